@@ -14,7 +14,6 @@ import (
 	evmtypes "github.com/okex/okexchain/x/evm/types"
 
 	"github.com/ethereum/go-ethereum/common"
-	ethcore "github.com/ethereum/go-ethereum/core"
 )
 
 // EVMKeeper defines the expected keeper interface used on the Eth AnteHandler
@@ -290,7 +289,7 @@ func NewEthGasConsumeDecorator(ak auth.AccountKeeper, sk types.SupplyKeeper, ek
 // Intrinsic gas for a transaction is the amount of gas
 // that the transaction uses before the transaction is executed. The gas is a
 // constant value of 21000 plus any cost inccured by additional bytes of data
-// supplied with the transaction.
+// supplied with the transaction and the EIP-2930 access list.
 func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	msgEthTx, ok := tx.(evmtypes.MsgEthereumTx)
 	if !ok {
@@ -317,7 +316,7 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	}
 
 	gasLimit := msgEthTx.GetGas()
-	gas, err := ethcore.IntrinsicGas(msgEthTx.Data.Payload, msgEthTx.To() == nil, true, false)
+	gas, err := evmtypes.IntrinsicGas(msgEthTx.Data.Payload, msgEthTx.AccessList(), msgEthTx.To() == nil, true, false)
 	if err != nil {
 		return ctx, sdkerrors.Wrap(err, "failed to compute intrinsic gas cost")
 	}
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 
 	"github.com/okex/okexchain/app/ante"
 	okexchaincodec "github.com/okex/okexchain/app/codec"
 	okexchain "github.com/okex/okexchain/app/types"
 	"github.com/okex/okexchain/x/ammswap"
 	"github.com/okex/okexchain/x/backend"
+	"github.com/okex/okexchain/x/common/proto"
 	commonversion "github.com/okex/okexchain/x/common/version"
 	"github.com/okex/okexchain/x/debug"
 	"github.com/okex/okexchain/x/dex"
@@ -17,11 +19,16 @@ import (
 	distr "github.com/okex/okexchain/x/distribution"
 	"github.com/okex/okexchain/x/evidence"
 	"github.com/okex/okexchain/x/evm"
+	evmclient "github.com/okex/okexchain/x/evm/client"
+	"github.com/okex/okexchain/x/evm/logindex"
+	"github.com/okex/okexchain/x/evm/receipts"
 	"github.com/okex/okexchain/x/farm"
 	farmclient "github.com/okex/okexchain/x/farm/client"
 	"github.com/okex/okexchain/x/genutil"
 	"github.com/okex/okexchain/x/gov"
 	"github.com/okex/okexchain/x/gov/keeper"
+	"github.com/okex/okexchain/x/makerincentive"
+	makerincentiveclient "github.com/okex/okexchain/x/makerincentive/client"
 	"github.com/okex/okexchain/x/order"
 	"github.com/okex/okexchain/x/params"
 	paramsclient "github.com/okex/okexchain/x/params/client"
@@ -29,6 +36,8 @@ import (
 	"github.com/okex/okexchain/x/staking"
 	"github.com/okex/okexchain/x/stream"
 	"github.com/okex/okexchain/x/token"
+	"github.com/okex/okexchain/x/upgrade"
+	upgradeclient "github.com/okex/okexchain/x/upgrade/client"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -42,10 +51,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/supply"
-	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	dbm "github.com/tendermint/tm-db"
@@ -81,6 +92,9 @@ var (
 		gov.NewAppModuleBasic(
 			paramsclient.ProposalHandler, distr.ProposalHandler,
 			dexclient.DelistProposalHandler, farmclient.ManageWhiteListProposalHandler,
+			evmclient.ManageContractDeploymentWhitelistProposalHandler, evmclient.ManageContractBlockedListProposalHandler,
+			makerincentiveclient.FundRewardPoolProposalHandler, makerincentiveclient.SetProductBudgetProposalHandler,
+			upgradeclient.AppUpgradeProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -97,6 +111,7 @@ var (
 		debug.AppModuleBasic{},
 		ammswap.AppModuleBasic{},
 		farm.AppModuleBasic{},
+		makerincentive.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -115,6 +130,7 @@ var (
 		farm.ModuleName:           nil,
 		farm.YieldFarmingAccount:  nil,
 		farm.MintFarmingAccount:   {supply.Burner},
+		makerincentive.ModuleName: nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -142,26 +158,32 @@ type OKExChainApp struct {
 	subspaces map[string]params.Subspace
 
 	// keepers
-	AccountKeeper  auth.AccountKeeper
-	BankKeeper     bank.Keeper
-	SupplyKeeper   supply.Keeper
-	StakingKeeper  staking.Keeper
-	SlashingKeeper slashing.Keeper
-	MintKeeper     mint.Keeper
-	DistrKeeper    distr.Keeper
-	GovKeeper      gov.Keeper
-	CrisisKeeper   crisis.Keeper
-	UpgradeKeeper  upgrade.Keeper
-	ParamsKeeper   params.Keeper
-	EvidenceKeeper evidence.Keeper
-	EvmKeeper      evm.Keeper
-	TokenKeeper    token.Keeper
-	DexKeeper      dex.Keeper
-	OrderKeeper    order.Keeper
-	SwapKeeper     ammswap.Keeper
-	FarmKeeper     farm.Keeper
-	BackendKeeper  backend.Keeper
-	StreamKeeper   stream.Keeper
+	AccountKeeper        auth.AccountKeeper
+	BankKeeper           bank.Keeper
+	SupplyKeeper         supply.Keeper
+	StakingKeeper        staking.Keeper
+	SlashingKeeper       slashing.Keeper
+	MintKeeper           mint.Keeper
+	DistrKeeper          distr.Keeper
+	GovKeeper            gov.Keeper
+	CrisisKeeper         crisis.Keeper
+	UpgradeKeeper        upgrade.Keeper
+	ParamsKeeper         params.Keeper
+	EvidenceKeeper       evidence.Keeper
+	EvmKeeper            evm.Keeper
+	TokenKeeper          token.Keeper
+	DexKeeper            dex.Keeper
+	OrderKeeper          order.Keeper
+	SwapKeeper           ammswap.Keeper
+	FarmKeeper           farm.Keeper
+	MakerIncentiveKeeper makerincentive.Keeper
+	BackendKeeper        backend.Keeper
+	StreamKeeper         stream.Keeper
+
+	// node-local log index of evm, which is nil if it's disabled
+	logIndex *logindex.Index
+	// node-local store of the evm receipts, which is nil if it's disabled
+	receiptStore *receipts.Store
 
 	// the module manager
 	mm *module.Manager
@@ -199,7 +221,7 @@ func NewOKExChainApp(
 		supply.StoreKey, mint.StoreKey, distr.StoreKey, slashing.StoreKey,
 		gov.StoreKey, params.StoreKey, upgrade.StoreKey, evidence.StoreKey,
 		evm.StoreKey, token.StoreKey, token.KeyLock, dex.StoreKey, dex.TokenPairStoreKey,
-		order.OrderStoreKey, ammswap.StoreKey, farm.StoreKey,
+		order.OrderStoreKey, ammswap.StoreKey, farm.StoreKey, makerincentive.StoreKey,
 	)
 
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
@@ -230,6 +252,7 @@ func NewOKExChainApp(
 	app.subspaces[order.ModuleName] = app.ParamsKeeper.Subspace(order.DefaultParamspace)
 	app.subspaces[ammswap.ModuleName] = app.ParamsKeeper.Subspace(ammswap.DefaultParamspace)
 	app.subspaces[farm.ModuleName] = app.ParamsKeeper.Subspace(farm.DefaultParamspace)
+	app.subspaces[makerincentive.ModuleName] = app.ParamsKeeper.Subspace(makerincentive.DefaultParamspace)
 
 	// use custom OKExChain account for contracts
 	app.AccountKeeper = auth.NewAccountKeeper(
@@ -260,7 +283,8 @@ func NewOKExChainApp(
 	app.CrisisKeeper = crisis.NewKeeper(
 		app.subspaces[crisis.ModuleName], invCheckPeriod, app.SupplyKeeper, auth.FeeCollectorName,
 	)
-	app.UpgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], app.cdc)
+	app.UpgradeKeeper = upgrade.NewKeeper(proto.NewProtocolKeeper(keys[bam.MainStoreKey]), &stakingKeeper,
+		skipUpgradeHeights, keys[upgrade.StoreKey], app.cdc)
 	app.EvmKeeper = evm.NewKeeper(
 		app.cdc, keys[evm.StoreKey], app.subspaces[evm.ModuleName], app.AccountKeeper,
 	)
@@ -279,14 +303,19 @@ func NewOKExChainApp(
 
 	app.SwapKeeper = ammswap.NewKeeper(app.SupplyKeeper, app.TokenKeeper, app.cdc, app.keys[ammswap.StoreKey], app.subspaces[ammswap.ModuleName])
 
-	app.StreamKeeper = stream.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.AccountKeeper, &app.SwapKeeper,
+	app.FarmKeeper = farm.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.TokenKeeper, app.SwapKeeper, app.subspaces[farm.StoreKey],
+		app.keys[farm.StoreKey], app.cdc)
+
+	app.MakerIncentiveKeeper = makerincentive.NewKeeper(app.OrderKeeper, app.DexKeeper, app.SupplyKeeper, app.DistrKeeper,
+		app.subspaces[makerincentive.ModuleName], app.keys[makerincentive.StoreKey], app.cdc)
+
+	app.StreamKeeper = stream.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.AccountKeeper, &app.SwapKeeper, &app.FarmKeeper,
 		app.cdc, logger, appConfig, streamMetrics)
 
-	app.BackendKeeper = backend.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.SwapKeeper, app.StreamKeeper.GetMarketKeeper(),
+	app.BackendKeeper = backend.NewKeeper(app.OrderKeeper, app.TokenKeeper, &app.DexKeeper, &app.SwapKeeper, &app.FarmKeeper,
+		&stakingKeeper, app.StreamKeeper.GetMarketKeeper(),
 		app.cdc, logger, appConfig.BackendConfig)
 
-	app.FarmKeeper = farm.NewKeeper(auth.FeeCollectorName, app.SupplyKeeper, app.TokenKeeper, app.SwapKeeper, app.subspaces[farm.StoreKey],
-		app.keys[farm.StoreKey], app.cdc)
 	// create evidence keeper with router
 	evidenceKeeper := evidence.NewKeeper(
 		cdc, keys[evidence.StoreKey], app.subspaces[evidence.ModuleName], &app.StakingKeeper, app.SlashingKeeper,
@@ -302,11 +331,17 @@ func NewOKExChainApp(
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(&app.ParamsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(dex.RouterKey, dex.NewProposalHandler(&app.DexKeeper)).
-		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper))
+		AddRoute(farm.RouterKey, farm.NewManageWhiteListProposalHandler(&app.FarmKeeper)).
+		AddRoute(makerincentive.RouterKey, makerincentive.NewProposalHandler(&app.MakerIncentiveKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewProposalHandler(&app.UpgradeKeeper)).
+		AddRoute(evm.RouterKey, evm.NewManageContractProposalHandler(&app.EvmKeeper))
 	govProposalHandlerRouter := keeper.NewProposalHandlerRouter()
 	govProposalHandlerRouter.AddRoute(params.RouterKey, &app.ParamsKeeper).
 		AddRoute(dex.RouterKey, &app.DexKeeper).
-		AddRoute(farm.RouterKey, &app.FarmKeeper)
+		AddRoute(farm.RouterKey, &app.FarmKeeper).
+		AddRoute(makerincentive.RouterKey, &app.MakerIncentiveKeeper).
+		AddRoute(upgrade.RouterKey, &app.UpgradeKeeper).
+		AddRoute(evm.RouterKey, &app.EvmKeeper)
 	app.GovKeeper = gov.NewKeeper(
 		app.cdc, app.keys[gov.StoreKey], app.ParamsKeeper, app.subspaces[gov.DefaultParamspace],
 		app.SupplyKeeper, &stakingKeeper, gov.DefaultParamspace, govRouter,
@@ -315,6 +350,28 @@ func NewOKExChainApp(
 	app.ParamsKeeper.SetGovKeeper(app.GovKeeper)
 	app.DexKeeper.SetGovKeeper(app.GovKeeper)
 	app.FarmKeeper.SetGovKeeper(app.GovKeeper)
+	app.MakerIncentiveKeeper.SetGovKeeper(app.GovKeeper)
+	app.UpgradeKeeper.SetGovKeeper(app.GovKeeper)
+	app.EvmKeeper.SetGovKeeper(app.GovKeeper)
+
+	// open the node-local log index of evm if it's enabled
+	if logIndexConfig := logindex.GetConfig(); logIndexConfig.Enable {
+		logIndex, err := logindex.OpenGlobalIndex(filepath.Join(viper.GetString(cli.HomeFlag), "data"), logIndexConfig)
+		if err != nil {
+			panic(fmt.Sprintf("failed to open the evm log index: %s", err))
+		}
+		app.EvmKeeper.SetLogIndexer(logIndex)
+		app.logIndex = logIndex
+	}
+
+	// open the node-local receipt store of evm if it's enabled
+	if receipts.GetConfig().Enable {
+		receiptStore, err := receipts.OpenGlobalStore(filepath.Join(viper.GetString(cli.HomeFlag), "data"))
+		if err != nil {
+			panic(fmt.Sprintf("failed to open the evm receipt store: %s", err))
+		}
+		app.receiptStore = receiptStore
+	}
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -342,6 +399,8 @@ func NewOKExChainApp(
 		order.NewAppModule(commonversion.ProtocolVersionV0, app.OrderKeeper, app.SupplyKeeper),
 		ammswap.NewAppModule(app.SwapKeeper),
 		farm.NewAppModule(app.FarmKeeper),
+		makerincentive.NewAppModule(app.MakerIncentiveKeeper),
+		upgrade.NewAppModule(app.UpgradeKeeper),
 		backend.NewAppModule(app.BackendKeeper),
 		stream.NewAppModule(app.StreamKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -351,6 +410,7 @@ func NewOKExChainApp(
 	// there is nothing left over in the validator fee pool, so as to keep the
 	// CanWithdrawInvariant invariant.
 	app.mm.SetOrderBeginBlockers(
+		upgrade.ModuleName,
 		stream.ModuleName,
 		order.ModuleName,
 		token.ModuleName,
@@ -368,6 +428,7 @@ func NewOKExChainApp(
 		gov.ModuleName,
 		dex.ModuleName,
 		order.ModuleName,
+		makerincentive.ModuleName,
 		staking.ModuleName,
 		backend.ModuleName,
 		stream.ModuleName,
@@ -380,9 +441,12 @@ func NewOKExChainApp(
 		auth.ModuleName, distr.ModuleName, staking.ModuleName, bank.ModuleName,
 		slashing.ModuleName, gov.ModuleName, mint.ModuleName, supply.ModuleName,
 		token.ModuleName, dex.ModuleName, order.ModuleName, ammswap.ModuleName, farm.ModuleName,
-		evm.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
+		makerincentive.ModuleName, evm.ModuleName, crisis.ModuleName, genutil.ModuleName, params.ModuleName, evidence.ModuleName,
+		upgrade.ModuleName,
 	)
 
+	app.setUpgradeHandlers()
+
 	app.mm.RegisterInvariants(&app.CrisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
 
@@ -434,7 +498,15 @@ func (app *OKExChainApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBloc
 
 // EndBlocker updates every end block
 func (app *OKExChainApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
-	return app.mm.EndBlock(ctx, req)
+	res := app.mm.EndBlock(ctx, req)
+
+	if app.receiptStore != nil {
+		if err := app.receiptStore.Commit(req.Height); err != nil {
+			app.Logger().Error("failed to store the evm receipts", "error", err, "height", req.Height)
+		}
+	}
+
+	return res
 }
 
 func (app *OKExChainApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliverTx) {
@@ -443,9 +515,27 @@ func (app *OKExChainApp) DeliverTx(req abci.RequestDeliverTx) (res abci.Response
 		app.syncTx(req.Tx)
 	}
 
+	if app.logIndex != nil {
+		app.logIndex.EndTx(app.GetDeliverStateCtx().BlockHeight(), resp.IsOK())
+	}
+
+	if app.receiptStore != nil {
+		app.storeReceipt(req.Tx, resp)
+	}
+
 	return resp
 }
 
+// storeReceipt passes the delivered tx to the receipt store, including the ones failed to decode which still take
+// a tx index in the block. The receipt is built from the final result of the tx, so that a tx failed or reverted
+// at any of its messages gets a failed receipt without logs, the same as its logs are dropped from the log index
+func (app *OKExChainApp) storeReceipt(txBytes []byte, res abci.ResponseDeliverTx) {
+	ctx := app.GetDeliverStateCtx()
+	tx, _ := evm.TxDecoder(app.Codec())(txBytes)
+	app.receiptStore.DeliverTx(ctx.BlockHeight(), evm.HashFromContext(ctx), tx,
+		common.BytesToHash(tmhash.Sum(txBytes)), res)
+}
+
 func (app *OKExChainApp) syncTx(txBytes []byte) {
 
 	if tx, err := auth.DefaultTxDecoder(app.Codec())(txBytes); err == nil {
@@ -549,6 +639,16 @@ func validateMsgHook(orderKeeper order.Keeper) ante.ValidateMsgHandler {
 					return wrongMsgErr
 				}
 				err = order.ValidateMsgCancelOrders(newCtx, orderKeeper, assertedMsg)
+			case order.MsgCancelAllOrders:
+				if len(msgs) > 1 {
+					return wrongMsgErr
+				}
+				err = order.ValidateMsgCancelAllOrders(newCtx, orderKeeper, assertedMsg)
+			case order.MsgAmendOrder:
+				if len(msgs) > 1 {
+					return wrongMsgErr
+				}
+				err = order.ValidateMsgAmendOrder(newCtx, orderKeeper, assertedMsg)
 			}
 
 			if err != nil {
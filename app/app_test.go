@@ -1,12 +1,13 @@
 package app
 
 import (
-	"github.com/cosmos/cosmos-sdk/x/upgrade"
 	"github.com/okex/okexchain/x/debug"
 	"github.com/okex/okexchain/x/dex"
 	distr "github.com/okex/okexchain/x/distribution"
 	"github.com/okex/okexchain/x/farm"
+	"github.com/okex/okexchain/x/makerincentive"
 	"github.com/okex/okexchain/x/params"
+	"github.com/okex/okexchain/x/upgrade"
 	"os"
 	"testing"
 
@@ -47,7 +48,7 @@ func TestModuleManager(t *testing.T) {
 	app := NewOKExChainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
 
 	for moduleName, _ := range ModuleBasics {
-		if moduleName == upgrade.ModuleName || moduleName == debug.ModuleName {
+		if moduleName == debug.ModuleName {
 			continue
 		}
 		_, found := app.mm.Modules[moduleName]
@@ -64,8 +65,12 @@ func TestProposalManager(t *testing.T) {
 	require.True(t, app.GovKeeper.Router().HasRoute(dex.RouterKey))
 	require.True(t, app.GovKeeper.Router().HasRoute(distr.RouterKey))
 	require.True(t, app.GovKeeper.Router().HasRoute(farm.RouterKey))
+	require.True(t, app.GovKeeper.Router().HasRoute(makerincentive.RouterKey))
+	require.True(t, app.GovKeeper.Router().HasRoute(upgrade.RouterKey))
 
 	require.True(t, app.GovKeeper.ProposalHandleRouter().HasRoute(params.RouterKey))
 	require.True(t, app.GovKeeper.ProposalHandleRouter().HasRoute(dex.RouterKey))
 	require.True(t, app.GovKeeper.ProposalHandleRouter().HasRoute(farm.RouterKey))
+	require.True(t, app.GovKeeper.ProposalHandleRouter().HasRoute(makerincentive.RouterKey))
+	require.True(t, app.GovKeeper.ProposalHandleRouter().HasRoute(upgrade.RouterKey))
 }
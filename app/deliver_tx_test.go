@@ -0,0 +1,136 @@
+package app
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
+	okexchain "github.com/okex/okexchain/app/types"
+	"github.com/okex/okexchain/x/evm/logindex"
+	"github.com/okex/okexchain/x/evm/receipts"
+	evmtypes "github.com/okex/okexchain/x/evm/types"
+)
+
+var (
+	// PUSH1 0 PUSH1 0 LOG0 STOP, the init code of a contract which emits a log on the creation
+	logInitCode = ethcmn.FromHex("0x60006000a000")
+	// PUSH1 0 PUSH1 0 REVERT, the init code of a contract which reverts on the creation
+	revertInitCode = ethcmn.FromHex("0x60006000fd")
+)
+
+func TestDeliverTxEvmIndexes(t *testing.T) {
+	home, err := ioutil.TempDir("", "okexchain-deliver-tx")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+
+	viper.Set(cli.HomeFlag, home)
+	viper.Set(logindex.FlagEnableLogIndex, true)
+	viper.Set(receipts.FlagEnableReceipts, true)
+	defer func() {
+		viper.Set(cli.HomeFlag, "")
+		viper.Set(logindex.FlagEnableLogIndex, false)
+		viper.Set(receipts.FlagEnableReceipts, false)
+	}()
+
+	chainID := "okexchain-3"
+	app := NewOKExChainApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, 0)
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, ModuleBasics.DefaultGenesis())
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{ChainId: chainID, Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	header := abci.Header{Height: 2, ChainID: chainID, Time: time.Now().UTC()}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := app.GetDeliverStateCtx()
+
+	evmParams := evmtypes.DefaultParams()
+	evmParams.EnableCreate, evmParams.EnableCall = true, true
+	app.EvmKeeper.SetParams(ctx, evmParams)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := ethcrypto.PubkeyToAddress(priv.ToECDSA().PublicKey)
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, sender.Bytes())
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(okexchain.NewPhotonCoinInt64(1000))))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	deliverTx := func(tx sdk.Tx) (ethcmn.Hash, abci.ResponseDeliverTx) {
+		txBytes, err := app.cdc.MarshalBinaryLengthPrefixed(tx)
+		require.NoError(t, err)
+		return ethcmn.BytesToHash(tmhash.Sum(txBytes)), app.DeliverTx(abci.RequestDeliverTx{Tx: txBytes})
+	}
+	ethTx := func(nonce uint64, payload []byte) sdk.Tx {
+		msg := evmtypes.NewMsgEthereumTx(nonce, nil, big.NewInt(0), 1000000, big.NewInt(1), payload)
+		chainIDEpoch, err := okexchain.ParseChainID(chainID)
+		require.NoError(t, err)
+		require.NoError(t, msg.Sign(chainIDEpoch, priv.ToECDSA()))
+		return msg
+	}
+
+	createHash, createRes := deliverTx(ethTx(0, logInitCode))
+	require.True(t, createRes.IsOK(), createRes.Log)
+	revertHash, revertRes := deliverTx(ethTx(1, revertInitCode))
+	require.False(t, revertRes.IsOK())
+
+	// the second message of the tx fails, which reverts the contract created by the first one
+	recipient := sdk.AccAddress(ethcmn.BytesToAddress([]byte("recipient")).Bytes())
+	msgs := []sdk.Msg{
+		evmtypes.NewMsgEthermint(2, nil, sdk.ZeroInt(), 1000000, sdk.OneInt(), logInitCode, sender.Bytes()),
+		evmtypes.NewMsgEthermint(2, &recipient, sdk.NewIntWithDecimal(1, 30), 1000000, sdk.OneInt(), nil, sender.Bytes()),
+	}
+	fee := auth.NewStdFee(2000000, sdk.NewCoins(okexchain.NewPhotonCoinInt64(1)))
+	signBytes := auth.StdSignBytes(chainID, acc.GetAccountNumber(), 2, fee, msgs, "")
+	sig, err := priv.Sign(signBytes)
+	require.NoError(t, err)
+	failedHash, failedRes := deliverTx(auth.NewStdTx(msgs, fee, []auth.StdSignature{{PubKey: priv.PubKey(), Signature: sig}}, ""))
+	require.False(t, failedRes.IsOK())
+
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	// only the log of the successful eth tx is indexed
+	logs, err := logindex.GetGlobalIndex().Logs(header.Height, header.Height, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, createHash, logs[0].TxHash)
+
+	// the multi-message tx has no receipt, and the reverted eth tx has a failed receipt without logs
+	blockReceipts, err := receipts.GetGlobalStore().GetBlockReceipts(header.Height)
+	require.NoError(t, err)
+	require.Len(t, blockReceipts, 2)
+
+	require.Equal(t, createHash, blockReceipts[0].TxHash)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, blockReceipts[0].Status)
+	require.Equal(t, uint64(0), blockReceipts[0].TransactionIndex)
+	require.Equal(t, uint64(createRes.GasUsed), blockReceipts[0].CumulativeGasUsed)
+	require.Len(t, blockReceipts[0].Logs, 1)
+	require.Equal(t, *blockReceipts[0].ContractAddress, logs[0].Address)
+
+	require.Equal(t, revertHash, blockReceipts[1].TxHash)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, blockReceipts[1].Status)
+	require.Equal(t, uint64(1), blockReceipts[1].TransactionIndex)
+	require.Equal(t, uint64(createRes.GasUsed+revertRes.GasUsed), blockReceipts[1].CumulativeGasUsed)
+	require.Empty(t, blockReceipts[1].Logs)
+	require.Equal(t, ethtypes.Bloom{}, blockReceipts[1].Bloom)
+
+	receipt, err := receipts.GetGlobalStore().GetReceipt(failedHash)
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+}
@@ -14,5 +14,6 @@ func ServeCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagUnlockKey, "", "Select a key to unlock on the RPC server")
 	cmd.Flags().String(flagWebsocket, "8546", "websocket port to listen to")
 	cmd.Flags().StringP(flags.FlagBroadcastMode, "b", flags.BroadcastSync, "Transaction broadcasting mode (sync|async|block)")
+	AddLimitFlags(cmd)
 	return cmd
 }
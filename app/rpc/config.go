@@ -3,10 +3,13 @@ package rpc
 import (
 	"bufio"
 	"fmt"
+	"math"
 	"os"
+	"strconv"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -23,8 +26,120 @@ import (
 const (
 	flagUnlockKey = "unlock-key"
 	flagWebsocket = "wsport"
+
+	// FlagRateLimitPerIP is the flag of the requests per second allowed from a client ip, 0 means unlimited
+	FlagRateLimitPerIP = "rpc.rate-limit-per-ip"
+	// FlagRateBurstPerIP is the flag of the burst size of the per ip limit
+	FlagRateBurstPerIP = "rpc.rate-burst-per-ip"
+	// FlagMethodRateLimits is the flag of the per ip limits of the specific methods
+	FlagMethodRateLimits = "rpc.method-rate-limits"
+	// FlagTrustForwardedFor is the flag to take the client ip from the X-Forwarded-For header set by a proxy
+	FlagTrustForwardedFor = "rpc.trust-forwarded-for"
+	// FlagMaxBatchSize is the flag of the max number of requests in a batch, 0 means unlimited
+	FlagMaxBatchSize = "rpc.max-batch-size"
+	// FlagMaxResponseSize is the flag of the max size of a response in bytes, 0 means unlimited
+	FlagMaxResponseSize = "rpc.max-response-size"
 )
 
+// RateLimit is the token bucket setting of a rate limit
+type RateLimit struct {
+	// Rate is the number of the requests refilled per second
+	Rate float64 `json:"rate"`
+	// Burst is the max number of the requests allowed at once
+	Burst int `json:"burst"`
+}
+
+// Enabled returns whether the rate limit is set
+func (rl RateLimit) Enabled() bool {
+	return rl.Rate > 0
+}
+
+// LimitConfig defines the limits of the web3 rpc server
+type LimitConfig struct {
+	// PerIP is the rate limit of all the requests from a client ip
+	PerIP RateLimit `json:"per_ip"`
+	// Methods are the rate limits of the specific methods per client ip
+	Methods map[string]RateLimit `json:"methods"`
+	// TrustForwardedFor takes the client ip from the X-Forwarded-For header, only for the server behind a proxy
+	TrustForwardedFor bool `json:"trust_forwarded_for"`
+	// MaxBatchSize is the max number of requests in a batch
+	MaxBatchSize int `json:"max_batch_size"`
+	// MaxResponseSize is the max size of a response in bytes
+	MaxResponseSize int `json:"max_response_size"`
+}
+
+// DefaultLimitConfig returns the default limits of the web3 rpc server, which limit nothing
+func DefaultLimitConfig() LimitConfig {
+	return LimitConfig{
+		Methods: map[string]RateLimit{},
+	}
+}
+
+// GetLimitConfig builds the limits of the web3 rpc server from the flags bound to viper
+func GetLimitConfig() (LimitConfig, error) {
+	cfg := DefaultLimitConfig()
+	cfg.PerIP = newRateLimit(viper.GetFloat64(FlagRateLimitPerIP), viper.GetInt(FlagRateBurstPerIP))
+	cfg.TrustForwardedFor = viper.GetBool(FlagTrustForwardedFor)
+	cfg.MaxBatchSize = viper.GetInt(FlagMaxBatchSize)
+	cfg.MaxResponseSize = viper.GetInt(FlagMaxResponseSize)
+
+	methods, err := parseMethodRateLimits(viper.GetString(FlagMethodRateLimits))
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Methods = methods
+	return cfg, nil
+}
+
+// AddLimitFlags adds the limit flags of the web3 rpc server to the command
+func AddLimitFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Float64(FlagRateLimitPerIP, 0, "Requests per second allowed from a client ip on the web3 rpc server, 0 means unlimited")
+	cmd.PersistentFlags().Int(FlagRateBurstPerIP, 0, "Burst size of the per ip rate limit, the rate rounded up is used if it's 0")
+	cmd.PersistentFlags().String(FlagMethodRateLimits, "",
+		"Per ip rate limits of the specific methods in the format of method=rate[:burst],..., e.g. eth_getLogs=5:10,eth_call=50")
+	cmd.PersistentFlags().Bool(FlagTrustForwardedFor, false, "Take the client ip from the X-Forwarded-For header, only if the web3 rpc server is behind a proxy")
+	cmd.PersistentFlags().Int(FlagMaxBatchSize, 0, "Max number of requests in a batch on the web3 rpc server, 0 means unlimited")
+	cmd.PersistentFlags().Int(FlagMaxResponseSize, 0, "Max size of a response in bytes on the web3 rpc server, 0 means unlimited")
+}
+
+func newRateLimit(rate float64, burst int) RateLimit {
+	if rate > 0 && burst <= 0 {
+		burst = int(math.Ceil(rate))
+	}
+	return RateLimit{Rate: rate, Burst: burst}
+}
+
+// parseMethodRateLimits parses the method rate limits in the format of method=rate[:burst],...
+func parseMethodRateLimits(str string) (map[string]RateLimit, error) {
+	limits := make(map[string]RateLimit)
+	for _, item := range strings.Split(str, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid method rate limit %q, expected method=rate[:burst]", item)
+		}
+
+		setting := strings.SplitN(kv[1], ":", 2)
+		rate, err := strconv.ParseFloat(strings.TrimSpace(setting[0]), 64)
+		if err != nil || rate <= 0 {
+			return nil, fmt.Errorf("invalid rate of the method rate limit %q", item)
+		}
+		burst := 0
+		if len(setting) == 2 {
+			if burst, err = strconv.Atoi(strings.TrimSpace(setting[1])); err != nil || burst <= 0 {
+				return nil, fmt.Errorf("invalid burst of the method rate limit %q", item)
+			}
+		}
+
+		limits[strings.TrimSpace(kv[0])] = newRateLimit(rate, burst)
+	}
+	return limits, nil
+}
+
 // RegisterRoutes creates a new server and registers the `/rpc` endpoint.
 // Rpc calls are enabled based on their associated module (eg. "eth").
 func RegisterRoutes(rs *lcd.RestServer) {
@@ -67,8 +182,13 @@ func RegisterRoutes(rs *lcd.RestServer) {
 		}
 	}
 
+	limitConfig, err := GetLimitConfig()
+	if err != nil {
+		panic(err)
+	}
+
 	// Web3 RPC API route
-	rs.Mux.HandleFunc("/", server.ServeHTTP).Methods("POST", "OPTIONS")
+	rs.Mux.Handle("/", newLimitHandler(server, limitConfig, rpcMetrics)).Methods("POST", "OPTIONS")
 
 	// start websockets server
 	websocketAddr := viper.GetString(flagWebsocket)
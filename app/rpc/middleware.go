@@ -0,0 +1,351 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/okex/okexchain/x/common/monitor"
+)
+
+const (
+	// the same as the request size limit of the go-ethereum rpc server
+	maxRequestContentLength = 1024 * 1024 * 5
+	// the idle buckets are dropped once per interval to bound the memory used by the limiters
+	limiterSweepInterval = time.Minute
+
+	// json-rpc error codes of the limits
+	errCodeInvalidRequest   = -32600
+	errCodeResponseTooLarge = -32003
+	errCodeLimitExceeded    = -32005
+
+	unknownMethod = "unknown"
+)
+
+var rpcMetrics = monitor.DefaultRPCMetrics(monitor.DefaultPrometheusConfig())
+
+// tokenBucket refills tokens at a constant rate up to the burst size
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter keeps a token bucket per key
+type rateLimiter struct {
+	limit RateLimit
+
+	mtx       sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	return &rateLimiter{
+		limit:   limit,
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// allow takes n tokens from the bucket of the key, it returns false and takes nothing if there are not enough tokens
+func (rl *rateLimiter) allow(key string, n int, now time.Time) bool {
+	rl.mtx.Lock()
+	defer rl.mtx.Unlock()
+
+	if now.Sub(rl.lastSweep) >= limiterSweepInterval {
+		rl.sweep(now)
+	}
+
+	bucket, ok := rl.buckets[key]
+	if !ok {
+		bucket = &tokenBucket{tokens: float64(rl.limit.Burst), last: now}
+		rl.buckets[key] = bucket
+	} else if elapsed := now.Sub(bucket.last); elapsed > 0 {
+		bucket.tokens = math.Min(float64(rl.limit.Burst), bucket.tokens+elapsed.Seconds()*rl.limit.Rate)
+		bucket.last = now
+	}
+
+	if bucket.tokens < float64(n) {
+		return false
+	}
+	bucket.tokens -= float64(n)
+	return true
+}
+
+// sweep drops the buckets which have been refilled completely, they are the same as the new ones
+func (rl *rateLimiter) sweep(now time.Time) {
+	for key, bucket := range rl.buckets {
+		if bucket.tokens+now.Sub(bucket.last).Seconds()*rl.limit.Rate >= float64(rl.limit.Burst) {
+			delete(rl.buckets, key)
+		}
+	}
+	rl.lastSweep = now
+}
+
+// jsonrpcRequest is the part of a json-rpc request read by the limit handler
+type jsonrpcRequest struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method"`
+}
+
+// jsonrpcResponse is the part of a json-rpc response read by the limit handler
+type jsonrpcResponse struct {
+	ID    json.RawMessage `json:"id,omitempty"`
+	Error json.RawMessage `json:"error,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type jsonrpcErrorResponse struct {
+	Version string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonrpcError    `json:"error"`
+}
+
+// limitHandler applies the limits of the LimitConfig to the json-rpc requests over http and records the metrics
+type limitHandler struct {
+	next    http.Handler
+	config  LimitConfig
+	metrics *monitor.RPCMetrics
+
+	ipLimiter      *rateLimiter
+	methodLimiters map[string]*rateLimiter
+}
+
+func newLimitHandler(next http.Handler, config LimitConfig, metrics *monitor.RPCMetrics) *limitHandler {
+	h := &limitHandler{
+		next:           next,
+		config:         config,
+		metrics:        metrics,
+		methodLimiters: make(map[string]*rateLimiter),
+	}
+	if config.PerIP.Enabled() {
+		h.ipLimiter = newRateLimiter(config.PerIP)
+	}
+	for method, limit := range config.Methods {
+		if limit.Enabled() {
+			h.methodLimiters[method] = newRateLimiter(limit)
+		}
+	}
+	return h
+}
+
+// ServeHTTP implements http.Handler
+func (h *limitHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.ContentLength > maxRequestContentLength {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRequestContentLength))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	requests, isBatch := parseRequests(body)
+	// the invalid requests are left to the rpc server, which responds with the parse error
+	if requests == nil {
+		h.next.ServeHTTP(w, r)
+		return
+	}
+
+	if isBatch && h.config.MaxBatchSize > 0 && len(requests) > h.config.MaxBatchSize {
+		writeError(w, http.StatusOK, nil, errCodeInvalidRequest, "batch too large")
+		return
+	}
+
+	if method, ok := h.allow(h.clientIP(r), requests, time.Now()); !ok {
+		h.metrics.LimitedRequests.With(monitor.RPCMethodLabel, method).Add(1)
+		writeError(w, http.StatusTooManyRequests, singleID(requests, isBatch), errCodeLimitExceeded, "request rate limit exceeded")
+		return
+	}
+
+	start := time.Now()
+	rec := newResponseRecorder()
+	h.next.ServeHTTP(rec, r)
+	duration := time.Since(start).Seconds()
+
+	if h.config.MaxResponseSize > 0 && rec.body.Len() > h.config.MaxResponseSize {
+		for _, req := range requests {
+			h.metrics.RequestDuration.With(monitor.RPCMethodLabel, req.Method).Observe(duration)
+			h.metrics.RequestErrors.With(monitor.RPCMethodLabel, req.Method).Add(1)
+		}
+		writeError(w, http.StatusOK, singleID(requests, isBatch), errCodeResponseTooLarge, "response too large")
+		return
+	}
+
+	h.observe(requests, isBatch, rec.body.Bytes(), duration)
+	rec.flush(w)
+}
+
+// allow takes the tokens of the requests from the per ip and the per method limiters. It returns the method of the
+// first limit exceeded
+func (h *limitHandler) allow(ip string, requests []jsonrpcRequest, now time.Time) (string, bool) {
+	if h.ipLimiter != nil && !h.ipLimiter.allow(ip, len(requests), now) {
+		return requests[0].Method, false
+	}
+
+	counts := make(map[string]int)
+	for _, req := range requests {
+		if _, ok := h.methodLimiters[req.Method]; ok {
+			counts[req.Method]++
+		}
+	}
+	for method, n := range counts {
+		if !h.methodLimiters[method].allow(ip, n, now) {
+			return method, false
+		}
+	}
+	return "", true
+}
+
+// observe records the latency and the errors of the requests. The requests in a batch share the latency of the batch
+func (h *limitHandler) observe(requests []jsonrpcRequest, isBatch bool, response []byte, duration float64) {
+	methodByID := make(map[string]string, len(requests))
+	for _, req := range requests {
+		h.metrics.RequestDuration.With(monitor.RPCMethodLabel, req.Method).Observe(duration)
+		methodByID[string(req.ID)] = req.Method
+	}
+
+	var responses []jsonrpcResponse
+	if isBatch {
+		if err := json.Unmarshal(response, &responses); err != nil {
+			return
+		}
+	} else {
+		var resp jsonrpcResponse
+		if err := json.Unmarshal(response, &resp); err != nil {
+			return
+		}
+		responses = append(responses, resp)
+	}
+
+	for _, resp := range responses {
+		if len(resp.Error) == 0 || string(resp.Error) == "null" {
+			continue
+		}
+		method, ok := methodByID[string(resp.ID)]
+		if !ok {
+			method = unknownMethod
+		}
+		h.metrics.RequestErrors.With(monitor.RPCMethodLabel, method).Add(1)
+	}
+}
+
+func (h *limitHandler) clientIP(r *http.Request) string {
+	if h.config.TrustForwardedFor {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// parseRequests parses the single request or the batch in the body. It returns nil if the body is invalid
+func parseRequests(body []byte) (requests []jsonrpcRequest, isBatch bool) {
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) == 0 {
+		return nil, false
+	}
+
+	if trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &requests); err != nil || len(requests) == 0 {
+			return nil, true
+		}
+		isBatch = true
+	} else {
+		var req jsonrpcRequest
+		if err := json.Unmarshal(trimmed, &req); err != nil {
+			return nil, false
+		}
+		requests = []jsonrpcRequest{req}
+	}
+
+	for i := range requests {
+		if requests[i].Method == "" {
+			requests[i].Method = unknownMethod
+		}
+	}
+	return requests, isBatch
+}
+
+// singleID returns the id of a single request, the errors of a batch are responded with the null id
+func singleID(requests []jsonrpcRequest, isBatch bool) json.RawMessage {
+	if isBatch || len(requests[0].ID) == 0 {
+		return nil
+	}
+	return requests[0].ID
+}
+
+func writeError(w http.ResponseWriter, status int, id json.RawMessage, code int, message string) {
+	if id == nil {
+		id = json.RawMessage("null")
+	}
+	bz, err := json.Marshal(jsonrpcErrorResponse{
+		Version: "2.0",
+		ID:      id,
+		Error:   jsonrpcError{Code: code, Message: message},
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(bz)
+}
+
+// responseRecorder buffers the response to check its size before it's sent to the client
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   *bytes.Buffer
+}
+
+func newResponseRecorder() *responseRecorder {
+	return &responseRecorder{
+		header: make(http.Header),
+		status: http.StatusOK,
+		body:   new(bytes.Buffer),
+	}
+}
+
+// Header implements http.ResponseWriter
+func (rr *responseRecorder) Header() http.Header {
+	return rr.header
+}
+
+// Write implements http.ResponseWriter
+func (rr *responseRecorder) Write(bz []byte) (int, error) {
+	return rr.body.Write(bz)
+}
+
+// WriteHeader implements http.ResponseWriter
+func (rr *responseRecorder) WriteHeader(status int) {
+	rr.status = status
+}
+
+func (rr *responseRecorder) flush(w http.ResponseWriter) {
+	for key, values := range rr.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(rr.status)
+	_, _ = w.Write(rr.body.Bytes())
+}
@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common/monitor"
+)
+
+func TestParseMethodRateLimits(t *testing.T) {
+	limits, err := parseMethodRateLimits(" eth_getLogs=5:10, eth_call=2.5 ,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]RateLimit{
+		"eth_getLogs": {Rate: 5, Burst: 10},
+		"eth_call":    {Rate: 2.5, Burst: 3},
+	}, limits)
+
+	for _, invalid := range []string{"eth_call", "=1", "eth_call=0", "eth_call=x", "eth_call=1:0", "eth_call=1:x"} {
+		_, err := parseMethodRateLimits(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	rl := newRateLimiter(RateLimit{Rate: 2, Burst: 3})
+	now := time.Now()
+
+	require.True(t, rl.allow("a", 3, now))
+	require.False(t, rl.allow("a", 1, now))
+	// the buckets are independent per key
+	require.True(t, rl.allow("b", 1, now))
+
+	// 2 tokens are refilled per second
+	require.True(t, rl.allow("a", 1, now.Add(500*time.Millisecond)))
+	require.False(t, rl.allow("a", 1, now.Add(500*time.Millisecond)))
+	require.False(t, rl.allow("a", 4, now.Add(time.Hour)))
+	require.True(t, rl.allow("a", 3, now.Add(time.Hour)))
+
+	// the full buckets are dropped on sweep
+	rl.sweep(now.Add(2 * time.Hour))
+	require.Empty(t, rl.buckets)
+}
+
+// echoHandler responds each request with its method as the result, or an error for the method "fail"
+func echoHandler(t *testing.T) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		resp := map[string]interface{}{"jsonrpc": "2.0", "id": req["id"]}
+		if req["method"] == "fail" {
+			resp["error"] = map[string]interface{}{"code": -32000, "message": "failed"}
+		} else {
+			resp["result"] = req["method"]
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(resp))
+	})
+}
+
+func doRequest(h http.Handler, remoteAddr, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	req.RemoteAddr = remoteAddr
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	return rec
+}
+
+func errorCode(t *testing.T, rec *httptest.ResponseRecorder) int {
+	var resp jsonrpcErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp.Error.Code
+}
+
+func TestLimitHandler(t *testing.T) {
+	cfg := DefaultLimitConfig()
+	cfg.PerIP = RateLimit{Rate: 1, Burst: 3}
+	cfg.Methods = map[string]RateLimit{"eth_getLogs": {Rate: 1, Burst: 1}}
+	cfg.MaxBatchSize = 2
+	cfg.MaxResponseSize = 60
+	h := newLimitHandler(echoHandler(t), cfg, monitor.NopRPCMetrics())
+
+	rec := doRequest(h, "1.1.1.1:1000", `{"jsonrpc":"2.0","id":1,"method":"eth_getLogs"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"result":"eth_getLogs"`)
+
+	// the method limit is exceeded
+	rec = doRequest(h, "1.1.1.1:1001", `{"jsonrpc":"2.0","id":2,"method":"eth_getLogs"}`)
+	require.Equal(t, http.StatusTooManyRequests, rec.Code)
+	require.Equal(t, errCodeLimitExceeded, errorCode(t, rec))
+
+	// the batch is too large
+	rec = doRequest(h, "1.1.1.1:1000", `[{"id":3,"method":"a"},{"id":4,"method":"b"},{"id":5,"method":"c"}]`)
+	require.Equal(t, errCodeInvalidRequest, errorCode(t, rec))
+
+	// the response is too large
+	rec = doRequest(h, "1.1.1.1:1000", `{"jsonrpc":"2.0","id":6,"method":"`+strings.Repeat("a", 60)+`"}`)
+	require.Equal(t, errCodeResponseTooLarge, errorCode(t, rec))
+
+	// the ip limit is exceeded, while the other ips are not limited
+	rec = doRequest(h, "1.1.1.1:1000", `{"jsonrpc":"2.0","id":7,"method":"eth_call"}`)
+	require.Equal(t, errCodeLimitExceeded, errorCode(t, rec))
+	rec = doRequest(h, "2.2.2.2:1000", `{"jsonrpc":"2.0","id":8,"method":"eth_call"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Contains(t, rec.Body.String(), `"result":"eth_call"`)
+}
+
+func TestLimitHandlerClientIP(t *testing.T) {
+	cfg := DefaultLimitConfig()
+	h := newLimitHandler(echoHandler(t), cfg, monitor.NopRPCMetrics())
+
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.RemoteAddr = "1.1.1.1:1000"
+	req.Header.Set("X-Forwarded-For", "3.3.3.3, 1.1.1.1")
+	require.Equal(t, "1.1.1.1", h.clientIP(req))
+
+	h.config.TrustForwardedFor = true
+	require.Equal(t, "3.3.3.3", h.clientIP(req))
+}
@@ -18,6 +18,7 @@ import (
 	rpctypes "github.com/okex/okexchain/app/rpc/types"
 	ethermint "github.com/okex/okexchain/app/types"
 	"github.com/okex/okexchain/app/utils"
+	"github.com/okex/okexchain/x/evm/receipts"
 	evmtypes "github.com/okex/okexchain/x/evm/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -30,7 +31,6 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/rlp"
 
 	clientcontext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -481,8 +481,8 @@ func (api *PublicEthereumAPI) SendRawTransaction(data hexutil.Bytes) (common.Has
 	api.logger.Debug("eth_sendRawTransaction", "data", data)
 	tx := new(evmtypes.MsgEthereumTx)
 
-	// RLP decode raw transaction bytes
-	if err := rlp.DecodeBytes(data, tx); err != nil {
+	// decode the raw transaction bytes of a legacy RLP transaction or an EIP-2718 typed transaction
+	if err := tx.UnmarshalBinary(data); err != nil {
 		// Return nil is for when gasLimit overflows uint64
 		return common.Hash{}, nil
 	}
@@ -584,6 +584,10 @@ func (api *PublicEthereumAPI) doCall(
 	// Create new call message
 	msg := evmtypes.NewMsgEthermint(0, &toAddr, sdk.NewIntFromBigInt(value), gas,
 		sdk.NewIntFromBigInt(gasPrice), data, sdk.AccAddress(addr.Bytes()))
+	if args.AccessList != nil {
+		msg = evmtypes.NewMsgEthermintAccessList(0, &toAddr, sdk.NewIntFromBigInt(value), gas,
+			sdk.NewIntFromBigInt(gasPrice), data, sdk.AccAddress(addr.Bytes()), *args.AccessList)
+	}
 	msgs = append(msgs, msg)
 
 	// convert the pending transactions into ethermint msgs
@@ -818,6 +822,18 @@ func (api *PublicEthereumAPI) getTransactionByBlockAndIndex(block *tmtypes.Block
 // GetTransactionReceipt returns the transaction receipt identified by hash.
 func (api *PublicEthereumAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
 	api.logger.Debug("eth_getTransactionReceipt", "hash", hash)
+
+	// serve the receipt persisted at DeliverTx if the receipt store is enabled on this node
+	if store := receipts.GetGlobalStore(); store != nil {
+		receipt, err := store.GetReceipt(hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return rpctypes.FormatReceipt(receipt), nil
+		}
+	}
+
 	tx, err := api.clientCtx.Client.Tx(hash.Bytes(), false)
 	if err != nil {
 		// Return nil for transaction when not found
@@ -869,6 +885,7 @@ func (api *PublicEthereumAPI) GetTransactionReceipt(hash common.Hash) (map[strin
 
 	receipt := map[string]interface{}{
 		// Consensus fields: These fields are defined by the Yellow Paper
+		"type":              hexutil.Uint64(ethTx.TxType()),
 		"status":            status,
 		"cumulativeGasUsed": hexutil.Uint64(cumulativeGasUsed),
 		"logsBloom":         data.Bloom,
@@ -876,9 +893,10 @@ func (api *PublicEthereumAPI) GetTransactionReceipt(hash common.Hash) (map[strin
 
 		// Implementation fields: These fields are added by geth when processing a transaction.
 		// They are stored in the chain database.
-		"transactionHash": hash,
-		"contractAddress": data.ContractAddress,
-		"gasUsed":         hexutil.Uint64(tx.TxResult.GasUsed),
+		"transactionHash":   hash,
+		"contractAddress":   data.ContractAddress,
+		"gasUsed":           hexutil.Uint64(tx.TxResult.GasUsed),
+		"effectiveGasPrice": (*hexutil.Big)(ethTx.Data.Price),
 
 		// Inclusion information: These fields provide information about the inclusion of the
 		// transaction corresponding to this receipt.
@@ -894,6 +912,54 @@ func (api *PublicEthereumAPI) GetTransactionReceipt(hash common.Hash) (map[strin
 	return receipt, nil
 }
 
+// GetBlockReceipts returns all the receipts of the ethereum transactions in the block.
+func (api *PublicEthereumAPI) GetBlockReceipts(blockNum rpctypes.BlockNumber) ([]map[string]interface{}, error) {
+	api.logger.Debug("eth_getBlockReceipts", "number", blockNum)
+
+	height := blockNum.Int64()
+	if height <= 0 {
+		latest, err := api.backend.LatestBlockNumber()
+		if err != nil {
+			return nil, err
+		}
+		height = latest
+	}
+
+	result := []map[string]interface{}{}
+	if store := receipts.GetGlobalStore(); store != nil && store.Covers(height) {
+		blockReceipts, err := store.GetBlockReceipts(height)
+		if err != nil {
+			return nil, err
+		}
+		for _, receipt := range blockReceipts {
+			result = append(result, rpctypes.FormatReceipt(receipt))
+		}
+		return result, nil
+	}
+
+	// rebuild the receipts from the block if they haven't been persisted
+	resBlock, err := api.clientCtx.Client.Block(&height)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tx := range resBlock.Block.Txs {
+		if _, err := rpctypes.RawTxToEthTx(api.clientCtx, tx); err != nil {
+			continue
+		}
+
+		receipt, err := api.GetTransactionReceipt(common.BytesToHash(tx.Hash()))
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			result = append(result, receipt)
+		}
+	}
+
+	return result, nil
+}
+
 // PendingTransactions returns the transactions that are in the transaction pool
 // and have a from address that is one of the accounts this node manages.
 func (api *PublicEthereumAPI) PendingTransactions() ([]*rpctypes.Transaction, error) {
@@ -1030,12 +1096,13 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 
 	if args.Gas == nil {
 		callArgs := rpctypes.CallArgs{
-			From:     &args.From,
-			To:       args.To,
-			Gas:      args.Gas,
-			GasPrice: args.GasPrice,
-			Value:    args.Value,
-			Data:     args.Data,
+			From:       &args.From,
+			To:         args.To,
+			Gas:        args.Gas,
+			GasPrice:   args.GasPrice,
+			Value:      args.Value,
+			Data:       args.Data,
+			AccessList: args.AccessList,
 		}
 		gl, err := api.EstimateGas(callArgs)
 		if err != nil {
@@ -1045,8 +1112,14 @@ func (api *PublicEthereumAPI) generateFromArgs(args rpctypes.SendTxArgs) (*evmty
 	} else {
 		gasLimit = (uint64)(*args.Gas)
 	}
-	msg := evmtypes.NewMsgEthereumTx(nonce, args.To, amount, gasLimit, gasPrice, input)
 
+	if args.AccessList != nil {
+		msg := evmtypes.NewMsgEthereumAccessListTx(api.chainIDEpoch, nonce, args.To, amount, gasLimit, gasPrice, input,
+			*args.AccessList)
+		return &msg, nil
+	}
+
+	msg := evmtypes.NewMsgEthereumTx(nonce, args.To, amount, gasLimit, gasPrice, input)
 	return &msg, nil
 }
 
@@ -1134,4 +1207,4 @@ func (api *PublicEthereumAPI) accountNonce(
 	}
 
 	return nonce, nil
-}
\ No newline at end of file
+}
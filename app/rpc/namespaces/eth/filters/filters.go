@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 
 	rpctypes "github.com/okex/okexchain/app/rpc/types"
+	"github.com/okex/okexchain/x/evm/logindex"
 )
 
 // Filter can be used to retrieve and filter logs.
@@ -108,6 +109,11 @@ func (f *Filter) Logs(_ context.Context) ([]*ethtypes.Log, error) {
 		f.criteria.ToBlock = big.NewInt(head)
 	}
 
+	// query the node-local log index if it covers the whole range
+	if idx := logindex.GetGlobalIndex(); idx != nil && idx.Covers(f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64()) {
+		return idx.Logs(f.criteria.FromBlock.Int64(), f.criteria.ToBlock.Int64(), f.criteria.Addresses, f.criteria.Topics)
+	}
+
 	for i := f.criteria.FromBlock.Int64(); i <= f.criteria.ToBlock.Int64(); i++ {
 		block, err := f.backend.GetBlockByNumber(rpctypes.BlockNumber(i), true)
 		if err != nil {
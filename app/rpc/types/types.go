@@ -3,6 +3,8 @@ package types
 import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/okex/okexchain/x/evm/types"
 )
 
 // Copied the Account and StorageResult types since they are registered under an
@@ -42,6 +44,10 @@ type Transaction struct {
 	V                *hexutil.Big    `json:"v"`
 	R                *hexutil.Big    `json:"r"`
 	S                *hexutil.Big    `json:"s"`
+	// fields of the EIP-2718 typed transactions
+	Type     hexutil.Uint64       `json:"type"`
+	ChainID  *hexutil.Big         `json:"chainId,omitempty"`
+	Accesses *evmtypes.AccessList `json:"accessList,omitempty"`
 }
 
 // SendTxArgs represents the arguments to submit a new transaction into the transaction pool.
@@ -58,6 +64,8 @@ type SendTxArgs struct {
 	// newer name and should be preferred by clients.
 	Data  *hexutil.Bytes `json:"data"`
 	Input *hexutil.Bytes `json:"input"`
+	// An EIP-2930 access list transaction is sent if the access list is set
+	AccessList *evmtypes.AccessList `json:"accessList"`
 }
 
 // CallArgs represents the arguments for a call.
@@ -68,6 +76,8 @@ type CallArgs struct {
 	GasPrice *hexutil.Big    `json:"gasPrice"`
 	Value    *hexutil.Big    `json:"value"`
 	Data     *hexutil.Bytes  `json:"data"`
+	// EIP-2930 access list of the call, which is charged and warmed up in the simulation
+	AccessList *evmtypes.AccessList `json:"accessList"`
 }
 
 // Account indicates the overriding fields of account during the execution of
@@ -14,6 +14,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
+	"github.com/okex/okexchain/x/evm/receipts"
 	evmtypes "github.com/okex/okexchain/x/evm/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -57,6 +58,13 @@ func NewTransaction(tx *evmtypes.MsgEthereumTx, txHash, blockHash common.Hash, b
 		V:        (*hexutil.Big)(tx.Data.V),
 		R:        (*hexutil.Big)(tx.Data.R),
 		S:        (*hexutil.Big)(tx.Data.S),
+		Type:     hexutil.Uint64(tx.TxType()),
+	}
+
+	if tx.TxType() != evmtypes.LegacyTxType {
+		accessList := tx.AccessList()
+		rpcTx.ChainID = (*hexutil.Big)(tx.ChainID())
+		rpcTx.Accesses = &accessList
 	}
 
 	if blockHash != (common.Hash{}) {
@@ -68,6 +76,39 @@ func NewTransaction(tx *evmtypes.MsgEthereumTx, txHash, blockHash common.Hash, b
 	return rpcTx, nil
 }
 
+// FormatReceipt returns the JSON-RPC representation of a receipt persisted by the evm receipt store
+func FormatReceipt(receipt *receipts.Receipt) map[string]interface{} {
+	logs := receipt.Logs
+	if logs == nil {
+		logs = []*ethtypes.Log{}
+	}
+
+	return map[string]interface{}{
+		// Consensus fields: These fields are defined by the Yellow Paper
+		"type":              hexutil.Uint64(receipt.Type),
+		"status":            hexutil.Uint(receipt.Status),
+		"cumulativeGasUsed": hexutil.Uint64(receipt.CumulativeGasUsed),
+		"logsBloom":         receipt.Bloom,
+		"logs":              logs,
+
+		// Implementation fields: These fields are added by geth when processing a transaction.
+		"transactionHash":   receipt.TxHash,
+		"contractAddress":   receipt.ContractAddress,
+		"gasUsed":           hexutil.Uint64(receipt.GasUsed),
+		"effectiveGasPrice": (*hexutil.Big)(receipt.EffectiveGasPrice),
+
+		// Inclusion information: These fields provide information about the inclusion of the
+		// transaction corresponding to this receipt.
+		"blockHash":        receipt.BlockHash,
+		"blockNumber":      hexutil.Uint64(receipt.BlockNumber),
+		"transactionIndex": hexutil.Uint64(receipt.TransactionIndex),
+
+		// sender and receiver (contract or EOA) addresses
+		"from": receipt.From,
+		"to":   receipt.To,
+	}
+}
+
 // EthBlockFromTendermint returns a JSON-RPC compatible Ethereum blockfrom a given Tendermint block.
 func EthBlockFromTendermint(clientCtx clientcontext.CLIContext, block *tmtypes.Block) (map[string]interface{}, error) {
 	gasLimit, err := BlockMaxGasFromConsensusParams(context.Background(), clientCtx)
@@ -0,0 +1,27 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common/proto"
+)
+
+// UpgradeVersion1 is the first protocol version upgraded to by an app upgrade proposal
+const UpgradeVersion1 uint64 = 1
+
+// setUpgradeHandlers registers the handlers migrating the stores to the protocol versions supported by this software.
+// A node halts at the height of a signalled upgrade if the handler of its version isn't registered
+func (app *OKExChainApp) setUpgradeHandlers() {
+	app.UpgradeKeeper.SetUpgradeHandler(UpgradeVersion1, func(ctx sdk.Context, _ proto.ProtocolDefinition) {
+		// the params added to the modules since version 0 aren't in the stores of the running chain yet
+		app.OrderKeeper.MigrateParams(ctx)
+		app.DexKeeper.MigrateParams(ctx)
+		app.EvmKeeper.MigrateParams(ctx)
+		// the modules added since version 0 have no state on the running chain yet
+		app.MakerIncentiveKeeper.InitStore(ctx)
+		// the store of the upgrade module is taken over from the upgrade module of the cosmos sdk
+		app.UpgradeKeeper.DeleteLegacyKeys(ctx)
+		// the open orders placed before version 1 aren't in the index of their senders
+		app.OrderKeeper.MigrateAccountOrderIndex(ctx)
+	})
+}
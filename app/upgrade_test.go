@@ -0,0 +1,38 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/x/common/proto"
+)
+
+func TestUpgradeHandlers(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewOKExChainApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)), db, nil, true, map[int64]bool{}, 0)
+
+	genesisState := ModuleBasics.DefaultGenesis()
+	stateBytes, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	app.Commit()
+
+	handler, found := app.UpgradeKeeper.GetUpgradeHandler(UpgradeVersion1)
+	require.True(t, found)
+
+	// the params set at genesis are kept
+	ctx := app.NewContext(true, abci.Header{})
+	orderParams, dexParams := app.OrderKeeper.GetParams(ctx), app.DexKeeper.GetParams(ctx)
+	evmParams, incentiveEpoch := app.EvmKeeper.GetParams(ctx), app.MakerIncentiveKeeper.GetEpoch(ctx)
+	handler(ctx, proto.ProtocolDefinition{Version: UpgradeVersion1})
+	require.Equal(t, orderParams, app.OrderKeeper.GetParams(ctx))
+	require.Equal(t, dexParams, app.DexKeeper.GetParams(ctx))
+	require.Equal(t, evmParams, app.EvmKeeper.GetParams(ctx))
+	require.Equal(t, incentiveEpoch, app.MakerIncentiveKeeper.GetEpoch(ctx))
+}
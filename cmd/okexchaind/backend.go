@@ -0,0 +1,221 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/mock"
+	"github.com/tendermint/tendermint/node"
+	"github.com/tendermint/tendermint/proxy"
+	sm "github.com/tendermint/tendermint/state"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+
+	"github.com/okex/okexchain/app"
+	"github.com/okex/okexchain/x/evm/logindex"
+	"github.com/okex/okexchain/x/evm/receipts"
+)
+
+const (
+	backendReindexDir        = "backend-reindex"
+	backendReindexCheckpoint = "checkpoint.json"
+	// number of blocks between two checkpoints
+	backendReindexCheckpointInterval = 100
+)
+
+// the stream configs disabled while reindexing, nothing is pushed to the streams or registered to the services
+var backendReindexDisabledStreamConfigs = []string{
+	"stream.engine",
+	"stream.klines_query_connect",
+	"stream.eureka_server_url",
+	"stream.rest_nacos_urls",
+}
+
+// backendReindexProgress is the checkpoint of the reindex, which is saved in the reindex directory
+type backendReindexProgress struct {
+	From   int64 `json:"from"`
+	To     int64 `json:"to"`
+	Height int64 `json:"height"`
+}
+
+func backendCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backend",
+		Short: "Maintain the backend database",
+	}
+	cmd.AddCommand(backendReindexCmd(ctx))
+	return cmd
+}
+
+func backendReindexCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reindex",
+		Short: "Reindex the backend database from the local block store, the node must be stopped",
+		Long: fmt.Sprintf(`Reindex the backend database configured in app.toml from the local block store, the node must be stopped.
+
+The blocks are executed from the genesis on a separate state in $HOME/data/%s, so the state of the node is never
+touched. Only the blocks within [from, to] are stored into the backend database. The progress is checkpointed in
+the same directory, and the reindex resumes from where it stopped if it's run again with the same --from. Remove the
+directory to start over.`, backendReindexDir),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.Println("--------- backend reindex start ---------")
+			if err := reindexBackend(ctx, viper.GetInt64(flagRebuildFrom), viper.GetInt64(flagRebuildTo)); err != nil {
+				return err
+			}
+			log.Println("--------- backend reindex success ---------")
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagRebuildFrom, 1, "Height of the first block to store into the backend database")
+	cmd.Flags().Int64(flagRebuildTo, 0, "Height of the last block to store into the backend database, the latest block height is used if it's 0")
+	return cmd
+}
+
+// reindexBackend replays the blocks up to the height to on the reindex state and stores the ones within [from, to]
+// into the backend database
+func reindexBackend(ctx *server.Context, from, to int64) error {
+	dataDir := filepath.Join(ctx.Config.RootDir, "data")
+	blockStoreDB, err := openDB(blockStoreDB, dataDir)
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	if to == 0 || to > blockStore.Height() {
+		to = blockStore.Height()
+	}
+	if from < 1 || from > to {
+		return fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+
+	reindexDir := filepath.Join(dataDir, backendReindexDir)
+	progress, err := loadBackendReindexProgress(reindexDir)
+	if err != nil {
+		return err
+	}
+	if progress != nil && progress.From != from {
+		return fmt.Errorf("the reindex from %d has been checkpointed at %d, remove %s to reindex from %d",
+			progress.From, progress.Height, reindexDir, from)
+	}
+
+	appConfig, err := config.ParseConfig()
+	if err != nil {
+		return err
+	}
+	if !appConfig.BackendConfig.EnableBackend {
+		return fmt.Errorf("the backend is not enabled in app.toml")
+	}
+	for _, key := range backendReindexDisabledStreamConfigs {
+		viper.Set(key, "")
+	}
+	// the node-local stores of evm under the data directory belong to the node, the replay never writes them
+	viper.Set(logindex.FlagEnableLogIndex, false)
+	viper.Set(receipts.FlagEnableReceipts, false)
+
+	appDB, err := openDB(applicationDB, reindexDir)
+	if err != nil {
+		return err
+	}
+	defer appDB.Close()
+	okexchainApp := app.NewOKExChainApp(ctx.Logger, appDB, nil, true, map[int64]bool{}, 0)
+	defer okexchainApp.BackendKeeper.Stop()
+	proxyApp, err := createAndStartProxyAppConns(proxy.NewLocalClientCreator(okexchainApp))
+	if err != nil {
+		return err
+	}
+	defer proxyApp.Stop()
+
+	stateStoreDB, err := openDB(stateDB, reindexDir)
+	if err != nil {
+		return err
+	}
+	defer stateStoreDB.Close()
+	state, genDoc, err := node.LoadStateFromDBOrGenesisDocProvider(stateStoreDB, node.DefaultGenesisDocProviderFunc(ctx.Config))
+	if err != nil {
+		return err
+	}
+	if state.LastBlockHeight == types.GetStartBlockHeight() && okexchainApp.LastBlockHeight() == types.GetStartBlockHeight() {
+		if err := initChain(state, stateStoreDB, genDoc, proxyApp); err != nil {
+			return err
+		}
+		state = sm.LoadState(stateStoreDB)
+	}
+	if state.LastBlockHeight != okexchainApp.LastBlockHeight() {
+		return fmt.Errorf("the reindex state at %d doesn't match the application at %d, remove %s to start over",
+			state.LastBlockHeight, okexchainApp.LastBlockHeight(), reindexDir)
+	}
+	if state.LastBlockHeight >= to {
+		return fmt.Errorf("the blocks up to %d have been reindexed", state.LastBlockHeight)
+	}
+	if err := saveBackendReindexProgress(reindexDir, backendReindexProgress{From: from, To: to, Height: state.LastBlockHeight}); err != nil {
+		return err
+	}
+	// the block after the state may be stored into the backend database partly before the reindex stopped, its records
+	// are deleted before it's executed again
+	if progress != nil && state.LastBlockHeight+1 >= from {
+		if err := okexchainApp.BackendKeeper.Orm.DeleteBlockRecords(state.LastBlockHeight + 1); err != nil {
+			return err
+		}
+	}
+	log.Println("reindex resumes from", state.LastBlockHeight+1)
+
+	backendConfig := okexchainApp.BackendKeeper.Config
+	blockExec := sm.NewBlockExecutor(stateStoreDB, ctx.Logger, proxyApp.Consensus(), mock.Mempool{}, sm.MockEvidencePool{})
+	for height := state.LastBlockHeight + 1; height <= to; height++ {
+		block := blockStore.LoadBlock(height)
+		meta := blockStore.LoadBlockMeta(height)
+		if block == nil || meta == nil {
+			return fmt.Errorf("block %d not found in the block store", height)
+		}
+
+		// the blocks before from are only executed to build the state
+		backendConfig.EnableBackend = height >= from
+		if state, _, err = blockExec.ApplyBlock(state, meta.BlockID, block); err != nil {
+			return err
+		}
+		if !backendConfig.EnableBackend {
+			okexchainApp.BackendKeeper.Flush()
+		}
+
+		if height%backendReindexCheckpointInterval == 0 || height == to {
+			if err := saveBackendReindexProgress(reindexDir, backendReindexProgress{From: from, To: to, Height: height}); err != nil {
+				return err
+			}
+			log.Println("reindexed", height)
+		}
+	}
+	return nil
+}
+
+func loadBackendReindexProgress(reindexDir string) (*backendReindexProgress, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(reindexDir, backendReindexCheckpoint))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var progress backendReindexProgress
+	if err := json.Unmarshal(bz, &progress); err != nil {
+		return nil, err
+	}
+	return &progress, nil
+}
+
+func saveBackendReindexProgress(reindexDir string, progress backendReindexProgress) error {
+	bz, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(reindexDir, backendReindexCheckpoint), bz, 0644)
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/store"
+
+	"github.com/okex/okexchain/app"
+	"github.com/okex/okexchain/x/evm/logindex"
+)
+
+const (
+	flagRebuildFrom = "from"
+	flagRebuildTo   = "to"
+)
+
+func logIndexCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log-index",
+		Short: "Maintain the node-local evm log index",
+	}
+	cmd.AddCommand(logIndexRebuildCmd(ctx))
+	return cmd
+}
+
+func logIndexRebuildCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Rebuild the evm log index from the local block and application db, the node must be stopped",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			log.Println("--------- log index rebuild start ---------")
+			if err := rebuildLogIndex(ctx, viper.GetInt64(flagRebuildFrom), viper.GetInt64(flagRebuildTo)); err != nil {
+				return err
+			}
+			log.Println("--------- log index rebuild success ---------")
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagRebuildFrom, 1, "Height of the first block to index")
+	cmd.Flags().Int64(flagRebuildTo, 0, "Height of the last block to index, the latest block height is used if it's 0")
+	return cmd
+}
+
+// rebuildLogIndex indexes the logs of the blocks within [from, to] which are stored in the evm store
+func rebuildLogIndex(ctx *server.Context, from, to int64) error {
+	dataDir := filepath.Join(ctx.Config.RootDir, "data")
+	blockStoreDB, err := openDB(blockStoreDB, dataDir)
+	if err != nil {
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := store.NewBlockStore(blockStoreDB)
+
+	if to == 0 || to > blockStore.Height() {
+		to = blockStore.Height()
+	}
+	if from < 1 || from > to {
+		return fmt.Errorf("invalid block range [%d, %d]", from, to)
+	}
+
+	appDB, err := openDB(applicationDB, dataDir)
+	if err != nil {
+		return err
+	}
+	defer appDB.Close()
+	okexchainApp := app.NewOKExChainApp(ctx.Logger, appDB, nil, true, map[int64]bool{}, 0)
+	queryCtx := okexchainApp.NewContext(true, abci.Header{})
+
+	// the app shares the global log index if it's enabled by the flag
+	idx, err := logindex.OpenGlobalIndex(dataDir, logindex.GetConfig())
+	if err != nil {
+		return err
+	}
+	defer idx.Close()
+	if idx.BaseHeight() != 0 && from > idx.LatestHeight()+1 {
+		return fmt.Errorf("the blocks within [%d, %d] must be indexed first", idx.LatestHeight()+1, from-1)
+	}
+
+	for height := from; height <= to; height++ {
+		block := blockStore.LoadBlock(height)
+		if block == nil {
+			return fmt.Errorf("block %d not found in the block store", height)
+		}
+
+		var logs []*ethtypes.Log
+		for _, tx := range block.Txs {
+			txLogs, err := okexchainApp.EvmKeeper.GetLogs(queryCtx, common.BytesToHash(tx.Hash()))
+			if err != nil {
+				return err
+			}
+			logs = append(logs, txLogs...)
+		}
+
+		if err := idx.IndexBlock(height, logs); err != nil {
+			return err
+		}
+		if height%1000 == 0 {
+			log.Println("indexed", height)
+		}
+	}
+
+	// move the base height back only if the rebuilt range joins the blocks which have been indexed
+	if base := idx.BaseHeight(); from < base && to >= base-1 {
+		return idx.SetBaseHeight(from)
+	}
+	return nil
+}
@@ -26,8 +26,11 @@ import (
 	"github.com/okex/okexchain/app"
 	"github.com/okex/okexchain/app/codec"
 	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
+	"github.com/okex/okexchain/app/rpc"
 	okexchain "github.com/okex/okexchain/app/types"
 	"github.com/okex/okexchain/cmd/client"
+	"github.com/okex/okexchain/x/evm/logindex"
+	"github.com/okex/okexchain/x/evm/receipts"
 	"github.com/okex/okexchain/x/genutil"
 	genutilcli "github.com/okex/okexchain/x/genutil/client/cli"
 	genutiltypes "github.com/okex/okexchain/x/genutil/types"
@@ -77,6 +80,8 @@ func main() {
 		genutilcli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics),
 		client.TestnetCmd(ctx, cdc, app.ModuleBasics, auth.GenesisAccountIterator{}),
 		replayCmd(ctx),
+		logIndexCmd(ctx),
+		backendCmd(ctx),
 		// AddGenesisAccountCmd allows users to add accounts to the genesis file
 		AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
 		flags.NewCompletionCmd(rootCmd, true),
@@ -89,6 +94,9 @@ func main() {
 	executor := cli.PrepareBaseCmd(rootCmd, "OKEXCHAIN", app.DefaultNodeHome)
 	rootCmd.PersistentFlags().UintVar(&invCheckPeriod, flagInvCheckPeriod,
 		0, "Assert registered invariants every N blocks")
+	logindex.AddFlags(rootCmd)
+	receipts.AddFlags(rootCmd)
+	rpc.AddLimitFlags(rootCmd)
 	err := executor.Execute()
 	if err != nil {
 		panic(err)
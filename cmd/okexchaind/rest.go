@@ -13,15 +13,18 @@ import (
 	"github.com/okex/okexchain/app/rpc"
 	"github.com/okex/okexchain/app/types"
 	ammswaprest "github.com/okex/okexchain/x/ammswap/client/rest"
+	backendgraphql "github.com/okex/okexchain/x/backend/client/graphql"
 	backendrest "github.com/okex/okexchain/x/backend/client/rest"
 	dexrest "github.com/okex/okexchain/x/dex/client/rest"
 	dist "github.com/okex/okexchain/x/distribution"
 	distrest "github.com/okex/okexchain/x/distribution/client/rest"
 	farmrest "github.com/okex/okexchain/x/farm/client/rest"
+	makerincentiverest "github.com/okex/okexchain/x/makerincentive/client/rest"
 	orderrest "github.com/okex/okexchain/x/order/client/rest"
 	stakingrest "github.com/okex/okexchain/x/staking/client/rest"
 	"github.com/okex/okexchain/x/token"
 	tokensrest "github.com/okex/okexchain/x/token/client/rest"
+	upgraderest "github.com/okex/okexchain/x/upgrade/client/rest"
 	"github.com/spf13/viper"
 )
 
@@ -36,6 +39,7 @@ func registerRoutes(rs *lcd.RestServer) {
 	}
 	registerRoutesV1(rs, pathPrefix)
 	registerRoutesV2(rs, pathPrefix)
+	registerGraphQL(rs, pathPrefix)
 }
 
 func registerRoutesV1(rs *lcd.RestServer, pathPrefix string) {
@@ -54,6 +58,8 @@ func registerRoutesV1(rs *lcd.RestServer, pathPrefix string) {
 	ammswaprest.RegisterRoutes(rs.CliCtx, v1Router)
 	supplyrest.RegisterRoutes(rs.CliCtx, v1Router)
 	farmrest.RegisterRoutes(rs.CliCtx, v1Router)
+	makerincentiverest.RegisterRoutes(rs.CliCtx, v1Router)
+	upgraderest.RegisterRoutes(rs.CliCtx, v1Router)
 }
 
 func registerRoutesV2(rs *lcd.RestServer, pathPrefix string) {
@@ -68,3 +74,8 @@ func registerRoutesV2(rs *lcd.RestServer, pathPrefix string) {
 	tokensrest.RegisterRoutesV2(rs.CliCtx, v2Router, token.StoreKey)
 	backendrest.RegisterRoutesV2(rs.CliCtx, v2Router)
 }
+
+func registerGraphQL(rs *lcd.RestServer, pathPrefix string) {
+	router := rs.Mux.PathPrefix(fmt.Sprintf("/%s", pathPrefix)).Name("graphql").Subrouter()
+	backendgraphql.RegisterRoutes(rs.CliCtx, router)
+}
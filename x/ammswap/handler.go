@@ -167,6 +167,7 @@ func handleMsgAddLiquidity(ctx sdk.Context, k Keeper, msg types.MsgAddLiquidity)
 	if err != nil {
 		return sdk.ErrInternal( "failed to mint pool token").Result()
 	}
+	k.OnAddLiquidity(ctx, msg.Sender, swapTokenPair, baseTokens, msg.QuoteAmount, liquidity)
 
 	event.AppendAttributes(sdk.NewAttribute("liquidity", liquidity.String()))
 	event.AppendAttributes(sdk.NewAttribute("baseAmount", baseTokens.String()))
@@ -225,6 +226,7 @@ func handleMsgRemoveLiquidity(ctx sdk.Context, k Keeper, msg types.MsgRemoveLiqu
 	if err != nil {
 		return sdk.ErrInternal( fmt.Sprintf("Failed to burn pool token: %s", err.Error())).Result()
 	}
+	k.OnRemoveLiquidity(ctx, msg.Sender, swapTokenPair, baseAmount, quoteAmount, liquidity)
 
 	event.AppendAttributes(sdk.NewAttribute("quoteAmount", quoteAmount.String()))
 	event.AppendAttributes(sdk.NewAttribute("baseAmount", baseAmount.String()))
@@ -212,3 +212,15 @@ func (k Keeper) OnCreateExchange(ctx sdk.Context, swapTokenPair types.SwapTokenP
 		observer.OnSwapCreateExchange(ctx, swapTokenPair)
 	}
 }
+
+func (k Keeper) OnAddLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair types.SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	for _, observer := range k.ObserverKeeper {
+		observer.OnAddLiquidity(ctx, address, swapTokenPair, baseAmount, quoteAmount, liquidity)
+	}
+}
+
+func (k Keeper) OnRemoveLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair types.SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	for _, observer := range k.ObserverKeeper {
+		observer.OnRemoveLiquidity(ctx, address, swapTokenPair, baseAmount, quoteAmount, liquidity)
+	}
+}
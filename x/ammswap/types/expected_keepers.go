@@ -45,4 +45,6 @@ type TokenKeeper interface {
 type BackendKeeper interface {
 	OnSwapToken(ctx sdk.Context, address sdk.AccAddress, swapTokenPair SwapTokenPair, sellAmount sdk.SysCoin, buyAmount sdk.SysCoin)
 	OnSwapCreateExchange(ctx sdk.Context, swapTokenPair SwapTokenPair)
+	OnAddLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec)
+	OnRemoveLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec)
 }
@@ -19,10 +19,13 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) {
 		// store data to db
 		storeNewOrders(ctx, keeper)
 		updateOrders(ctx, keeper)
-		storeDealAndMatchResult(ctx, keeper)
-		storeFeeDetails(keeper)
+		deals := storeDealAndMatchResult(ctx, keeper)
+		storeFeeDetails(ctx, keeper)
 		storeTransactions(keeper)
 		storeSwapInfos(keeper)
+		storeHistoryInfos(keeper)
+		storeProductHalts(ctx, keeper)
+		storePositions(ctx, keeper, deals)
 		keeper.EmitAllWsItems(ctx)
 		// refresh cache
 		keeper.Flush()
@@ -40,6 +43,74 @@ func storeSwapInfos(keeper Keeper) {
 	} else {
 		keeper.Logger.Debug(fmt.Sprintf("[backend] Expect to insert %d swapInfos, inserted Count %d", total, count))
 	}
+
+	// update ticker of the swapped token pairs
+	var productList []string
+	swappedPairs := map[string]bool{}
+	for _, swapInfo := range swapInfos {
+		if swapInfo.SwapPrice > 0 && !swappedPairs[swapInfo.TokenPairName] {
+			swappedPairs[swapInfo.TokenPairName] = true
+			productList = append(productList, types.SwapKlineProduct(swapInfo.TokenPairName))
+		}
+	}
+	if len(productList) > 0 {
+		ts := keeper.Orm.GetMaxBlockTimestamp()
+		keeper.UpdateTickersBuffer(ts-types.SecondsInADay, ts+1, productList)
+	}
+}
+
+// storeHistoryInfos stores the liquidity, the farm and the staking infos of the block
+func storeHistoryInfos(keeper Keeper) {
+	defer types.PrintStackIfPanic()
+
+	liquidityInfos := keeper.Cache.GetLiquidityInfos()
+	if len(liquidityInfos) > 0 {
+		count, err := keeper.Orm.AddLiquidityInfos(liquidityInfos)
+		if err != nil {
+			keeper.Logger.Error(fmt.Sprintf("[backend] Expect to insert %d liquidityInfos, inserted Count %d, err: %+v", len(liquidityInfos), count, err))
+		}
+	}
+
+	farmInfos := keeper.Cache.GetFarmInfos()
+	if len(farmInfos) > 0 {
+		count, err := keeper.Orm.AddFarmInfos(farmInfos)
+		if err != nil {
+			keeper.Logger.Error(fmt.Sprintf("[backend] Expect to insert %d farmInfos, inserted Count %d, err: %+v", len(farmInfos), count, err))
+		}
+	}
+
+	stakingInfos := keeper.Cache.GetStakingInfos()
+	if len(stakingInfos) > 0 {
+		count, err := keeper.Orm.AddStakingInfos(stakingInfos)
+		if err != nil {
+			keeper.Logger.Error(fmt.Sprintf("[backend] Expect to insert %d stakingInfos, inserted Count %d, err: %+v", len(stakingInfos), count, err))
+		}
+	}
+}
+
+// storeProductHalts stores the matching of the products paused by the circuit breaker in the block
+func storeProductHalts(ctx sdk.Context, keeper Keeper) {
+	defer types.PrintStackIfPanic()
+
+	orderHalts := keeper.OrderKeeper.GetBlockProductHalts()
+	if len(orderHalts) == 0 {
+		return
+	}
+	halts := make([]*types.ProductHalt, 0, len(orderHalts))
+	for _, halt := range orderHalts {
+		halts = append(halts, &types.ProductHalt{
+			Product:     halt.Product,
+			BlockHeight: halt.BlockHeight,
+			EndHeight:   halt.EndHeight,
+			Price:       halt.Price.String(),
+			RefPrice:    halt.RefPrice.String(),
+			Timestamp:   ctx.BlockHeader().Time.Unix(),
+		})
+	}
+	count, err := keeper.Orm.AddProductHalts(halts)
+	if err != nil {
+		keeper.Logger.Error(fmt.Sprintf("[backend] Expect to insert %d productHalts, inserted Count %d, err: %+v", len(halts), count, err))
+	}
 }
 
 func storeTransactions(keeper Keeper) {
@@ -56,7 +127,7 @@ func storeTransactions(keeper Keeper) {
 	}
 }
 
-func storeDealAndMatchResult(ctx sdk.Context, keeper Keeper) {
+func storeDealAndMatchResult(ctx sdk.Context, keeper Keeper) []*types.Deal {
 	timestamp := ctx.BlockHeader().Time.Unix()
 	keeper.Orm.SetMaxBlockTimestamp(timestamp)
 	deals, results, err := GetNewDealsAndMatchResultsAtEndBlock(ctx, keeper.OrderKeeper)
@@ -91,10 +162,36 @@ func storeDealAndMatchResult(ctx sdk.Context, keeper Keeper) {
 		ts := keeper.Orm.GetMaxBlockTimestamp()
 		keeper.UpdateTickersBuffer(ts-types.SecondsInADay, ts+1, productList)
 	}
+	return deals
 }
 
-func storeFeeDetails(keeper Keeper) {
+// storePositions applies the deals and the swaps of the block to the positions
+func storePositions(ctx sdk.Context, keeper Keeper, deals []*types.Deal) {
+	defer types.PrintStackIfPanic()
+
+	var trades []types.PositionTrade
+	for _, deal := range deals {
+		trades = append(trades, types.NewPositionTradeFromDeal(deal))
+	}
+	for _, swapInfo := range keeper.Cache.GetSwapInfos() {
+		if trade, ok := types.NewPositionTradeFromSwap(swapInfo, ctx.BlockHeight()); ok {
+			trades = append(trades, trade)
+		}
+	}
+
+	if len(trades) > 0 {
+		cnt, err := keeper.Orm.UpdatePositions(trades)
+		if err != nil {
+			keeper.Logger.Error(fmt.Sprintf("[backend] Expect to apply %d trades to positions, applied Count %d, err: %+v", len(trades), cnt, err))
+		}
+	}
+}
+
+func storeFeeDetails(ctx sdk.Context, keeper Keeper) {
 	feeDetails := keeper.TokenKeeper.GetFeeDetailList()
+	for _, feeDetail := range feeDetails {
+		feeDetail.BlockHeight = ctx.BlockHeight()
+	}
 	if len(feeDetails) > 0 {
 		cnt, err := keeper.Orm.AddFeeDetails(feeDetails)
 		if err != nil {
@@ -178,6 +275,7 @@ func GetNewDealsAndMatchResultsAtEndBlock(ctx sdk.Context, orderKeeper types.Ord
 					Fee:         record.Fee,
 					Timestamp:   ctx.BlockHeader().Time.Unix(),
 					FeeReceiver: record.FeeReceiver,
+					Role:        record.Role,
 				}
 				deals = append(deals, deal)
 
@@ -12,6 +12,10 @@ type Cache struct {
 
 	// swap infos, flush at EndBlocker
 	swapInfos []*types.SwapInfo
+	// liquidity, farm and staking infos, flush at EndBlocker
+	liquidityInfos []*types.LiquidityInfo
+	farmInfos      []*types.FarmInfo
+	stakingInfos   []*types.StakingInfo
 }
 
 // NewCache return  cache pointer address, called at NewKeeper
@@ -27,6 +31,9 @@ func NewCache() *Cache {
 func (c *Cache) Flush() {
 	c.Transactions = make([]*types.Transaction, 0, 2000)
 	c.swapInfos = make([]*types.SwapInfo, 0, 2000)
+	c.liquidityInfos = nil
+	c.farmInfos = nil
+	c.stakingInfos = nil
 }
 
 // AddTransaction append transaction to cache Transactions
@@ -48,3 +55,33 @@ func (c *Cache) AddSwapInfo(swapInfo *types.SwapInfo) {
 func (c *Cache) GetSwapInfos() []*types.SwapInfo {
 	return c.swapInfos
 }
+
+// AddLiquidityInfo appends liquidityInfo to cache liquidityInfos
+func (c *Cache) AddLiquidityInfo(liquidityInfo *types.LiquidityInfo) {
+	c.liquidityInfos = append(c.liquidityInfos, liquidityInfo)
+}
+
+// nolint
+func (c *Cache) GetLiquidityInfos() []*types.LiquidityInfo {
+	return c.liquidityInfos
+}
+
+// AddFarmInfo appends farmInfo to cache farmInfos
+func (c *Cache) AddFarmInfo(farmInfo *types.FarmInfo) {
+	c.farmInfos = append(c.farmInfos, farmInfo)
+}
+
+// nolint
+func (c *Cache) GetFarmInfos() []*types.FarmInfo {
+	return c.farmInfos
+}
+
+// AddStakingInfo appends stakingInfo to cache stakingInfos
+func (c *Cache) AddStakingInfo(stakingInfo *types.StakingInfo) {
+	c.stakingInfos = append(c.stakingInfos, stakingInfo)
+}
+
+// nolint
+func (c *Cache) GetStakingInfos() []*types.StakingInfo {
+	return c.stakingInfos
+}
@@ -0,0 +1,148 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// GetCmdLiquidityHistory queries the liquidity added and removed
+func GetCmdLiquidityHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidity-history",
+		Short: "get the history of adding and removing liquidity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			addr, errAddr := flags.GetString("address")
+			swapPair, errSwapPair := flags.GetString("swap-pair")
+			startTime, errST := flags.GetInt64("start")
+			endTime, errET := flags.GetInt64("end")
+			page, errPage := flags.GetInt("page")
+			perPage, errPerPage := flags.GetInt("per-page")
+
+			mError := types.NewErrorsMerged(errAddr, errSwapPair, errST, errET, errPage, errPerPage)
+			if mError != nil {
+				return mError
+			}
+
+			params := types.NewQueryLiquidityInfoParams(addr, swapPair, startTime, endTime, page, perPage)
+			return queryHistory(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryLiquidityList), params)
+		},
+	}
+	cmd.Flags().String("address", "", "filter the history by address")
+	cmd.Flags().String("swap-pair", "", "filter the history by swap token pair")
+	addHistoryFlags(cmd)
+	return cmd
+}
+
+// GetCmdFarmHistory queries the locks, the unlocks and the claims of the farm pools
+func GetCmdFarmHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "farm-history",
+		Short: "get the history of locking, unlocking and claiming in the farm pools",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			addr, errAddr := flags.GetString("address")
+			poolName, errPoolName := flags.GetString("pool-name")
+			startTime, errST := flags.GetInt64("start")
+			endTime, errET := flags.GetInt64("end")
+			page, errPage := flags.GetInt("page")
+			perPage, errPerPage := flags.GetInt("per-page")
+
+			mError := types.NewErrorsMerged(errAddr, errPoolName, errST, errET, errPage, errPerPage)
+			if mError != nil {
+				return mError
+			}
+
+			params := types.NewQueryFarmInfoParams(addr, poolName, startTime, endTime, page, perPage)
+			return queryHistory(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFarmList), params)
+		},
+	}
+	cmd.Flags().String("address", "", "filter the history by address")
+	cmd.Flags().String("pool-name", "", "filter the history by farm pool")
+	addHistoryFlags(cmd)
+	return cmd
+}
+
+// GetCmdStakingHistory queries the deposits, the withdrawals and the shares added by the delegators
+func GetCmdStakingHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staking-history",
+		Short: "get the history of depositing, withdrawing and adding shares",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			addr, errAddr := flags.GetString("address")
+			validator, errValidator := flags.GetString("validator")
+			startTime, errST := flags.GetInt64("start")
+			endTime, errET := flags.GetInt64("end")
+			page, errPage := flags.GetInt("page")
+			perPage, errPerPage := flags.GetInt("per-page")
+
+			mError := types.NewErrorsMerged(errAddr, errValidator, errST, errET, errPage, errPerPage)
+			if mError != nil {
+				return mError
+			}
+
+			params := types.NewQueryStakingInfoParams(addr, validator, startTime, endTime, page, perPage)
+			return queryHistory(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryStakingList), params)
+		},
+	}
+	cmd.Flags().String("address", "", "filter the history by delegator address")
+	cmd.Flags().String("validator", "", "filter the history by validator address the shares added to")
+	addHistoryFlags(cmd)
+	return cmd
+}
+
+// GetCmdHaltHistory queries the matching of the products paused by the circuit breaker
+func GetCmdHaltHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halt-history",
+		Short: "get the history of the matching paused by the circuit breaker",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			product, errProduct := flags.GetString("product")
+			startTime, errST := flags.GetInt64("start")
+			endTime, errET := flags.GetInt64("end")
+			page, errPage := flags.GetInt("page")
+			perPage, errPerPage := flags.GetInt("per-page")
+
+			mError := types.NewErrorsMerged(errProduct, errST, errET, errPage, errPerPage)
+			if mError != nil {
+				return mError
+			}
+
+			params := types.NewQueryProductHaltParams(product, startTime, endTime, page, perPage)
+			return queryHistory(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryProductHalts), params)
+		},
+	}
+	cmd.Flags().String("product", "", "filter the history by product")
+	addHistoryFlags(cmd)
+	return cmd
+}
+
+func addHistoryFlags(cmd *cobra.Command) {
+	cmd.Flags().Int64("start", 0, "filter the history by >= start timestamp")
+	cmd.Flags().Int64("end", 0, "filter the history by < end timestamp")
+	cmd.Flags().Int("page", 1, "page num")
+	cmd.Flags().Int("per-page", 50, "items per page")
+}
+
+func queryHistory(cdc *codec.Codec, route string, params interface{}) error {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return err
+	}
+
+	res, _, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(res))
+	return nil
+}
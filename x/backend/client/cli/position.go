@@ -0,0 +1,78 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// GetCmdPositions queries the positions of an address
+func GetCmdPositions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [address]",
+		Short: "get the positions and the realized pnl of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			product, errProduct := flags.GetString("product")
+			page, errPage := flags.GetInt("page")
+			perPage, errPerPage := flags.GetInt("per-page")
+
+			mError := types.NewErrorsMerged(errProduct, errPage, errPerPage)
+			if mError != nil {
+				return mError
+			}
+
+			params := types.NewQueryPositionParams(args[0], product, page, perPage)
+			return queryHistory(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPositionList), params)
+		},
+	}
+	cmd.Flags().String("product", "", "filter the positions by product, e.g. ammswap_xxb_okt for a swap token pair")
+	cmd.Flags().Int("page", 1, "page num")
+	cmd.Flags().Int("per-page", 50, "items per page")
+	return cmd
+}
+
+// GetCmdPositionDaily queries the daily snapshots of the positions of an address
+func GetCmdPositionDaily(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position-daily [address]",
+		Short: "get the daily snapshots of the positions of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			flags := cmd.Flags()
+			product, errProduct := flags.GetString("product")
+			startTime, errST := flags.GetInt64("start")
+			endTime, errET := flags.GetInt64("end")
+			page, errPage := flags.GetInt("page")
+			perPage, errPerPage := flags.GetInt("per-page")
+
+			mError := types.NewErrorsMerged(errProduct, errST, errET, errPage, errPerPage)
+			if mError != nil {
+				return mError
+			}
+
+			params := types.NewQueryPositionSnapshotParams(args[0], product, startTime, endTime, page, perPage)
+			return queryHistory(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPositionDaily), params)
+		},
+	}
+	cmd.Flags().String("product", "", "filter the snapshots by product")
+	addHistoryFlags(cmd)
+	return cmd
+}
+
+// GetCmdFeeTier queries the fee tier of an address
+func GetCmdFeeTier(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-tier [address]",
+		Short: "get the fee tier of an address by its trade volume in the last 30 days",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := types.NewQueryFeeTierParams(args[0])
+			return queryHistory(cdc, fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryFeeTier), params)
+		},
+	}
+}
@@ -32,6 +32,13 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdTickers(queryRoute, cdc),
 		GetCmdTxList(queryRoute, cdc),
 		GetBlockTxHashesCommand(queryRoute, cdc),
+		GetCmdLiquidityHistory(queryRoute, cdc),
+		GetCmdFarmHistory(queryRoute, cdc),
+		GetCmdStakingHistory(queryRoute, cdc),
+		GetCmdPositions(queryRoute, cdc),
+		GetCmdPositionDaily(queryRoute, cdc),
+		GetCmdFeeTier(queryRoute, cdc),
+		GetCmdHaltHistory(queryRoute, cdc),
 	)...)
 
 	return queryCmd
@@ -240,7 +247,7 @@ func GetCmdFeeDetails(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				return mError
 			}
 
-			params := types.NewQueryFeeDetailsParams(addr, page, perPage)
+			params := types.NewQueryFeeDetailsParams(addr, 0, 0, page, perPage)
 			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
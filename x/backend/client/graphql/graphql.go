@@ -0,0 +1,33 @@
+package graphql
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	graphqlgo "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+)
+
+const (
+	// maxQueryDepth is the max depth of the selections of a query
+	maxQueryDepth = 8
+	// maxQueryParallelism is the max number of the fields resolved concurrently for a query
+	maxQueryParallelism = 8
+)
+
+// NewSchema parses the graphql schema of the backend data resolved by the resolver
+func NewSchema(resolver *Resolver) (*graphqlgo.Schema, error) {
+	return graphqlgo.ParseSchema(schema, resolver,
+		graphqlgo.UseFieldResolvers(),
+		graphqlgo.MaxDepth(maxQueryDepth),
+		graphqlgo.MaxParallelism(maxQueryParallelism),
+	)
+}
+
+// RegisterRoutes registers the graphql endpoint to the router of the rest server
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	gqlSchema, err := NewSchema(NewResolver(cliCtx))
+	if err != nil {
+		panic(err)
+	}
+	r.Handle("/graphql", &relay.Handler{Schema: gqlSchema}).Methods("POST")
+}
@@ -0,0 +1,194 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+	dextypes "github.com/okex/okexchain/x/dex/types"
+)
+
+// mockQuerier serves the deals, the products and the tickers like the queriers of the modules, the queries of the
+// tickers are counted
+func mockQuerier(t *testing.T, cdc *codec.Codec, allDeals *[]types.Deal, tickerQueries *int) func(path string, data []byte) ([]byte, int64, error) {
+	return func(path string, data []byte) ([]byte, int64, error) {
+		switch path {
+		case backendPath(types.QueryDealList):
+			var params types.QueryDealsParams
+			require.NoError(t, cdc.UnmarshalJSON(data, &params))
+			var deals []types.Deal
+			for _, d := range *allDeals {
+				if params.End == 0 || d.Timestamp < params.End {
+					deals = append(deals, d)
+				}
+			}
+			offset, limit := common.GetPage(params.Page, params.PerPage)
+			var page []types.Deal
+			if offset < len(deals) {
+				end := offset + limit
+				if end > len(deals) {
+					end = len(deals)
+				}
+				page = deals[offset:end]
+			}
+			bz, err := json.Marshal(common.GetListResponse(len(deals), params.Page, params.PerPage, page))
+			return bz, 0, err
+		case fmt.Sprintf("custom/%s/%s", dextypes.QuerierRoute, dextypes.QueryProducts):
+			tokenPairs := []dextypes.TokenPair{
+				{BaseAssetSymbol: "xxb", QuoteAssetSymbol: common.NativeToken, ID: 1},
+				{BaseAssetSymbol: "yyb", QuoteAssetSymbol: common.NativeToken, ID: 2},
+			}
+			bz, err := json.Marshal(common.GetListResponse(len(tokenPairs), 1, 20, tokenPairs))
+			return bz, 0, err
+		case backendPath(types.QueryTickerList):
+			*tickerQueries++
+			// the tickers of the market keeper
+			tickers := []map[string]string{
+				{"product": "xxb_" + common.NativeToken, "price": "1.5", "timestamp": "2021-01-01T00:00:00.000Z"},
+				{"product": "yyb_" + common.NativeToken, "price": "2.5", "timestamp": "2021-01-01T00:00:00.000Z"},
+			}
+			bz, err := json.Marshal(common.GetBaseResponse(tickers))
+			return bz, 0, err
+		}
+		return nil, 0, fmt.Errorf("unexpected path %s", path)
+	}
+}
+
+func execQuery(t *testing.T, resolver *Resolver, query string, v interface{}) []string {
+	schema, err := NewSchema(resolver)
+	require.NoError(t, err)
+
+	response := schema.Exec(context.Background(), query, "", nil)
+	var errs []string
+	for _, err := range response.Errors {
+		errs = append(errs, err.Message)
+	}
+	if len(errs) == 0 {
+		require.NoError(t, json.Unmarshal(response.Data, v))
+	}
+	return errs
+}
+
+func TestDealsPagination(t *testing.T) {
+	cdc := codec.New()
+	var deals []types.Deal
+	for i := 0; i < 7; i++ {
+		deals = append(deals, types.Deal{OrderID: fmt.Sprintf("ID%d", i), Timestamp: int64(100 - i/2)})
+	}
+	resolver := &Resolver{cdc: cdc, query: mockQuerier(t, cdc, &deals, new(int))}
+
+	type result struct {
+		Deals struct {
+			TotalCount int
+			Edges      []struct {
+				Cursor string
+				Node   struct {
+					OrderID   string
+					Timestamp int64
+				}
+			}
+			PageInfo struct {
+				HasNextPage     bool
+				HasPreviousPage bool
+				EndCursor       string
+			}
+		}
+	}
+
+	var r result
+	errs := execQuery(t, resolver, `{deals(first: 2) {totalCount edges {cursor node {orderId timestamp}}
+		pageInfo {hasNextPage hasPreviousPage endCursor}}}`, &r)
+	require.Empty(t, errs)
+	require.Equal(t, 7, r.Deals.TotalCount)
+	require.Len(t, r.Deals.Edges, 2)
+	require.Equal(t, "ID1", r.Deals.Edges[1].Node.OrderID)
+	require.EqualValues(t, 100, r.Deals.Edges[1].Node.Timestamp)
+	require.True(t, r.Deals.PageInfo.HasNextPage)
+	require.False(t, r.Deals.PageInfo.HasPreviousPage)
+
+	// the newer deals added don't shift the window after the cursor
+	deals = append([]types.Deal{{OrderID: "ID7", Timestamp: 101}, {OrderID: "ID8", Timestamp: 100}}, deals...)
+	errs = execQuery(t, resolver, fmt.Sprintf(`{deals(first: 3, after: "%s") {totalCount edges {node {orderId}}
+		pageInfo {hasNextPage hasPreviousPage endCursor}}}`, r.Deals.PageInfo.EndCursor), &r)
+	require.Empty(t, errs)
+	require.Equal(t, 9, r.Deals.TotalCount)
+	require.Len(t, r.Deals.Edges, 3)
+	require.Equal(t, "ID2", r.Deals.Edges[0].Node.OrderID)
+	require.Equal(t, "ID4", r.Deals.Edges[2].Node.OrderID)
+	require.True(t, r.Deals.PageInfo.HasNextPage)
+	require.True(t, r.Deals.PageInfo.HasPreviousPage)
+
+	errs = execQuery(t, resolver, fmt.Sprintf(`{deals(first: 3, after: "%s") {edges {node {orderId}}
+		pageInfo {hasNextPage hasPreviousPage endCursor}}}`, r.Deals.PageInfo.EndCursor), &r)
+	require.Empty(t, errs)
+	require.Len(t, r.Deals.Edges, 2)
+	require.Equal(t, "ID6", r.Deals.Edges[1].Node.OrderID)
+	require.False(t, r.Deals.PageInfo.HasNextPage)
+
+	// field limits and invalid cursors
+	require.NotEmpty(t, execQuery(t, resolver, `{deals(first: 101) {totalCount}}`, &r))
+	require.NotEmpty(t, execQuery(t, resolver, `{deals(first: 1, after: "invalid") {totalCount}}`, &r))
+	require.NotEmpty(t, execQuery(t, resolver, `{candles(product: "xxb_okt", size: 1001) {open}}`, &r))
+}
+
+func TestProductsWithTicker(t *testing.T) {
+	cdc := codec.New()
+	tickerQueries := 0
+	resolver := &Resolver{cdc: cdc, query: mockQuerier(t, cdc, nil, &tickerQueries)}
+
+	var r struct {
+		Products struct {
+			Edges []struct {
+				Node struct {
+					Name        string
+					TokenPairID int64
+					Ticker      struct {
+						Price     float64
+						Timestamp string
+					}
+				}
+			}
+		}
+	}
+	errs := execQuery(t, resolver, `{products {edges {node {name tokenPairId ticker {price timestamp}}}}}`, &r)
+	require.Empty(t, errs)
+	require.Len(t, r.Products.Edges, 2)
+	node := r.Products.Edges[0].Node
+	require.Equal(t, "xxb_"+common.NativeToken, node.Name)
+	require.EqualValues(t, 1, node.TokenPairID)
+	require.Equal(t, 1.5, node.Ticker.Price)
+	require.Equal(t, "2021-01-01T00:00:00.000Z", node.Ticker.Timestamp)
+	require.Equal(t, 2.5, r.Products.Edges[1].Node.Ticker.Price)
+	// the tickers of the products are queried at a time
+	require.Equal(t, 1, tickerQueries)
+}
+
+func TestCursor(t *testing.T) {
+	c := cursor{Timestamp: 100, ID: "okt:xxb", Seq: 2}
+	decoded, err := decodeCursor(c.encode())
+	require.NoError(t, err)
+	require.Equal(t, c, decoded)
+
+	_, err = decodeCursor("b2Zmc2V0OjQy") // offset:42
+	require.Error(t, err)
+	_, err = decodeCursor("MTAwOjA6SUQx") // 100:0:ID1
+	require.Error(t, err)
+
+	// the items without the id field are told apart by the hash
+	ts, id, err := itemKey(json.RawMessage(`{"Timestamp":100,"Address":"addr1"}`), "")
+	require.NoError(t, err)
+	require.EqualValues(t, 100, ts)
+	_, id2, err := itemKey(json.RawMessage(`{"Timestamp":100,"Address":"addr2"}`), "")
+	require.NoError(t, err)
+	require.NotEqual(t, id, id2)
+	ts, id, err = itemKey(json.RawMessage(`{"timestamp":"100","order_id":"ID1"}`), "order_id")
+	require.NoError(t, err)
+	require.EqualValues(t, 100, ts)
+	require.Equal(t, "ID1", id)
+}
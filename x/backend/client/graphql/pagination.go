@@ -0,0 +1,266 @@
+package graphql
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// defaultFieldLimit is the limit of the field not in fieldLimits
+	defaultFieldLimit = 100
+)
+
+// fieldLimits is the max number of the items returned by a field at a time
+var fieldLimits = map[string]int32{
+	"tokens":       100,
+	"products":     100,
+	"orders":       100,
+	"deals":        100,
+	"matchResults": 100,
+	"feeDetails":   100,
+	"transactions": 100,
+	"swapInfos":    100,
+	"tickers":      100,
+	"candles":      1000,
+}
+
+// checkFieldLimit returns an error if the number of the items requested is out of [1, limit] of the field
+func checkFieldLimit(field string, n int32) error {
+	limit, ok := fieldLimits[field]
+	if !ok {
+		limit = defaultFieldLimit
+	}
+	if n < 1 || n > limit {
+		return fmt.Errorf("the number of %s must be between 1 and %d, got %d", field, limit, n)
+	}
+	return nil
+}
+
+// Long is the graphql scalar of int64
+type Long int64
+
+// ImplementsGraphQLType implements the graphql custom scalar
+func (Long) ImplementsGraphQLType(name string) bool {
+	return name == "Long"
+}
+
+// UnmarshalGraphQL implements the graphql custom scalar, the integer may be passed as a string
+func (l *Long) UnmarshalGraphQL(input interface{}) error {
+	switch v := input.(type) {
+	case int32:
+		*l = Long(v)
+	case int64:
+		*l = Long(v)
+	case float64:
+		*l = Long(v)
+	case string:
+		i, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*l = Long(i)
+	default:
+		return fmt.Errorf("unexpected type %T for Long", input)
+	}
+	return nil
+}
+
+// cursor is the position of an item in a list sorted by the timestamp desc like the cursors of the v2 interfaces, the
+// items of the same timestamp are told apart by the id, and the items of the same id by the sequence among them. The
+// cursor stays on the item while the newer items are added to the list
+type cursor struct {
+	Timestamp int64
+	ID        string
+	Seq       int
+}
+
+func (c cursor) encode() string {
+	return base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d:%s", c.Timestamp, c.Seq, c.ID)))
+}
+
+func decodeCursor(s string) (c cursor, err error) {
+	bz, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return c, fmt.Errorf("invalid cursor %s", s)
+	}
+	parts := strings.SplitN(string(bz), ":", 3)
+	if len(parts) != 3 {
+		return c, fmt.Errorf("invalid cursor %s", s)
+	}
+	c.ID = parts[2]
+	if c.Timestamp, err = strconv.ParseInt(parts[0], 10, 64); err != nil || c.Timestamp < 0 {
+		return c, fmt.Errorf("invalid cursor %s", s)
+	}
+	if c.Seq, err = strconv.Atoi(parts[1]); err != nil || c.Seq < 1 {
+		return c, fmt.Errorf("invalid cursor %s", s)
+	}
+	return c, nil
+}
+
+// pageArgs is the pagination arguments of a connection, the items after the cursor after are returned
+type pageArgs struct {
+	First int32
+	After *string
+}
+
+// pageInfo is the graphql PageInfo of a connection
+type pageInfo struct {
+	HasNextPage     bool
+	HasPreviousPage bool
+	StartCursor     *string
+	EndCursor       *string
+}
+
+// pageFetcher queries a page of a list, which returns the raw items of the page and the total number of the list
+type pageFetcher func(page, perPage int) (items []json.RawMessage, total int, err error)
+
+// listSource returns the fetcher of the list whose items are older than the timestamp end, it's not bounded if end is 0
+type listSource func(end int64) pageFetcher
+
+// boundEnd returns the stricter one of the end of the query and the end bound by the cursor, 0 is not bounded
+func boundEnd(end, bound int64) int64 {
+	if end == 0 || (bound > 0 && bound < end) {
+		return bound
+	}
+	return end
+}
+
+// lookupField returns the raw field of the item, the name is matched case-insensitively like json.Unmarshal
+func lookupField(fields map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	for key, raw := range fields {
+		if strings.EqualFold(key, name) {
+			return raw, true
+		}
+	}
+	return nil, false
+}
+
+// itemKey returns the timestamp and the id of the raw item. The id is the field idField, or the hash of the item if
+// idField is empty, which is only used for the items never updated
+func itemKey(item json.RawMessage, idField string) (int64, string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(item, &fields); err != nil {
+		return 0, "", err
+	}
+
+	var timestamp int64
+	if raw, ok := lookupField(fields, "timestamp"); ok {
+		// the amino json encodes the int64 as a string
+		var n json.Number
+		if err := json.Unmarshal(bytes.Trim(raw, `"`), &n); err != nil {
+			return 0, "", err
+		}
+		ts, err := n.Int64()
+		if err != nil {
+			return 0, "", err
+		}
+		timestamp = ts
+	}
+
+	if idField == "" {
+		hash := sha256.Sum256(item)
+		return timestamp, hex.EncodeToString(hash[:8]), nil
+	}
+	raw, ok := lookupField(fields, idField)
+	if !ok {
+		return 0, "", fmt.Errorf("field %s not found in the item", idField)
+	}
+	return timestamp, strings.Trim(string(raw), `"`), nil
+}
+
+// listWindow is the items of a connection and their cursors
+type listWindow struct {
+	items       []json.RawMessage
+	cursors     []string
+	total       int
+	hasNext     bool
+	hasPrevious bool
+}
+
+func (w listWindow) pageInfo() pageInfo {
+	info := pageInfo{
+		HasNextPage:     w.hasNext,
+		HasPreviousPage: w.hasPrevious,
+	}
+	if len(w.cursors) > 0 {
+		info.StartCursor, info.EndCursor = &w.cursors[0], &w.cursors[len(w.cursors)-1]
+	}
+	return info
+}
+
+// fetchWindow returns the first items after the cursor after. The list is walked from the newest item bound by the
+// timestamp of the cursor, the items of the same timestamp are skipped up to the one of the cursor. If that item has
+// left the list, the window starts at the next older item
+func fetchWindow(source listSource, idField string, args pageArgs, field string) (window listWindow, err error) {
+	if err = checkFieldLimit(field, args.First); err != nil {
+		return
+	}
+	first := int(args.First)
+
+	var after *cursor
+	fetch := source(0)
+	if args.After != nil {
+		c, err := decodeCursor(*args.After)
+		if err != nil {
+			return window, err
+		}
+		after = &c
+		if _, window.total, err = fetch(1, 1); err != nil {
+			return window, err
+		}
+		if c.Timestamp > 0 {
+			fetch = source(c.Timestamp + 1)
+		}
+		window.hasPrevious = true
+	}
+
+	// the sequences of the items of the same timestamp and id walked
+	seqs := make(map[cursor]int)
+	started := after == nil
+	perPage := first + 1
+	for page := 1; ; page++ {
+		items, total, err := fetch(page, perPage)
+		if err != nil {
+			return window, err
+		}
+		if after == nil {
+			window.total = total
+		}
+
+		for _, item := range items {
+			timestamp, id, err := itemKey(item, idField)
+			if err != nil {
+				return window, err
+			}
+			key := cursor{Timestamp: timestamp, ID: id}
+			seqs[key]++
+			key.Seq = seqs[key]
+
+			if !started {
+				if timestamp > after.Timestamp {
+					continue
+				}
+				if timestamp == after.Timestamp {
+					started = key == *after
+					continue
+				}
+				started = true
+			}
+			if len(window.items) == first {
+				window.hasNext = true
+				return window, nil
+			}
+			window.items = append(window.items, item)
+			window.cursors = append(window.cursors, key.encode())
+		}
+		if len(items) < perPage {
+			return window, nil
+		}
+	}
+}
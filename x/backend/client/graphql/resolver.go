@@ -0,0 +1,451 @@
+package graphql
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+	dextypes "github.com/okex/okexchain/x/dex/types"
+	tokentypes "github.com/okex/okexchain/x/token/types"
+)
+
+// Resolver is the root resolver of the graphql schema, the fields are resolved by the queriers of the backend, the
+// token and the dex modules
+type Resolver struct {
+	cdc   *codec.Codec
+	query func(path string, data []byte) ([]byte, int64, error)
+}
+
+// NewResolver creates a new instance of Resolver querying by the cli context
+func NewResolver(cliCtx context.CLIContext) *Resolver {
+	return &Resolver{
+		cdc:   cliCtx.Codec,
+		query: cliCtx.QueryWithData,
+	}
+}
+
+func backendPath(route string) string {
+	return fmt.Sprintf("custom/%s/%s", types.QuerierRoute, route)
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func (r *Resolver) queryWithParams(path string, params interface{}) ([]byte, error) {
+	var data []byte
+	if params != nil {
+		bz, err := r.cdc.MarshalJSON(params)
+		if err != nil {
+			return nil, err
+		}
+		data = bz
+	}
+	res, _, err := r.query(path, data)
+	return res, err
+}
+
+func responseError(code int, msg, detailMsg string) error {
+	if code == 0 {
+		return nil
+	}
+	if detailMsg != "" {
+		return errors.New(detailMsg)
+	}
+	return fmt.Errorf("query failed with code %d: %s", code, msg)
+}
+
+// listFetcher returns the fetcher of the paged querier, whose response is a common.ListResponse
+func (r *Resolver) listFetcher(path string, newParams func(page, perPage int) interface{}) pageFetcher {
+	return func(page, perPage int) ([]json.RawMessage, int, error) {
+		res, err := r.queryWithParams(path, newParams(page, perPage))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		var response struct {
+			Code      int    `json:"code"`
+			Msg       string `json:"msg"`
+			DetailMsg string `json:"detail_msg"`
+			Data      struct {
+				Data      []json.RawMessage `json:"data"`
+				ParamPage common.ParamPage  `json:"param_page"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(res, &response); err != nil {
+			return nil, 0, err
+		}
+		if err := responseError(response.Code, response.Msg, response.DetailMsg); err != nil {
+			return nil, 0, err
+		}
+		return response.Data.Data, response.Data.ParamPage.Total, nil
+	}
+}
+
+// queryBase queries the querier whose response is a common.BaseResponse and decodes the data into v
+func (r *Resolver) queryBase(path string, params interface{}, v interface{}) error {
+	res, err := r.queryWithParams(path, params)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Code      int             `json:"code"`
+		Msg       string          `json:"msg"`
+		DetailMsg string          `json:"detail_msg"`
+		Data      json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(res, &response); err != nil {
+		return err
+	}
+	if err := responseError(response.Code, response.Msg, response.DetailMsg); err != nil {
+		return err
+	}
+	if len(response.Data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(response.Data, v)
+}
+
+// fetchList fetches the window of the connection field and returns the items as a json array
+func fetchList(field string, args pageArgs, idField string, source listSource) (list []byte, window listWindow, err error) {
+	if window, err = fetchWindow(source, idField, args, field); err != nil {
+		return nil, window, err
+	}
+	items := window.items
+	if items == nil {
+		items = []json.RawMessage{}
+	}
+	list, err = json.Marshal(items)
+	return list, window, err
+}
+
+type tokensArgs struct {
+	Owner *string
+	pageArgs
+}
+
+// Tokens resolves the tokens, the querier returns all of them at a time so they are paged here
+func (r *Resolver) Tokens(args tokensArgs) (*tokenConnection, error) {
+	path := fmt.Sprintf("custom/%s/%s", tokentypes.QuerierRoute, tokentypes.QueryTokens)
+	if owner := stringValue(args.Owner); owner != "" {
+		path = fmt.Sprintf("%s/%s", path, owner)
+	}
+	res, err := r.queryWithParams(path, nil)
+	if err != nil {
+		return nil, err
+	}
+	var all []json.RawMessage
+	if err := json.Unmarshal(res, &all); err != nil {
+		return nil, err
+	}
+
+	list, window, err := fetchList("tokens", args.pageArgs, "symbol", func(int64) pageFetcher {
+		return func(page, perPage int) ([]json.RawMessage, int, error) {
+			start, limit := common.GetPage(page, perPage)
+			if start >= len(all) {
+				return nil, len(all), nil
+			}
+			if start+limit > len(all) {
+				limit = len(all) - start
+			}
+			return all[start : start+limit], len(all), nil
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []tokentypes.TokenResp
+	if err := r.cdc.UnmarshalJSON(list, &tokens); err != nil {
+		return nil, err
+	}
+	conn := &tokenConnection{TotalCount: int32(window.total), Edges: make([]tokenEdge, 0, len(tokens)),
+		PageInfo: window.pageInfo()}
+	for i, t := range tokens {
+		conn.Edges = append(conn.Edges, tokenEdge{Cursor: window.cursors[i], Node: newToken(t)})
+	}
+	return conn, nil
+}
+
+type productsArgs struct {
+	Owner *string
+	pageArgs
+}
+
+// Products resolves the products listed on the dex
+func (r *Resolver) Products(args productsArgs) (*productConnection, error) {
+	path := fmt.Sprintf("custom/%s/%s", dextypes.QuerierRoute, dextypes.QueryProducts)
+	list, window, err := fetchList("products", args.pageArgs, "token_pair_id", func(int64) pageFetcher {
+		return r.listFetcher(path, func(page, perPage int) interface{} {
+			return dextypes.NewQueryDexInfoParams(stringValue(args.Owner), page, perPage)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var tokenPairs []dextypes.TokenPair
+	if err := json.Unmarshal(list, &tokenPairs); err != nil {
+		return nil, err
+	}
+	conn := &productConnection{TotalCount: int32(window.total), Edges: make([]productEdge, 0, len(tokenPairs)),
+		PageInfo: window.pageInfo()}
+	tickers := newProductTickers(r)
+	for i, tp := range tokenPairs {
+		conn.Edges = append(conn.Edges, productEdge{Cursor: window.cursors[i], Node: newProduct(r, tickers, tp)})
+	}
+	return conn, nil
+}
+
+type ordersArgs struct {
+	Address    string
+	Open       bool
+	Product    *string
+	Side       *string
+	Start, End Long
+	HideNoFill bool
+	pageArgs
+}
+
+// Orders resolves the open or the closed orders of the address
+func (r *Resolver) Orders(args ordersArgs) (*orderConnection, error) {
+	path := fmt.Sprintf("%s/closed", backendPath(types.QueryOrderList))
+	if args.Open {
+		path = fmt.Sprintf("%s/open", backendPath(types.QueryOrderList))
+	}
+	list, window, err := fetchList("orders", args.pageArgs, "order_id", func(end int64) pageFetcher {
+		return r.listFetcher(path, func(page, perPage int) interface{} {
+			return types.NewQueryOrderListParams(args.Address, stringValue(args.Product), stringValue(args.Side), page,
+				perPage, int64(args.Start), boundEnd(int64(args.End), end), args.HideNoFill)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var orders []types.Order
+	if err := json.Unmarshal(list, &orders); err != nil {
+		return nil, err
+	}
+	conn := &orderConnection{TotalCount: int32(window.total), Edges: make([]orderEdge, 0, len(orders)),
+		PageInfo: window.pageInfo()}
+	for i, o := range orders {
+		conn.Edges = append(conn.Edges, orderEdge{Cursor: window.cursors[i], Node: newOrder(o)})
+	}
+	return conn, nil
+}
+
+type dealsArgs struct {
+	Address    *string
+	Product    *string
+	Side       *string
+	Start, End Long
+	pageArgs
+}
+
+// Deals resolves the deals of the address or the product
+func (r *Resolver) Deals(args dealsArgs) (*dealConnection, error) {
+	list, window, err := fetchList("deals", args.pageArgs, "order_id", func(end int64) pageFetcher {
+		return r.listFetcher(backendPath(types.QueryDealList), func(page, perPage int) interface{} {
+			return types.NewQueryDealsParams(stringValue(args.Address), stringValue(args.Product), int64(args.Start),
+				boundEnd(int64(args.End), end), page, perPage, stringValue(args.Side))
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var deals []types.Deal
+	if err := json.Unmarshal(list, &deals); err != nil {
+		return nil, err
+	}
+	conn := &dealConnection{TotalCount: int32(window.total), Edges: make([]dealEdge, 0, len(deals)),
+		PageInfo: window.pageInfo()}
+	for i, d := range deals {
+		conn.Edges = append(conn.Edges, dealEdge{Cursor: window.cursors[i], Node: newDeal(d)})
+	}
+	return conn, nil
+}
+
+type matchResultsArgs struct {
+	Product    *string
+	Start, End Long
+	pageArgs
+}
+
+// MatchResults resolves the match results of the product
+func (r *Resolver) MatchResults(args matchResultsArgs) (*matchResultConnection, error) {
+	list, window, err := fetchList("matchResults", args.pageArgs, "product", func(end int64) pageFetcher {
+		return r.listFetcher(backendPath(types.QueryMatchResults), func(page, perPage int) interface{} {
+			return types.NewQueryMatchParams(stringValue(args.Product), int64(args.Start), boundEnd(int64(args.End), end),
+				page, perPage)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matchResults []types.MatchResult
+	if err := json.Unmarshal(list, &matchResults); err != nil {
+		return nil, err
+	}
+	conn := &matchResultConnection{TotalCount: int32(window.total), Edges: make([]matchResultEdge, 0, len(matchResults)),
+		PageInfo: window.pageInfo()}
+	for i, m := range matchResults {
+		conn.Edges = append(conn.Edges, matchResultEdge{Cursor: window.cursors[i], Node: newMatchResult(m)})
+	}
+	return conn, nil
+}
+
+type feeDetailsArgs struct {
+	Address string
+	pageArgs
+}
+
+// FeeDetails resolves the fees paid by the address
+func (r *Resolver) FeeDetails(args feeDetailsArgs) (*feeDetailConnection, error) {
+	list, window, err := fetchList("feeDetails", args.pageArgs, "", func(end int64) pageFetcher {
+		return r.listFetcher(backendPath(types.QueryFeeDetails), func(page, perPage int) interface{} {
+			return types.NewQueryFeeDetailsParams(args.Address, 0, end, page, perPage)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var feeDetails []tokentypes.FeeDetail
+	if err := json.Unmarshal(list, &feeDetails); err != nil {
+		return nil, err
+	}
+	conn := &feeDetailConnection{TotalCount: int32(window.total), Edges: make([]feeDetailEdge, 0, len(feeDetails)),
+		PageInfo: window.pageInfo()}
+	for i, f := range feeDetails {
+		conn.Edges = append(conn.Edges, feeDetailEdge{Cursor: window.cursors[i], Node: newFeeDetail(f)})
+	}
+	return conn, nil
+}
+
+type candlesArgs struct {
+	Product     string
+	Granularity int32
+	Size        int32
+}
+
+// Candles resolves the latest candles of the product
+func (r *Resolver) Candles(args candlesArgs) ([]candle, error) {
+	if err := checkFieldLimit("candles", args.Size); err != nil {
+		return nil, err
+	}
+
+	var data [][]string
+	params := types.NewQueryKlinesParams(args.Product, int(args.Granularity), int(args.Size))
+	if err := r.queryBase(backendPath(types.QueryCandleList), params, &data); err != nil {
+		return nil, err
+	}
+	candles := make([]candle, 0, len(data))
+	for _, d := range data {
+		c, err := newCandle(d)
+		if err != nil {
+			return nil, err
+		}
+		candles = append(candles, c)
+	}
+	return candles, nil
+}
+
+type tickersArgs struct {
+	Product *string
+	Count   int32
+}
+
+// Tickers resolves the tickers of the product or all the products
+func (r *Resolver) Tickers(args tickersArgs) ([]ticker, error) {
+	if err := checkFieldLimit("tickers", args.Count); err != nil {
+		return nil, err
+	}
+	return r.queryTickers(stringValue(args.Product), int(args.Count))
+}
+
+func (r *Resolver) queryTickers(product string, count int) ([]ticker, error) {
+	var data []map[string]interface{}
+	params := types.QueryTickerParams{Product: product, Count: count}
+	if err := r.queryBase(backendPath(types.QueryTickerList), params, &data); err != nil {
+		return nil, err
+	}
+	tickers := make([]ticker, 0, len(data))
+	for _, d := range data {
+		tickers = append(tickers, newTicker(d))
+	}
+	return tickers, nil
+}
+
+type transactionsArgs struct {
+	Address    string
+	Type       Long
+	Start, End Long
+	pageArgs
+}
+
+// Transactions resolves the transactions of the address
+func (r *Resolver) Transactions(args transactionsArgs) (*transactionConnection, error) {
+	list, window, err := fetchList("transactions", args.pageArgs, "", func(end int64) pageFetcher {
+		return r.listFetcher(backendPath(types.QueryTxList), func(page, perPage int) interface{} {
+			return types.NewQueryTxListParams(args.Address, int64(args.Type), int64(args.Start),
+				boundEnd(int64(args.End), end), page, perPage)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var txs []types.Transaction
+	if err := json.Unmarshal(list, &txs); err != nil {
+		return nil, err
+	}
+	conn := &transactionConnection{TotalCount: int32(window.total), Edges: make([]transactionEdge, 0, len(txs)),
+		PageInfo: window.pageInfo()}
+	for i, tx := range txs {
+		conn.Edges = append(conn.Edges, transactionEdge{Cursor: window.cursors[i], Node: newTransaction(tx)})
+	}
+	return conn, nil
+}
+
+type swapInfosArgs struct {
+	Address       *string
+	TokenPairName *string
+	Start, End    Long
+	pageArgs
+}
+
+// SwapInfos resolves the swaps of the address or the swap token pair
+func (r *Resolver) SwapInfos(args swapInfosArgs) (*swapInfoConnection, error) {
+	list, window, err := fetchList("swapInfos", args.pageArgs, "", func(end int64) pageFetcher {
+		return r.listFetcher(backendPath(types.QuerySwapInfoList), func(page, perPage int) interface{} {
+			return types.NewQuerySwapInfoParams(stringValue(args.Address), stringValue(args.TokenPairName),
+				int64(args.Start), boundEnd(int64(args.End), end), page, perPage)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var swapInfos []types.SwapInfo
+	if err := json.Unmarshal(list, &swapInfos); err != nil {
+		return nil, err
+	}
+	conn := &swapInfoConnection{TotalCount: int32(window.total), Edges: make([]swapInfoEdge, 0, len(swapInfos)),
+		PageInfo: window.pageInfo()}
+	for i, s := range swapInfos {
+		conn.Edges = append(conn.Edges, swapInfoEdge{Cursor: window.cursors[i], Node: newSwapInfo(s)})
+	}
+	return conn, nil
+}
@@ -0,0 +1,249 @@
+package graphql
+
+// schema is the graphql schema of the backend data. The lists are paginated by the opaque cursors of the connections,
+// at most fieldLimits of the field are returned at a time
+const schema = `
+schema {
+	query: Query
+}
+
+# Long is a 64 bit integer, e.g. a unix timestamp or a block height
+scalar Long
+
+type Query {
+	# tokens issued by the owner, all the tokens if the owner is omitted
+	tokens(owner: String, first: Int = 20, after: String): TokenConnection!
+	# products listed by the owner, all the products if the owner is omitted
+	products(owner: String, first: Int = 20, after: String): ProductConnection!
+	# open or closed orders of the address
+	orders(address: String!, open: Boolean = true, product: String, side: String, start: Long = 0, end: Long = 0,
+		hideNoFill: Boolean = false, first: Int = 20, after: String): OrderConnection!
+	deals(address: String, product: String, side: String, start: Long = 0, end: Long = 0, first: Int = 20,
+		after: String): DealConnection!
+	matchResults(product: String, start: Long = 0, end: Long = 0, first: Int = 20, after: String): MatchResultConnection!
+	feeDetails(address: String!, first: Int = 20, after: String): FeeDetailConnection!
+	# latest candles of the product, the granularity is in seconds
+	candles(product: String!, granularity: Int = 60, size: Int = 100): [Candle!]!
+	# tickers of the product, all the products if the product is omitted
+	tickers(product: String, count: Int = 10): [Ticker!]!
+	transactions(address: String!, type: Long = 0, start: Long = 0, end: Long = 0, first: Int = 20,
+		after: String): TransactionConnection!
+	swapInfos(address: String, tokenPairName: String, start: Long = 0, end: Long = 0, first: Int = 20,
+		after: String): SwapInfoConnection!
+}
+
+type PageInfo {
+	hasNextPage: Boolean!
+	hasPreviousPage: Boolean!
+	startCursor: String
+	endCursor: String
+}
+
+type Token {
+	symbol: String!
+	originalSymbol: String!
+	wholeName: String!
+	description: String!
+	originalTotalSupply: String!
+	totalSupply: String!
+	type: Int!
+	owner: String!
+	mintable: Boolean!
+}
+
+type TokenEdge {
+	cursor: String!
+	node: Token!
+}
+
+type TokenConnection {
+	totalCount: Int!
+	edges: [TokenEdge!]!
+	pageInfo: PageInfo!
+}
+
+type Product {
+	name: String!
+	baseAssetSymbol: String!
+	quoteAssetSymbol: String!
+	price: String!
+	maxPriceDigit: Long!
+	maxSizeDigit: Long!
+	minTradeSize: String!
+	tokenPairId: Long!
+	delisting: Boolean!
+	owner: String!
+	deposits: String!
+	blockHeight: Long!
+	ticker: Ticker
+	candles(granularity: Int = 60, size: Int = 100): [Candle!]!
+	matchResults(start: Long = 0, end: Long = 0, first: Int = 20, after: String): MatchResultConnection!
+}
+
+type ProductEdge {
+	cursor: String!
+	node: Product!
+}
+
+type ProductConnection {
+	totalCount: Int!
+	edges: [ProductEdge!]!
+	pageInfo: PageInfo!
+}
+
+type Order {
+	txHash: String!
+	orderId: String!
+	sender: String!
+	product: String!
+	side: String!
+	price: String!
+	quantity: String!
+	status: Long!
+	filledAvgPrice: String!
+	remainQuantity: String!
+	timestamp: Long!
+}
+
+type OrderEdge {
+	cursor: String!
+	node: Order!
+}
+
+type OrderConnection {
+	totalCount: Int!
+	edges: [OrderEdge!]!
+	pageInfo: PageInfo!
+}
+
+type Deal {
+	timestamp: Long!
+	blockHeight: Long!
+	orderId: String!
+	sender: String!
+	product: String!
+	side: String!
+	price: Float!
+	quantity: Float!
+	fee: String!
+	feeReceiver: String!
+}
+
+type DealEdge {
+	cursor: String!
+	node: Deal!
+}
+
+type DealConnection {
+	totalCount: Int!
+	edges: [DealEdge!]!
+	pageInfo: PageInfo!
+}
+
+type MatchResult {
+	timestamp: Long!
+	blockHeight: Long!
+	product: String!
+	price: Float!
+	quantity: Float!
+}
+
+type MatchResultEdge {
+	cursor: String!
+	node: MatchResult!
+}
+
+type MatchResultConnection {
+	totalCount: Int!
+	edges: [MatchResultEdge!]!
+	pageInfo: PageInfo!
+}
+
+type FeeDetail {
+	address: String!
+	receiver: String!
+	fee: String!
+	feeType: String!
+	timestamp: Long!
+}
+
+type FeeDetailEdge {
+	cursor: String!
+	node: FeeDetail!
+}
+
+type FeeDetailConnection {
+	totalCount: Int!
+	edges: [FeeDetailEdge!]!
+	pageInfo: PageInfo!
+}
+
+type Candle {
+	timestamp: String!
+	open: String!
+	high: String!
+	low: String!
+	close: String!
+	volume: String!
+}
+
+type Ticker {
+	product: String!
+	symbol: String!
+	price: Float!
+	open: Float!
+	close: Float!
+	high: Float!
+	low: Float!
+	volume: Float!
+	change: Float!
+	changePercentage: String!
+	timestamp: String!
+}
+
+type Transaction {
+	txHash: String!
+	type: Long!
+	address: String!
+	symbol: String!
+	side: Long!
+	quantity: String!
+	fee: String!
+	timestamp: Long!
+}
+
+type TransactionEdge {
+	cursor: String!
+	node: Transaction!
+}
+
+type TransactionConnection {
+	totalCount: Int!
+	edges: [TransactionEdge!]!
+	pageInfo: PageInfo!
+}
+
+type SwapInfo {
+	address: String!
+	tokenPairName: String!
+	baseTokenAmount: String!
+	quoteTokenAmount: String!
+	sellAmount: String!
+	buyAmount: String!
+	price: String!
+	swapPrice: Float!
+	baseVolume: Float!
+	timestamp: Long!
+}
+
+type SwapInfoEdge {
+	cursor: String!
+	node: SwapInfo!
+}
+
+type SwapInfoConnection {
+	totalCount: Int!
+	edges: [SwapInfoEdge!]!
+	pageInfo: PageInfo!
+}
+`
@@ -0,0 +1,444 @@
+package graphql
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/okex/okexchain/x/backend/types"
+	dextypes "github.com/okex/okexchain/x/dex/types"
+	tokentypes "github.com/okex/okexchain/x/token/types"
+)
+
+// the graphql objects are resolved from the fields of the structs below by the names, the nested lists of a product
+// are resolved by its methods
+
+type token struct {
+	Symbol              string
+	OriginalSymbol      string
+	WholeName           string
+	Description         string
+	OriginalTotalSupply string
+	TotalSupply         string
+	Type                int32
+	Owner               string
+	Mintable            bool
+}
+
+func newToken(t tokentypes.TokenResp) token {
+	return token{
+		Symbol:              t.Symbol,
+		OriginalSymbol:      t.OriginalSymbol,
+		WholeName:           t.WholeName,
+		Description:         t.Description,
+		OriginalTotalSupply: t.OriginalTotalSupply.String(),
+		TotalSupply:         t.TotalSupply.String(),
+		Type:                int32(t.Type),
+		Owner:               t.Owner.String(),
+		Mintable:            t.Mintable,
+	}
+}
+
+type tokenEdge struct {
+	Cursor string
+	Node   token
+}
+
+type tokenConnection struct {
+	TotalCount int32
+	Edges      []tokenEdge
+	PageInfo   pageInfo
+}
+
+// productTickers loads the tickers of all the products at the first request, so the tickers of the products resolved
+// together are queried at a time
+type productTickers struct {
+	resolver *Resolver
+	once     sync.Once
+	tickers  map[string]ticker
+	err      error
+}
+
+func newProductTickers(resolver *Resolver) *productTickers {
+	return &productTickers{resolver: resolver}
+}
+
+func (t *productTickers) get(product string) (*ticker, error) {
+	t.once.Do(func() {
+		var tickers []ticker
+		if tickers, t.err = t.resolver.queryTickers("", math.MaxInt32); t.err != nil {
+			return
+		}
+		t.tickers = make(map[string]ticker, len(tickers))
+		for _, tk := range tickers {
+			t.tickers[tk.Product] = tk
+		}
+	})
+	if t.err != nil {
+		return nil, t.err
+	}
+	tk, ok := t.tickers[product]
+	if !ok {
+		return nil, nil
+	}
+	return &tk, nil
+}
+
+type product struct {
+	resolver *Resolver
+	tickers  *productTickers
+
+	Name             string
+	BaseAssetSymbol  string
+	QuoteAssetSymbol string
+	Price            string
+	MaxPriceDigit    Long
+	MaxSizeDigit     Long
+	MinTradeSize     string
+	TokenPairID      Long
+	Delisting        bool
+	Owner            string
+	Deposits         string
+	BlockHeight      Long
+}
+
+func newProduct(resolver *Resolver, tickers *productTickers, tp dextypes.TokenPair) product {
+	return product{
+		resolver:         resolver,
+		tickers:          tickers,
+		Name:             tp.Name(),
+		BaseAssetSymbol:  tp.BaseAssetSymbol,
+		QuoteAssetSymbol: tp.QuoteAssetSymbol,
+		Price:            tp.InitPrice.String(),
+		MaxPriceDigit:    Long(tp.MaxPriceDigit),
+		MaxSizeDigit:     Long(tp.MaxQuantityDigit),
+		MinTradeSize:     tp.MinQuantity.String(),
+		TokenPairID:      Long(tp.ID),
+		Delisting:        tp.Delisting,
+		Owner:            tp.Owner.String(),
+		Deposits:         tp.Deposits.String(),
+		BlockHeight:      Long(tp.BlockHeight),
+	}
+}
+
+// Ticker resolves the ticker of the product, it's nil if the product has no ticker
+func (p product) Ticker() (*ticker, error) {
+	return p.tickers.get(p.Name)
+}
+
+// Candles resolves the latest candles of the product
+func (p product) Candles(args struct {
+	Granularity int32
+	Size        int32
+}) ([]candle, error) {
+	return p.resolver.Candles(candlesArgs{Product: p.Name, Granularity: args.Granularity, Size: args.Size})
+}
+
+// MatchResults resolves the match results of the product
+func (p product) MatchResults(args struct {
+	Start, End Long
+	pageArgs
+}) (*matchResultConnection, error) {
+	name := p.Name
+	return p.resolver.MatchResults(matchResultsArgs{Product: &name, Start: args.Start, End: args.End, pageArgs: args.pageArgs})
+}
+
+type productEdge struct {
+	Cursor string
+	Node   product
+}
+
+type productConnection struct {
+	TotalCount int32
+	Edges      []productEdge
+	PageInfo   pageInfo
+}
+
+type order struct {
+	TxHash         string
+	OrderID        string
+	Sender         string
+	Product        string
+	Side           string
+	Price          string
+	Quantity       string
+	Status         Long
+	FilledAvgPrice string
+	RemainQuantity string
+	Timestamp      Long
+}
+
+func newOrder(o types.Order) order {
+	return order{
+		TxHash:         o.TxHash,
+		OrderID:        o.OrderID,
+		Sender:         o.Sender,
+		Product:        o.Product,
+		Side:           o.Side,
+		Price:          o.Price,
+		Quantity:       o.Quantity,
+		Status:         Long(o.Status),
+		FilledAvgPrice: o.FilledAvgPrice,
+		RemainQuantity: o.RemainQuantity,
+		Timestamp:      Long(o.Timestamp),
+	}
+}
+
+type orderEdge struct {
+	Cursor string
+	Node   order
+}
+
+type orderConnection struct {
+	TotalCount int32
+	Edges      []orderEdge
+	PageInfo   pageInfo
+}
+
+type deal struct {
+	Timestamp   Long
+	BlockHeight Long
+	OrderID     string
+	Sender      string
+	Product     string
+	Side        string
+	Price       float64
+	Quantity    float64
+	Fee         string
+	FeeReceiver string
+}
+
+func newDeal(d types.Deal) deal {
+	return deal{
+		Timestamp:   Long(d.Timestamp),
+		BlockHeight: Long(d.BlockHeight),
+		OrderID:     d.OrderID,
+		Sender:      d.Sender,
+		Product:     d.Product,
+		Side:        d.Side,
+		Price:       d.Price,
+		Quantity:    d.Quantity,
+		Fee:         d.Fee,
+		FeeReceiver: d.FeeReceiver,
+	}
+}
+
+type dealEdge struct {
+	Cursor string
+	Node   deal
+}
+
+type dealConnection struct {
+	TotalCount int32
+	Edges      []dealEdge
+	PageInfo   pageInfo
+}
+
+type matchResult struct {
+	Timestamp   Long
+	BlockHeight Long
+	Product     string
+	Price       float64
+	Quantity    float64
+}
+
+func newMatchResult(m types.MatchResult) matchResult {
+	return matchResult{
+		Timestamp:   Long(m.Timestamp),
+		BlockHeight: Long(m.BlockHeight),
+		Product:     m.Product,
+		Price:       m.Price,
+		Quantity:    m.Quantity,
+	}
+}
+
+type matchResultEdge struct {
+	Cursor string
+	Node   matchResult
+}
+
+type matchResultConnection struct {
+	TotalCount int32
+	Edges      []matchResultEdge
+	PageInfo   pageInfo
+}
+
+type feeDetail struct {
+	Address   string
+	Receiver  string
+	Fee       string
+	FeeType   string
+	Timestamp Long
+}
+
+func newFeeDetail(f tokentypes.FeeDetail) feeDetail {
+	return feeDetail{
+		Address:   f.Address,
+		Receiver:  f.Receiver,
+		Fee:       f.Fee,
+		FeeType:   f.FeeType,
+		Timestamp: Long(f.Timestamp),
+	}
+}
+
+type feeDetailEdge struct {
+	Cursor string
+	Node   feeDetail
+}
+
+type feeDetailConnection struct {
+	TotalCount int32
+	Edges      []feeDetailEdge
+	PageInfo   pageInfo
+}
+
+type candle struct {
+	Timestamp string
+	Open      string
+	High      string
+	Low       string
+	Close     string
+	Volume    string
+}
+
+// newCandle creates a candle from the restful data of a kline, which is [timestamp, open, high, low, close, volume]
+func newCandle(data []string) (candle, error) {
+	if len(data) < 6 {
+		return candle{}, fmt.Errorf("invalid candle %v", data)
+	}
+	return candle{
+		Timestamp: data[0],
+		Open:      data[1],
+		High:      data[2],
+		Low:       data[3],
+		Close:     data[4],
+		Volume:    data[5],
+	}, nil
+}
+
+type ticker struct {
+	Product          string
+	Symbol           string
+	Price            float64
+	Open             float64
+	Close            float64
+	High             float64
+	Low              float64
+	Volume           float64
+	Change           float64
+	ChangePercentage string
+	Timestamp        string
+}
+
+// newTicker creates a ticker from either a types.Ticker or a ticker of the market keeper, whose values are strings
+func newTicker(m map[string]interface{}) ticker {
+	t := ticker{
+		Product:          fmt.Sprint(m["product"]),
+		Symbol:           fmt.Sprint(m["symbol"]),
+		Price:            toFloat(m["price"]),
+		Open:             toFloat(m["open"]),
+		Close:            toFloat(m["close"]),
+		High:             toFloat(m["high"]),
+		Low:              toFloat(m["low"]),
+		Volume:           toFloat(m["volume"]),
+		Change:           toFloat(m["change"]),
+		ChangePercentage: "0.00%",
+	}
+	if percentage, ok := m["change_percentage"].(string); ok {
+		t.ChangePercentage = percentage
+	}
+	switch ts := m["timestamp"].(type) {
+	case float64:
+		t.Timestamp = time.Unix(int64(ts), 0).UTC().Format("2006-01-02T15:04:05.000Z")
+	case string:
+		t.Timestamp = ts
+	}
+	return t
+}
+
+func toFloat(v interface{}) float64 {
+	switch f := v.(type) {
+	case float64:
+		return f
+	case string:
+		r, _ := strconv.ParseFloat(f, 64)
+		return r
+	}
+	return 0
+}
+
+type transaction struct {
+	TxHash    string
+	Type      Long
+	Address   string
+	Symbol    string
+	Side      Long
+	Quantity  string
+	Fee       string
+	Timestamp Long
+}
+
+func newTransaction(tx types.Transaction) transaction {
+	return transaction{
+		TxHash:    tx.TxHash,
+		Type:      Long(tx.Type),
+		Address:   tx.Address,
+		Symbol:    tx.Symbol,
+		Side:      Long(tx.Side),
+		Quantity:  tx.Quantity,
+		Fee:       tx.Fee,
+		Timestamp: Long(tx.Timestamp),
+	}
+}
+
+type transactionEdge struct {
+	Cursor string
+	Node   transaction
+}
+
+type transactionConnection struct {
+	TotalCount int32
+	Edges      []transactionEdge
+	PageInfo   pageInfo
+}
+
+type swapInfo struct {
+	Address          string
+	TokenPairName    string
+	BaseTokenAmount  string
+	QuoteTokenAmount string
+	SellAmount       string
+	BuyAmount        string
+	Price            string
+	SwapPrice        float64
+	BaseVolume       float64
+	Timestamp        Long
+}
+
+func newSwapInfo(s types.SwapInfo) swapInfo {
+	return swapInfo{
+		Address:          s.Address,
+		TokenPairName:    s.TokenPairName,
+		BaseTokenAmount:  s.BaseTokenAmount,
+		QuoteTokenAmount: s.QuoteTokenAmount,
+		SellAmount:       s.SellAmount,
+		BuyAmount:        s.BuysAmount,
+		Price:            s.Price,
+		SwapPrice:        s.SwapPrice,
+		BaseVolume:       s.BaseVolume,
+		Timestamp:        Long(s.Timestamp),
+	}
+}
+
+type swapInfoEdge struct {
+	Cursor string
+	Node   swapInfo
+}
+
+type swapInfoConnection struct {
+	TotalCount int32
+	Edges      []swapInfoEdge
+	PageInfo   pageInfo
+}
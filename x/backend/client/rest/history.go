@@ -0,0 +1,195 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+)
+
+// historyQuery is the filter shared by the liquidity, the farm and the staking history endpoints
+type historyQuery struct {
+	start, end    int64
+	page, perPage int
+}
+
+func parseHistoryQuery(r *http.Request) (q historyQuery, err error) {
+	if startStr := r.URL.Query().Get("start"); startStr != "" {
+		if q.start, err = strconv.ParseInt(startStr, 10, 64); err != nil {
+			return q, err
+		}
+	}
+	if endStr := r.URL.Query().Get("end"); endStr != "" {
+		if q.end, err = strconv.ParseInt(endStr, 10, 64); err != nil {
+			return q, err
+		}
+	}
+	q.page, q.perPage, err = common.Paginate(r.URL.Query().Get("page"), r.URL.Query().Get("per_page"))
+	return q, err
+}
+
+func queryHistory(w http.ResponseWriter, cliCtx context.CLIContext, route string, params interface{}) {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		common.HandleErrorMsg(w, cliCtx, err.Error())
+		return
+	}
+
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", route), bz)
+	if err != nil {
+		common.HandleErrorMsg(w, cliCtx, err.Error())
+		return
+	}
+
+	rest.PostProcessResponse(w, cliCtx, res)
+}
+
+func liquidityHistoryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := parseHistoryQuery(r)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		params := types.NewQueryLiquidityInfoParams(r.URL.Query().Get("address"), r.URL.Query().Get("swap_pair"),
+			q.start, q.end, q.page, q.perPage)
+		queryHistory(w, cliCtx, types.QueryLiquidityList, params)
+	}
+}
+
+func farmHistoryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := parseHistoryQuery(r)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		params := types.NewQueryFarmInfoParams(r.URL.Query().Get("address"), r.URL.Query().Get("pool_name"),
+			q.start, q.end, q.page, q.perPage)
+		queryHistory(w, cliCtx, types.QueryFarmList, params)
+	}
+}
+
+func stakingHistoryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := parseHistoryQuery(r)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		params := types.NewQueryStakingInfoParams(r.URL.Query().Get("address"), r.URL.Query().Get("validator"),
+			q.start, q.end, q.page, q.perPage)
+		queryHistory(w, cliCtx, types.QueryStakingList, params)
+	}
+}
+
+func haltHistoryHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := parseHistoryQuery(r)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		params := types.NewQueryProductHaltParams(r.URL.Query().Get("product"), q.start, q.end, q.page, q.perPage)
+		queryHistory(w, cliCtx, types.QueryProductHalts, params)
+	}
+}
+
+// parseHistoryQueryV2 parses the cursors and the limit of the v2 history endpoints, the cursors are optional
+func parseHistoryQueryV2(r *http.Request) (after, before string, limit int, ok bool) {
+	after = r.URL.Query().Get("after")
+	before = r.URL.Query().Get("before")
+	limitStr := r.URL.Query().Get("limit")
+
+	if after != "" {
+		if _, err := strconv.Atoi(after); err != nil {
+			return
+		}
+	}
+	if before != "" {
+		if _, err := strconv.Atoi(before); err != nil {
+			return
+		}
+	}
+	// default limit 100
+	if limitStr == "" {
+		limitStr = defaultLimit
+	}
+	limit, err := strconv.Atoi(limitStr)
+	if err != nil {
+		return
+	}
+	return after, before, limit, true
+}
+
+func queryHistoryV2(w http.ResponseWriter, cliCtx context.CLIContext, route string, params interface{}) {
+	req := cliCtx.Codec.MustMarshalJSON(params)
+	res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/backend/%s", route), req)
+	common.HandleResponseV2(w, res, err)
+}
+
+func liquidityHistoryHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		after, before, limit, ok := parseHistoryQueryV2(r)
+		if !ok {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+
+		params := types.QueryLiquidityInfoParamsV2{
+			Address:       r.URL.Query().Get("address"),
+			TokenPairName: r.URL.Query().Get("swap_pair"),
+			After:         after,
+			Before:        before,
+			Limit:         limit,
+		}
+		queryHistoryV2(w, cliCtx, types.QueryLiquidityListV2, params)
+	}
+}
+
+func farmHistoryHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		after, before, limit, ok := parseHistoryQueryV2(r)
+		if !ok {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+
+		params := types.QueryFarmInfoParamsV2{
+			Address:  r.URL.Query().Get("address"),
+			PoolName: r.URL.Query().Get("pool_name"),
+			After:    after,
+			Before:   before,
+			Limit:    limit,
+		}
+		queryHistoryV2(w, cliCtx, types.QueryFarmListV2, params)
+	}
+}
+
+func stakingHistoryHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		after, before, limit, ok := parseHistoryQueryV2(r)
+		if !ok {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidParam)
+			return
+		}
+
+		params := types.QueryStakingInfoParamsV2{
+			Address:   r.URL.Query().Get("address"),
+			Validator: r.URL.Query().Get("validator"),
+			After:     after,
+			Before:    before,
+			Limit:     limit,
+		}
+		queryHistoryV2(w, cliCtx, types.QueryStakingListV2, params)
+	}
+}
@@ -0,0 +1,45 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+)
+
+func positionListHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		page, perPage, err := common.Paginate(r.URL.Query().Get("page"), r.URL.Query().Get("per_page"))
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		params := types.NewQueryPositionParams(r.URL.Query().Get("address"), r.URL.Query().Get("product"), page,
+			perPage)
+		queryHistory(w, cliCtx, types.QueryPositionList, params)
+	}
+}
+
+func positionDailyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := parseHistoryQuery(r)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		params := types.NewQueryPositionSnapshotParams(r.URL.Query().Get("address"), r.URL.Query().Get("product"),
+			q.start, q.end, q.page, q.perPage)
+		queryHistory(w, cliCtx, types.QueryPositionDaily, params)
+	}
+}
+
+func feeTierHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := types.NewQueryFeeTierParams(r.URL.Query().Get("address"))
+		queryHistory(w, cliCtx, types.QueryFeeTier, params)
+	}
+}
@@ -29,6 +29,13 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/latestheight", latestHeightHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/dex/fees", dexFeesHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/swap/watchlist", swapWatchlistHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/swap/liquidity/history", liquidityHistoryHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/farm/history", farmHistoryHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/staking/history", stakingHistoryHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/positions", positionListHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/positions/daily", positionDailyHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/fee_tier", feeTierHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/halts/history", haltHistoryHandler(cliCtx)).Methods("GET")
 }
 
 func candleHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -242,7 +249,7 @@ func feeDetailListHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			common.HandleErrorMsg(w, cliCtx, err.Error())
 			return
 		}
-		params := types.NewQueryFeeDetailsParams(addr, page, perPage)
+		params := types.NewQueryFeeDetailsParams(addr, 0, 0, page, perPage)
 		bz, err := cliCtx.Codec.MarshalJSON(params)
 		if err != nil {
 			common.HandleErrorMsg(w, cliCtx, err.Error())
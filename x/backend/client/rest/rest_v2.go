@@ -33,6 +33,9 @@ func RegisterRoutesV2(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/fees", feesHandlerV2(cliCtx)).Methods("GET")
 	r.HandleFunc("/deals", dealsHandlerV2(cliCtx)).Methods("GET")
 	r.HandleFunc("/transactions", txListHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/swap/liquidity/history", liquidityHistoryHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/farm/history", farmHistoryHandlerV2(cliCtx)).Methods("GET")
+	r.HandleFunc("/staking/history", stakingHistoryHandlerV2(cliCtx)).Methods("GET")
 }
 
 func txListHandlerV2(cliCtx context.CLIContext) http.HandlerFunc {
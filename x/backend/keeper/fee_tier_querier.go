@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+)
+
+// queryFeeTier returns the fee tier of the address by its trade volume in the order keeper
+func queryFeeTier(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeTierParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	addr, err := sdk.AccAddressFromBech32(params.Address)
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("invalid address: %s", params.Address))
+	}
+
+	response := common.GetBaseResponse(keeper.OrderKeeper.GetAccountFeeTier(ctx, addr))
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
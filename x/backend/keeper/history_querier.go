@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+)
+
+// queryLiquidityList returns the liquidity infos of the address or the swap token pair
+func queryLiquidityList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryLiquidityInfoParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if err := validateHistoryParams(params.Address, "", params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	liquidityInfos, total := keeper.Orm.GetLiquidityInfoList(params.Address, params.TokenPairName, params.Start,
+		params.End, offset, limit)
+	return marshalListResponse(liquidityInfos, len(liquidityInfos), total, params.Page, params.PerPage)
+}
+
+// queryFarmList returns the farm infos of the address or the farm pool
+func queryFarmList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFarmInfoParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if err := validateHistoryParams(params.Address, "", params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	farmInfos, total := keeper.Orm.GetFarmInfoList(params.Address, params.PoolName, params.Start, params.End,
+		offset, limit)
+	return marshalListResponse(farmInfos, len(farmInfos), total, params.Page, params.PerPage)
+}
+
+// queryStakingList returns the staking infos of the address or the validator
+func queryStakingList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStakingInfoParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if err := validateHistoryParams(params.Address, params.Validator, params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	stakingInfos, total := keeper.Orm.GetStakingInfoList(params.Address, params.Validator, params.Start, params.End,
+		offset, limit)
+	return marshalListResponse(stakingInfos, len(stakingInfos), total, params.Page, params.PerPage)
+}
+
+func queryLiquidityListV2(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryLiquidityInfoParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	liquidityInfos := keeper.Orm.GetLiquidityInfoListV2(params.Address, params.TokenPairName, params.After,
+		params.Before, params.Limit)
+	if len(liquidityInfos) == 0 {
+		return nil, nil
+	}
+	return marshalListV2(liquidityInfos)
+}
+
+func queryFarmListV2(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFarmInfoParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	farmInfos := keeper.Orm.GetFarmInfoListV2(params.Address, params.PoolName, params.After, params.Before,
+		params.Limit)
+	if len(farmInfos) == 0 {
+		return nil, nil
+	}
+	return marshalListV2(farmInfos)
+}
+
+func queryStakingListV2(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStakingInfoParamsV2
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	stakingInfos := keeper.Orm.GetStakingInfoListV2(params.Address, params.Validator, params.After, params.Before,
+		params.Limit)
+	if len(stakingInfos) == 0 {
+		return nil, nil
+	}
+	return marshalListV2(stakingInfos)
+}
+
+// validateHistoryParams checks the address, the validator and the page, the empty address and validator are allowed
+// queryProductHalts returns the circuit breaker halts of the product
+func queryProductHalts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProductHaltParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if err := validateHistoryParams("", "", params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	halts, total := keeper.Orm.GetProductHaltList(params.Product, params.Start, params.End, offset, limit)
+	return marshalListResponse(halts, len(halts), total, params.Page, params.PerPage)
+}
+
+func validateHistoryParams(address, validator string, page, perPage int) sdk.Error {
+	if address != "" {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid address", err.Error()))
+		}
+	}
+	if validator != "" {
+		if _, err := sdk.ValAddressFromBech32(validator); err != nil {
+			return sdk.ErrUnknownRequest(sdk.AppendMsgToErr("invalid validator address", err.Error()))
+		}
+	}
+	if page < 0 || perPage < 0 {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid page %d or per_page %d", page, perPage))
+	}
+	return nil
+}
+
+func marshalListResponse(data interface{}, count, total, page, perPage int) ([]byte, sdk.Error) {
+	var response *common.ListResponse
+	if count > 0 {
+		response = common.GetListResponse(total, page, perPage, data)
+	} else {
+		response = common.GetEmptyListResponse(total, page, perPage)
+	}
+	bz, err := json.Marshal(response)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return bz, nil
+}
+
+func marshalListV2(data interface{}) ([]byte, sdk.Error) {
+	res, err := common.JSONMarshalV2(data)
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+	return res, nil
+}
@@ -24,33 +24,39 @@ import (
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	OrderKeeper  types.OrderKeeper  // The reference to the OrderKeeper to get deals
-	TokenKeeper  types.TokenKeeper  // The reference to the TokenKeeper to get fee details
-	marketKeeper types.MarketKeeper // The reference to the MarketKeeper to get fee details
-	dexKeeper    types.DexKeeper    // The reference to the DexKeeper to get tokenpair
-	swapKeeper   types.SwapKeeper
-	cdc          *codec.Codec // The wire codec for binary encoding/decoding.
-	Orm          *orm.ORM
-	stopChan     chan struct{}
-	Config       *config.Config
-	Logger       log.Logger
-	wsChan       chan types.IWebsocket // Websocket channel, it's only available when websocket config enabled
-	ticker3sChan chan types.IWebsocket // Websocket channel, it's used by tickers merge triggered 3s once
-	Cache        *cache.Cache          // Memory cache
+	OrderKeeper   types.OrderKeeper  // The reference to the OrderKeeper to get deals
+	TokenKeeper   types.TokenKeeper  // The reference to the TokenKeeper to get fee details
+	marketKeeper  types.MarketKeeper // The reference to the MarketKeeper to get fee details
+	dexKeeper     types.DexKeeper    // The reference to the DexKeeper to get tokenpair
+	swapKeeper    types.SwapKeeper
+	farmKeeper    types.FarmKeeper
+	stakingKeeper types.StakingKeeper
+	cdc           *codec.Codec // The wire codec for binary encoding/decoding.
+	Orm           *orm.ORM
+	stopChan      chan struct{}
+	Config        *config.Config
+	Logger        log.Logger
+	wsChan        chan types.IWebsocket // Websocket channel, it's only available when websocket config enabled
+	ticker3sChan  chan types.IWebsocket // Websocket channel, it's used by tickers merge triggered 3s once
+	Cache         *cache.Cache          // Memory cache
 }
 
 // NewKeeper creates new instances of the nameservice Keeper
-func NewKeeper(orderKeeper types.OrderKeeper, tokenKeeper types.TokenKeeper, dexKeeper types.DexKeeper, swapKeeper types.SwapKeeper, marketKeeper types.MarketKeeper, cdc *codec.Codec, logger log.Logger, cfg *config.Config) Keeper {
+func NewKeeper(orderKeeper types.OrderKeeper, tokenKeeper types.TokenKeeper, dexKeeper types.DexKeeper, swapKeeper types.SwapKeeper,
+	farmKeeper types.FarmKeeper, stakingKeeper types.StakingKeeper, marketKeeper types.MarketKeeper, cdc *codec.Codec,
+	logger log.Logger, cfg *config.Config) Keeper {
 	k := Keeper{
-		OrderKeeper:  orderKeeper,
-		TokenKeeper:  tokenKeeper,
-		marketKeeper: marketKeeper,
-		dexKeeper:    dexKeeper,
-		swapKeeper:   swapKeeper,
-		cdc:          cdc,
-		Logger:       logger.With("module", "backend"),
-		Config:       cfg,
-		wsChan:       nil,
+		OrderKeeper:   orderKeeper,
+		TokenKeeper:   tokenKeeper,
+		marketKeeper:  marketKeeper,
+		dexKeeper:     dexKeeper,
+		swapKeeper:    swapKeeper,
+		farmKeeper:    farmKeeper,
+		stakingKeeper: stakingKeeper,
+		cdc:           cdc,
+		Logger:        logger.With("module", "backend"),
+		Config:        cfg,
+		wsChan:        nil,
 	}
 
 	if k.Config.EnableBackend {
@@ -76,6 +82,12 @@ func NewKeeper(orderKeeper types.OrderKeeper, tokenKeeper types.TokenKeeper, dex
 
 			// set observer keeper
 			k.swapKeeper.SetObserverKeeper(k)
+			if k.farmKeeper != nil {
+				k.farmKeeper.SetObserverKeeper(k)
+			}
+			if k.stakingKeeper != nil {
+				k.stakingKeeper.SetObserverKeeper(k)
+			}
 		}
 
 	}
@@ -145,7 +157,7 @@ func (k Keeper) EmitAllWsItems(ctx sdk.Context) {
 
 		freq := types.GetFreqByKlineType(klineType)
 
-		tokenPairs := k.getAllProducts(ctx)
+		tokenPairs := k.getAllKlineProducts(ctx)
 		for _, tp := range tokenPairs {
 
 			klines, err := k.getCandlesWithTimeFromORM(tp, freq, 1, ts)
@@ -218,8 +230,8 @@ func (k Keeper) GetDeals(ctx sdk.Context, sender, product, side string, start, e
 }
 
 // nolint
-func (k Keeper) GetFeeDetails(ctx sdk.Context, addr string, offset, limit int) ([]token.FeeDetail, int) {
-	return k.Orm.GetFeeDetails(addr, offset, limit)
+func (k Keeper) GetFeeDetails(ctx sdk.Context, addr string, start, end int64, offset, limit int) ([]token.FeeDetail, int) {
+	return k.Orm.GetFeeDetails(addr, start, end, offset, limit)
 }
 
 // nolint
@@ -247,6 +259,24 @@ func (k Keeper) getAllProducts(ctx sdk.Context) []string {
 	return products
 }
 
+// getAllKlineProducts returns the products of the dex and the ones of the swap token pairs
+func (k Keeper) getAllKlineProducts(ctx sdk.Context) []string {
+	products := k.getAllProducts(ctx)
+	for _, swapTokenPair := range k.swapKeeper.GetSwapTokenPairs(ctx) {
+		products = append(products, types.SwapKlineProduct(swapTokenPair.TokenPairName()))
+	}
+	return products
+}
+
+// klineProductExists returns whether the product is a token pair of the dex or a swap token pair
+func (k Keeper) klineProductExists(ctx sdk.Context, product string) bool {
+	if types.IsSwapKlineProduct(product) {
+		_, err := k.swapKeeper.GetSwapTokenPair(ctx, types.SwapTokenPairNameFromProduct(product))
+		return err == nil
+	}
+	return k.dexKeeper.GetTokenPair(ctx, product) != nil
+}
+
 // nolint
 func (k Keeper) getCandlesWithTimeFromORM(product string, granularity, size int, ts int64) (r []types.IKline, err error) {
 	if !k.Config.EnableBackend {
@@ -468,18 +498,34 @@ func (k Keeper) mergeTicker3SecondEvents() (err error) {
 }
 
 func (k Keeper) OnSwapToken(ctx sdk.Context, address sdk.AccAddress, swapTokenPair ammswap.SwapTokenPair, sellAmount sdk.SysCoin, buyAmount sdk.SysCoin) {
-	swapInfo := &types.SwapInfo{
-		Address:          address.String(),
-		TokenPairName:    swapTokenPair.TokenPairName(),
-		BaseTokenAmount:  swapTokenPair.BasePooledCoin.String(),
-		QuoteTokenAmount: swapTokenPair.QuotePooledCoin.String(),
-		SellAmount:       sellAmount.String(),
-		BuysAmount:       buyAmount.String(),
-		Price:            swapTokenPair.BasePooledCoin.Amount.Quo(swapTokenPair.QuotePooledCoin.Amount).String(),
-		Timestamp:        ctx.BlockTime().Unix(),
-	}
+	swapInfo := types.NewSwapInfo(address, swapTokenPair, sellAmount, buyAmount, ctx.BlockTime().Unix())
+	swapInfo.BlockHeight = ctx.BlockHeight()
+	feeRate := k.swapKeeper.GetParams(ctx).FeeRate
+	swapInfo.Fee = sdk.NewDecCoinFromDec(sellAmount.Denom, sellAmount.Amount.Mul(feeRate)).String()
 	k.Cache.AddSwapInfo(swapInfo)
 }
 
 func (k Keeper) OnSwapCreateExchange(ctx sdk.Context, swapTokenPair ammswap.SwapTokenPair) {
 }
+
+func (k Keeper) OnAddLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair ammswap.SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	k.addLiquidityInfo(ctx, types.LiquidityTypeAdd, address, swapTokenPair, baseAmount, quoteAmount, liquidity)
+}
+
+func (k Keeper) OnRemoveLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair ammswap.SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	k.addLiquidityInfo(ctx, types.LiquidityTypeRemove, address, swapTokenPair, baseAmount, quoteAmount, liquidity)
+}
+
+func (k Keeper) addLiquidityInfo(ctx sdk.Context, liquidityType string, address sdk.AccAddress,
+	swapTokenPair ammswap.SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	k.Cache.AddLiquidityInfo(&types.LiquidityInfo{
+		Address:       address.String(),
+		TokenPairName: swapTokenPair.TokenPairName(),
+		Type:          liquidityType,
+		BaseAmount:    baseAmount.String(),
+		QuoteAmount:   quoteAmount.String(),
+		Liquidity:     liquidity.String(),
+		BlockHeight:   ctx.BlockHeight(),
+		Timestamp:     ctx.BlockTime().Unix(),
+	})
+}
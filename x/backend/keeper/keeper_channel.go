@@ -41,7 +41,7 @@ func generateKline1M(keeper Keeper) {
 	}
 
 	//ds := DealDataSource{orm: orm}
-	ds := orm.MergeResultDataSource{Orm: keeper.Orm}
+	ds := orm.MergeResultSwapDataSource{Orm: keeper.Orm}
 	anchorNewStartTS, _, newKline1s, err := keeper.Orm.CreateKline1M(startTS, endTS, &ds)
 	if err != nil {
 		keeper.Logger.Debug(fmt.Sprintf("[backend] generateKline1M go routine error: %+v \n", err))
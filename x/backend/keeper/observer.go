@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// OnFarmPoolUpdated implements farm ObserverKeeper, the pool state is not recorded
+func (k Keeper) OnFarmPoolUpdated(ctx sdk.Context, poolName string) {
+}
+
+// OnFarmLock implements farm ObserverKeeper
+func (k Keeper) OnFarmLock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin) {
+	k.addFarmInfo(ctx, types.FarmTypeLock, address, poolName, amount.String())
+}
+
+// OnFarmUnlock implements farm ObserverKeeper
+func (k Keeper) OnFarmUnlock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin) {
+	k.addFarmInfo(ctx, types.FarmTypeUnlock, address, poolName, amount.String())
+}
+
+// OnFarmClaim implements farm ObserverKeeper
+func (k Keeper) OnFarmClaim(ctx sdk.Context, address sdk.AccAddress, poolName string, rewards sdk.SysCoins) {
+	k.addFarmInfo(ctx, types.FarmTypeClaim, address, poolName, rewards.String())
+}
+
+func (k Keeper) addFarmInfo(ctx sdk.Context, farmType string, address sdk.AccAddress, poolName, amount string) {
+	k.Cache.AddFarmInfo(&types.FarmInfo{
+		Address:     address.String(),
+		PoolName:    poolName,
+		Type:        farmType,
+		Amount:      amount,
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
+}
+
+// OnStakingDeposit implements staking ObserverKeeper
+func (k Keeper) OnStakingDeposit(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.SysCoin) {
+	k.Cache.AddStakingInfo(&types.StakingInfo{
+		Address:     delAddr.String(),
+		Type:        types.StakingTypeDeposit,
+		Amount:      amount.String(),
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
+}
+
+// OnStakingWithdraw implements staking ObserverKeeper
+func (k Keeper) OnStakingWithdraw(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.SysCoin) {
+	k.Cache.AddStakingInfo(&types.StakingInfo{
+		Address:     delAddr.String(),
+		Type:        types.StakingTypeWithdraw,
+		Amount:      amount.String(),
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
+}
+
+// OnStakingAddShares implements staking ObserverKeeper
+func (k Keeper) OnStakingAddShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, shares sdk.Dec) {
+	validators := make([]string, len(valAddrs))
+	for i, valAddr := range valAddrs {
+		validators[i] = valAddr.String()
+	}
+	k.Cache.AddStakingInfo(&types.StakingInfo{
+		Address:     delAddr.String(),
+		Type:        types.StakingTypeAddShares,
+		Validators:  strings.Join(validators, ","),
+		Shares:      shares.String(),
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime().Unix(),
+	})
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/common"
+)
+
+// queryPositionList returns the positions of the address
+func queryPositionList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPositionParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if params.Address == "" {
+		return nil, sdk.ErrUnknownRequest("invalid params: address is required")
+	}
+	if err := validateHistoryParams(params.Address, "", params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	positions, total := keeper.Orm.GetPositionList(params.Address, params.Product, offset, limit)
+	return marshalListResponse(positions, len(positions), total, params.Page, params.PerPage)
+}
+
+// queryPositionDaily returns the daily snapshots of the positions of the address
+func queryPositionDaily(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryPositionSnapshotParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if params.Address == "" {
+		return nil, sdk.ErrUnknownRequest("invalid params: address is required")
+	}
+	if err := validateHistoryParams(params.Address, "", params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	snapshots, total := keeper.Orm.GetPositionSnapshotList(params.Address, params.Product, params.Start, params.End,
+		offset, limit)
+	return marshalListResponse(snapshots, len(snapshots), total, params.Page, params.PerPage)
+}
@@ -70,6 +70,22 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 		case types.QuerySwapWatchlist:
 			res, err = querySwapWatchlist(ctx, req, keeper)
+		case types.QuerySwapInfoList:
+			res, err = querySwapInfoList(ctx, req, keeper)
+		case types.QueryLiquidityList:
+			res, err = queryLiquidityList(ctx, req, keeper)
+		case types.QueryFarmList:
+			res, err = queryFarmList(ctx, req, keeper)
+		case types.QueryStakingList:
+			res, err = queryStakingList(ctx, req, keeper)
+		case types.QueryPositionList:
+			res, err = queryPositionList(ctx, req, keeper)
+		case types.QueryPositionDaily:
+			res, err = queryPositionDaily(ctx, req, keeper)
+		case types.QueryFeeTier:
+			res, err = queryFeeTier(ctx, req, keeper)
+		case types.QueryProductHalts:
+			res, err = queryProductHalts(ctx, req, keeper)
 		case types.QueryTickerListV2:
 			if keeper.Config.EnableMktCompute {
 				res, err = queryTickerListV2(ctx, path[1:], req, keeper)
@@ -102,6 +118,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			res, err = queryDealsV2(ctx, path[1:], req, keeper)
 		case types.QueryTxListV2:
 			res, err = queryTxListV2(ctx, path[1:], req, keeper)
+		case types.QueryLiquidityListV2:
+			res, err = queryLiquidityListV2(ctx, req, keeper)
+		case types.QueryFarmListV2:
+			res, err = queryFarmListV2(ctx, req, keeper)
+		case types.QueryStakingListV2:
+			res, err = queryStakingListV2(ctx, req, keeper)
 		default:
 			res, err = nil, sdk.ErrUnknownRequest("unknown backend endpoint")
 		}
@@ -195,7 +217,7 @@ func queryFeeDetails(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 	}
 
 	offset, limit := common.GetPage(params.Page, params.PerPage)
-	feeDetails, total := keeper.GetFeeDetails(ctx, params.Address, offset, limit)
+	feeDetails, total := keeper.GetFeeDetails(ctx, params.Address, params.Start, params.End, offset, limit)
 	var response *common.ListResponse
 	if len(feeDetails) > 0 {
 		response = common.GetListResponse(total, params.Page, params.PerPage, feeDetails)
@@ -219,7 +241,7 @@ func queryCandleList(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 	if params.Product == "" {
 		return nil, sdk.ErrUnknownRequest("invalid params: product is required")
 	}
-	if keeper.dexKeeper.GetTokenPair(ctx, params.Product) == nil {
+	if !keeper.klineProductExists(ctx, params.Product) {
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("product %s does not exist", params.Product))
 	}
 
@@ -280,12 +302,12 @@ func queryTickerList(ctx sdk.Context, path []string, req abci.RequestQuery, keep
 
 	products := []string{}
 	if params.Product != "" {
-		if tokenPair := keeper.dexKeeper.GetTokenPair(ctx, params.Product); tokenPair == nil {
+		if !keeper.klineProductExists(ctx, params.Product) {
 			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("product %s does not exist", params.Product))
 		}
 		products = append(products, params.Product)
 	} else {
-		products = keeper.getAllProducts(ctx)
+		products = keeper.getAllKlineProducts(ctx)
 	}
 
 	// set default count to 10
@@ -161,6 +161,22 @@ func querySwapWatchlist(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (
 	return bz, nil
 }
 
+// querySwapInfoList returns the swaps of the address or the swap token pair
+func querySwapInfoList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySwapInfoParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+	if err := validateHistoryParams(params.Address, "", params.Page, params.PerPage); err != nil {
+		return nil, err
+	}
+
+	offset, limit := common.GetPage(params.Page, params.PerPage)
+	swapInfos, total := keeper.Orm.GetSwapInfoList(params.Address, params.TokenPairName, params.Start, params.End,
+		offset, limit)
+	return marshalListResponse(swapInfos, len(swapInfos), total, params.Page, params.PerPage)
+}
+
 // calculate baseAmount and quoteAmount in dollar by usdk
 func calculateDollarAmount(ctx sdk.Context, keeper Keeper, baseAmount sdk.SysCoin, quoteAmount sdk.SysCoin) sdk.Dec {
 	dollarAmount := sdk.ZeroDec()
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	ammswaptypes "github.com/okex/okexchain/x/ammswap/types"
 	"github.com/okex/okexchain/x/backend/cases"
 	"github.com/okex/okexchain/x/backend/config"
 	"github.com/okex/okexchain/x/backend/orm"
@@ -62,7 +63,7 @@ func TestKeeper_AllInOne_Smoke(t *testing.T) {
 	require.True(t, orders2 != nil && len(orders2) == cnt2)
 	require.True(t, (cnt1+cnt2) == 1)
 
-	_, cnt := app.backendKeeper.GetFeeDetails(ctx, orders[0].Sender.String(), 0, 100)
+	_, cnt := app.backendKeeper.GetFeeDetails(ctx, orders[0].Sender.String(), 0, 0, 0, 100)
 
 	require.True(t, cnt > 0)
 }
@@ -402,3 +403,21 @@ func buildCancelOrderMsg(sender sdk.AccAddress, blockHeight, orderNum int) order
 
 	return msgCancelOrder
 }
+
+func TestKeeper_OnSwapToken(t *testing.T) {
+	mapp, addrKeysSlice := getMockApp(t, 1, true, "")
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{Time: time.Now()}).WithBlockHeight(2)
+	mapp.swapKeeper.SetParams(ctx, ammswaptypes.DefaultParams())
+
+	swapTokenPair := ammswaptypes.NewSwapTokenPair(sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(100)),
+		sdk.NewDecCoinFromDec(common.TestToken, sdk.NewDec(100)), ammswaptypes.GetPoolTokenName(common.TestToken, common.NativeToken))
+	mapp.backendKeeper.OnSwapToken(ctx, addrKeysSlice[0].Address, *swapTokenPair,
+		sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(10)), sdk.NewDecCoinFromDec(common.TestToken, sdk.NewDec(9)))
+
+	// the fee is charged at the swap fee rate in the token sold
+	swapInfos := mapp.backendKeeper.Cache.GetSwapInfos()
+	require.Len(t, swapInfos, 1)
+	require.EqualValues(t, 2, swapInfos[0].BlockHeight)
+	require.Equal(t, sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDecWithPrec(3, 2)).String(), swapInfos[0].Fee)
+}
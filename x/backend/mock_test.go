@@ -162,6 +162,8 @@ func getMockApp(t *testing.T, numGenAccs int, enableBackend bool, dbDir string)
 		&mockApp.dexKeeper,
 		&mockApp.swapKeeper,
 		nil,
+		nil,
+		nil,
 		mockApp.Cdc,
 		mockApp.Logger(),
 		cfg)
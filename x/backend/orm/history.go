@@ -0,0 +1,258 @@
+package orm
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// whereTimeRange filters the records in [startTime, endTime), the zero bound is ignored
+func whereTimeRange(query *gorm.DB, startTime, endTime int64) *gorm.DB {
+	if startTime > 0 {
+		query = query.Where("timestamp >= ?", startTime)
+	}
+	if endTime > 0 {
+		query = query.Where("timestamp < ?", endTime)
+	}
+	return query
+}
+
+// whereAfterBefore filters the records by the cursors of the v2 interfaces, the empty cursor is ignored
+func whereAfterBefore(query *gorm.DB, after, before string) *gorm.DB {
+	if after != "" {
+		query = query.Where("timestamp > ?", after)
+	}
+	if before != "" {
+		query = query.Where("timestamp < ?", before)
+	}
+	return query
+}
+
+// AddLiquidityInfos insert into liquidity infos, return count
+func (orm *ORM) AddLiquidityInfos(liquidityInfos []*types.LiquidityInfo) (addedCnt int, err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer orm.deferRollbackTx(tx, err)
+	cnt := 0
+
+	for _, liquidityInfo := range liquidityInfos {
+		if liquidityInfo != nil {
+			ret := tx.Create(liquidityInfo)
+			if ret.Error != nil {
+				return cnt, ret.Error
+			}
+			cnt++
+		}
+	}
+
+	tx.Commit()
+	return cnt, nil
+}
+
+// nolint
+func (orm *ORM) GetLiquidityInfoList(address, tokenPairName string, startTime, endTime int64,
+	offset, limit int) ([]types.LiquidityInfo, int) {
+	var liquidityInfos []types.LiquidityInfo
+	query := orm.db.Model(types.LiquidityInfo{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if tokenPairName != "" {
+		query = query.Where("token_pair_name = ?", tokenPairName)
+	}
+	query = whereTimeRange(query, startTime, endTime)
+
+	var total int
+	query.Count(&total)
+	if offset >= total {
+		return liquidityInfos, total
+	}
+
+	query.Order("timestamp desc").Offset(offset).Limit(limit).Find(&liquidityInfos)
+	return liquidityInfos, total
+}
+
+// nolint
+func (orm *ORM) GetLiquidityInfoListV2(address, tokenPairName string, after, before string, limit int) []types.LiquidityInfo {
+	var liquidityInfos []types.LiquidityInfo
+	query := orm.db.Model(types.LiquidityInfo{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if tokenPairName != "" {
+		query = query.Where("token_pair_name = ?", tokenPairName)
+	}
+	query = whereAfterBefore(query, after, before)
+
+	query.Order("timestamp desc").Limit(limit).Find(&liquidityInfos)
+	return liquidityInfos
+}
+
+// AddFarmInfos insert into farm infos, return count
+func (orm *ORM) AddFarmInfos(farmInfos []*types.FarmInfo) (addedCnt int, err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer orm.deferRollbackTx(tx, err)
+	cnt := 0
+
+	for _, farmInfo := range farmInfos {
+		if farmInfo != nil {
+			ret := tx.Create(farmInfo)
+			if ret.Error != nil {
+				return cnt, ret.Error
+			}
+			cnt++
+		}
+	}
+
+	tx.Commit()
+	return cnt, nil
+}
+
+// nolint
+func (orm *ORM) GetFarmInfoList(address, poolName string, startTime, endTime int64,
+	offset, limit int) ([]types.FarmInfo, int) {
+	var farmInfos []types.FarmInfo
+	query := orm.db.Model(types.FarmInfo{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if poolName != "" {
+		query = query.Where("pool_name = ?", poolName)
+	}
+	query = whereTimeRange(query, startTime, endTime)
+
+	var total int
+	query.Count(&total)
+	if offset >= total {
+		return farmInfos, total
+	}
+
+	query.Order("timestamp desc").Offset(offset).Limit(limit).Find(&farmInfos)
+	return farmInfos, total
+}
+
+// nolint
+func (orm *ORM) GetFarmInfoListV2(address, poolName string, after, before string, limit int) []types.FarmInfo {
+	var farmInfos []types.FarmInfo
+	query := orm.db.Model(types.FarmInfo{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if poolName != "" {
+		query = query.Where("pool_name = ?", poolName)
+	}
+	query = whereAfterBefore(query, after, before)
+
+	query.Order("timestamp desc").Limit(limit).Find(&farmInfos)
+	return farmInfos
+}
+
+// AddStakingInfos insert into staking infos, return count
+func (orm *ORM) AddStakingInfos(stakingInfos []*types.StakingInfo) (addedCnt int, err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer orm.deferRollbackTx(tx, err)
+	cnt := 0
+
+	for _, stakingInfo := range stakingInfos {
+		if stakingInfo != nil {
+			ret := tx.Create(stakingInfo)
+			if ret.Error != nil {
+				return cnt, ret.Error
+			}
+			cnt++
+		}
+	}
+
+	tx.Commit()
+	return cnt, nil
+}
+
+// nolint
+func (orm *ORM) GetStakingInfoList(address, validator string, startTime, endTime int64,
+	offset, limit int) ([]types.StakingInfo, int) {
+	var stakingInfos []types.StakingInfo
+	query := orm.db.Model(types.StakingInfo{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if validator != "" {
+		query = query.Where("validators LIKE ?", "%"+validator+"%")
+	}
+	query = whereTimeRange(query, startTime, endTime)
+
+	var total int
+	query.Count(&total)
+	if offset >= total {
+		return stakingInfos, total
+	}
+
+	query.Order("timestamp desc").Offset(offset).Limit(limit).Find(&stakingInfos)
+	return stakingInfos, total
+}
+
+// nolint
+func (orm *ORM) GetStakingInfoListV2(address, validator string, after, before string, limit int) []types.StakingInfo {
+	var stakingInfos []types.StakingInfo
+	query := orm.db.Model(types.StakingInfo{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if validator != "" {
+		query = query.Where("validators LIKE ?", "%"+validator+"%")
+	}
+	query = whereAfterBefore(query, after, before)
+
+	query.Order("timestamp desc").Limit(limit).Find(&stakingInfos)
+	return stakingInfos
+}
+
+// AddProductHalts insert into product halts, return count
+func (orm *ORM) AddProductHalts(halts []*types.ProductHalt) (addedCnt int, err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer orm.deferRollbackTx(tx, err)
+	cnt := 0
+
+	for _, halt := range halts {
+		if halt != nil {
+			ret := tx.Create(halt)
+			if ret.Error != nil {
+				return cnt, ret.Error
+			}
+			cnt++
+		}
+	}
+
+	tx.Commit()
+	return cnt, nil
+}
+
+// nolint
+func (orm *ORM) GetProductHaltList(product string, startTime, endTime int64, offset, limit int) ([]types.ProductHalt,
+	int) {
+	var halts []types.ProductHalt
+	query := orm.db.Model(types.ProductHalt{})
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+	query = whereTimeRange(query, startTime, endTime)
+
+	var total int
+	query.Count(&total)
+	if offset >= total {
+		return halts, total
+	}
+
+	query.Order("timestamp desc").Offset(offset).Limit(limit).Find(&halts)
+	return halts, total
+}
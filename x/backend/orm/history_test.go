@@ -0,0 +1,83 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+func TestORM_LiquidityInfos(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	infos := []*types.LiquidityInfo{
+		{Address: "addr1", TokenPairName: "xxb_okt", Type: types.LiquidityTypeAdd, Timestamp: 100},
+		{Address: "addr1", TokenPairName: "yyb_okt", Type: types.LiquidityTypeAdd, Timestamp: 200},
+		{Address: "addr2", TokenPairName: "xxb_okt", Type: types.LiquidityTypeRemove, Timestamp: 300},
+	}
+	cnt, err := orm.AddLiquidityInfos(infos)
+	require.NoError(t, err)
+	require.Equal(t, 3, cnt)
+
+	list, total := orm.GetLiquidityInfoList("addr1", "", 0, 0, 0, 10)
+	require.Equal(t, 2, total)
+	require.Equal(t, int64(200), list[0].Timestamp)
+
+	list, total = orm.GetLiquidityInfoList("", "xxb_okt", 100, 300, 0, 10)
+	require.Equal(t, 1, total)
+	require.Equal(t, "addr1", list[0].Address)
+
+	list = orm.GetLiquidityInfoListV2("", "", "100", "300", 10)
+	require.Len(t, list, 1)
+	require.Equal(t, int64(200), list[0].Timestamp)
+	require.Len(t, orm.GetLiquidityInfoListV2("", "", "", "", 2), 2)
+}
+
+func TestORM_FarmInfos(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	infos := []*types.FarmInfo{
+		{Address: "addr1", PoolName: "pool1", Type: types.FarmTypeLock, Amount: "1.000000000000000000xxb", Timestamp: 100},
+		{Address: "addr1", PoolName: "pool1", Type: types.FarmTypeClaim, Amount: "0.100000000000000000okt", Timestamp: 200},
+		{Address: "addr2", PoolName: "pool2", Type: types.FarmTypeUnlock, Amount: "1.000000000000000000yyb", Timestamp: 300},
+	}
+	cnt, err := orm.AddFarmInfos(infos)
+	require.NoError(t, err)
+	require.Equal(t, 3, cnt)
+
+	list, total := orm.GetFarmInfoList("", "pool1", 0, 0, 0, 10)
+	require.Equal(t, 2, total)
+	require.Equal(t, types.FarmTypeClaim, list[0].Type)
+
+	list = orm.GetFarmInfoListV2("addr2", "", "", "", 10)
+	require.Len(t, list, 1)
+	require.Equal(t, "pool2", list[0].PoolName)
+}
+
+func TestORM_StakingInfos(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	infos := []*types.StakingInfo{
+		{Address: "addr1", Type: types.StakingTypeDeposit, Amount: "10.000000000000000000okt", Timestamp: 100},
+		{Address: "addr1", Type: types.StakingTypeAddShares, Validators: "val1,val2", Shares: "10", Timestamp: 200},
+		{Address: "addr2", Type: types.StakingTypeAddShares, Validators: "val2", Shares: "1", Timestamp: 300},
+	}
+	cnt, err := orm.AddStakingInfos(infos)
+	require.NoError(t, err)
+	require.Equal(t, 3, cnt)
+
+	_, total := orm.GetStakingInfoList("addr1", "", 0, 0, 0, 10)
+	require.Equal(t, 2, total)
+
+	list, total := orm.GetStakingInfoList("", "val2", 0, 0, 0, 10)
+	require.Equal(t, 2, total)
+	require.Equal(t, "addr2", list[0].Address)
+
+	list = orm.GetStakingInfoListV2("", "val1", "", "", 10)
+	require.Len(t, list, 1)
+	require.Equal(t, "addr1", list[0].Address)
+}
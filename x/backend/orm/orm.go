@@ -46,6 +46,7 @@ type ORM struct {
 	klineM1sBuffer         map[string][]types.KlineM1
 	maxBlockTimestampMutex *sync.RWMutex
 	maxBlockTimestamp      int64
+	engineType             string
 }
 
 func (o *ORM) SetMaxBlockTimestamp(maxBlockTimestamp int64) {
@@ -89,6 +90,7 @@ func New(enableLog bool, engineInfo *OrmEngineInfo, logger *log.Logger) (m *ORM,
 
 	orm.logger = logger
 	orm.db = db
+	orm.engineType = engineInfo.EngineType
 	orm.lastK1Timestamp = -1
 	orm.lastK15Timestamp = -1
 	orm.bufferLock = new(sync.Mutex)
@@ -101,15 +103,50 @@ func New(enableLog bool, engineInfo *OrmEngineInfo, logger *log.Logger) (m *ORM,
 	orm.db.AutoMigrate(&types.Order{})
 	orm.db.AutoMigrate(&types.Transaction{})
 	orm.db.AutoMigrate(&types.SwapInfo{})
+	orm.db.AutoMigrate(&types.LiquidityInfo{})
+	orm.db.AutoMigrate(&types.FarmInfo{})
+	orm.db.AutoMigrate(&types.StakingInfo{})
+	orm.db.AutoMigrate(&types.ProductHalt{})
+	orm.db.AutoMigrate(&types.Position{})
+	orm.db.AutoMigrate(&types.PositionSnapshot{})
 
 	allKlinesMap := types.GetAllKlineMap()
 	for _, v := range allKlinesMap {
 		k := types.MustNewKlineFactory(v, nil)
 		orm.db.AutoMigrate(k)
+		if err := orm.migrateKlineProductColumn(k); err != nil {
+			return nil, err
+		}
 	}
 	return &orm, nil
 }
 
+// klineProductLength is the length of the product column of the klines, which holds the products of the swap token
+// pairs too
+const klineProductLength = 40
+
+// migrateKlineProductColumn widens the product column of the kline table created with a shorter one, which AutoMigrate
+// never alters. Only the mysql is migrated, the sqlite doesn't limit the length of varchar
+func (orm *ORM) migrateKlineProductColumn(kline interface{}) error {
+	if orm.engineType != EngineTypeMysql {
+		return nil
+	}
+
+	var length int
+	tableName := orm.db.NewScope(kline).TableName()
+	row := orm.db.Raw("SELECT character_maximum_length FROM information_schema.columns "+
+		"WHERE table_schema = DATABASE() AND table_name = ? AND column_name = 'product'", tableName).Row()
+	if err := row.Scan(&length); err != nil {
+		return fmt.Errorf("failed to get the product column of %s: %v", tableName, err)
+	}
+	if length >= klineProductLength {
+		return nil
+	}
+
+	orm.Debug(fmt.Sprintf("widen the product column of %s from varchar(%d)", tableName, length))
+	return orm.db.Model(kline).ModifyColumn("product", fmt.Sprintf("varchar(%d)", klineProductLength)).Error
+}
+
 // Debug log  debug info when use orm
 func (orm *ORM) Debug(msg string) {
 	if orm.logger != nil {
@@ -135,6 +172,25 @@ func (orm *ORM) deferRollbackTx(trx *gorm.DB, returnErr error) {
 	}
 }
 
+// DeleteBlockRecords deletes the records stored of the block, except the orders and the positions, which are stored
+// idempotently. The records of a block interrupted before it's committed are deleted before it's executed again
+func (orm *ORM) DeleteBlockRecords(height int64) (err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer orm.deferRollbackTx(tx, err)
+
+	for _, record := range []interface{}{&types.MatchResult{}, &types.Deal{}, &token.FeeDetail{},
+		&types.Transaction{}, &types.SwapInfo{}, &types.LiquidityInfo{}, &types.FarmInfo{}, &types.StakingInfo{},
+		&types.ProductHalt{}} {
+		if err = tx.Where("block_height = ?", height).Delete(record).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Commit().Error
+}
+
 // Close close the database by orm
 func (orm *ORM) Close() error {
 	return orm.db.Close()
@@ -660,6 +716,11 @@ func (orm *ORM) getAllUpdatedProducts(anchorStartTS, anchorEndTS int64) ([]strin
 		return nil, e1
 	}
 
+	p3, e3 := orm.getUpdatedSwapProducts(midTS, anchorEndTS)
+	if e3 != nil {
+		return nil, e3
+	}
+
 	tmpMap := map[string]bool{}
 	for _, p := range p1 {
 		tmpMap[p] = true
@@ -669,6 +730,10 @@ func (orm *ORM) getAllUpdatedProducts(anchorStartTS, anchorEndTS int64) ([]strin
 		tmpMap[p] = true
 	}
 
+	for _, p := range p3 {
+		tmpMap[p] = true
+	}
+
 	mergedKline := []string{}
 	for k := range tmpMap {
 		mergedKline = append(mergedKline, k)
@@ -926,7 +991,7 @@ func (orm *ORM) RefreshTickers(startTS, endTS int64, productList []string) (m ma
 	// 	2.3 For each product, get latest [anchorKM1TS, endTS) MatchResult list
 	matchResultMap := make(map[string][]types.MatchResult)
 	for _, product := range productList {
-		matchResults, err := orm.getMatchResultsByTimeRange(product, anchorKM1TS, endTS)
+		matchResults, err := orm.getTickerMatchResultsByTimeRange(product, anchorKM1TS, endTS)
 		if err != nil {
 			orm.Error(fmt.Sprintf("failed to GetMatchResultsByTimeRange, error: %s", err.Error()))
 			continue
@@ -998,7 +1063,7 @@ func (orm *ORM) RefreshTickers(startTS, endTS int64, productList []string) (m ma
 		}
 
 		if len(iklines) == 0 && len(matchResults) == 0 {
-			latestMatches, err := orm.getLatestMatchResults(p, 1)
+			latestMatches, err := orm.getLatestTickerMatchResults(p, 1)
 			if err != nil {
 				orm.Debug(fmt.Sprintf("failed to GetLatestMatchResults, error: %s", err.Error()))
 			}
@@ -1071,9 +1136,10 @@ func (orm *ORM) AddFeeDetails(feeDetails []*token.FeeDetail) (addedCnt int, err
 }
 
 // nolint
-func (orm *ORM) GetFeeDetails(address string, offset, limit int) ([]token.FeeDetail, int) {
+func (orm *ORM) GetFeeDetails(address string, startTime, endTime int64, offset, limit int) ([]token.FeeDetail, int) {
 	var feeDetails []token.FeeDetail
 	query := orm.db.Model(token.FeeDetail{}).Where("address = ?", address)
+	query = whereTimeRange(query, startTime, endTime)
 	var total int
 	query.Count(&total)
 	if offset >= total {
@@ -3,6 +3,8 @@ package orm
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/okex/okexchain/x/backend/types"
 	"github.com/okex/okexchain/x/common"
 	"github.com/okex/okexchain/x/token"
@@ -77,3 +79,22 @@ func TestNewORM_BatchInsert(t *testing.T) {
 	orm, _ := NewMysqlORM()
 	testORMBatchInsert(t, orm)
 }
+
+func TestMysql_MigrateKlineProductColumn(t *testing.T) {
+	common.SkipSysTestChecker(t)
+	orm, err := NewMysqlORM()
+	require.NoError(t, err)
+
+	// the kline table created before the product column was widened
+	kline := types.MustNewKlineFactory(types.KlineTypeM1, nil)
+	require.NoError(t, orm.db.Model(kline).ModifyColumn("product", "varchar(20)").Error)
+	orm, err = NewMysqlORM()
+	require.NoError(t, err)
+
+	var length int
+	row := orm.db.Raw("SELECT character_maximum_length FROM information_schema.columns "+
+		"WHERE table_schema = DATABASE() AND table_name = ? AND column_name = 'product'",
+		orm.db.NewScope(kline).TableName()).Row()
+	require.NoError(t, row.Scan(&length))
+	require.Equal(t, klineProductLength, length)
+}
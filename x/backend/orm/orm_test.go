@@ -1,7 +1,7 @@
 package orm
 
 import (
-	//"encoding/json"
+	"encoding/json"
 	"fmt"
 	"os"
 	"runtime/debug"
@@ -568,13 +568,13 @@ func testORMFeeDetails(t *testing.T, orm *ORM) {
 	require.Nil(t, err)
 
 	// Test GetFeeDetails
-	fees, total := orm.GetFeeDetails("addr1", 1, 2)
+	fees, total := orm.GetFeeDetails("addr1", 0, 0, 1, 2)
 	require.EqualValues(t, 3, total)
 	require.EqualValues(t, 2, len(fees))
 	require.EqualValues(t, 200, fees[0].Timestamp)
 	require.EqualValues(t, 100, fees[1].Timestamp)
 	// too large offset
-	fees, total = orm.GetFeeDetails("addr1", 3, 2)
+	fees, total = orm.GetFeeDetails("addr1", 0, 0, 3, 2)
 	require.EqualValues(t, 3, total)
 	require.EqualValues(t, 0, len(fees))
 
@@ -895,3 +895,69 @@ func TestORM_DeferRollbackTx(t *testing.T) {
 	panic("orm deferRollbackTx recover will catch the panic")
 
 }
+
+func TestORM_GetSwapInfoList(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	swapInfos := []*types.SwapInfo{
+		{Address: "addr1", TokenPairName: "xxb_okt", Timestamp: 100},
+		{Address: "addr1", TokenPairName: "yyb_okt", Timestamp: 200},
+		{Address: "addr2", TokenPairName: "xxb_okt", Timestamp: 300},
+	}
+	_, err := orm.AddSwapInfo(swapInfos)
+	require.NoError(t, err)
+
+	infos, total := orm.GetSwapInfoList("addr1", "", 0, 0, 0, 10)
+	require.Equal(t, 2, total)
+	require.EqualValues(t, 200, infos[0].Timestamp)
+
+	infos, total = orm.GetSwapInfoList("", "xxb_okt", 0, 300, 0, 10)
+	require.Equal(t, 1, total)
+	require.Equal(t, "addr1", infos[0].Address)
+
+	infos, total = orm.GetSwapInfoList("", "", 0, 0, 3, 10)
+	require.Equal(t, 3, total)
+	require.Len(t, infos, 0)
+}
+
+func TestORM_DeleteBlockRecords(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	_, err := orm.AddFeeDetails([]*token.FeeDetail{
+		{Address: "addr1", Fee: "0.1" + common.NativeToken, FeeType: types.FeeTypeOrderNew, Timestamp: 100, BlockHeight: 1},
+		{Address: "addr1", Fee: "0.1" + common.NativeToken, FeeType: types.FeeTypeOrderNew, Timestamp: 100, BlockHeight: 2},
+	})
+	require.NoError(t, err)
+	_, err = orm.AddTransactions([]*types.Transaction{
+		{TxHash: "hash1", Address: "addr1", Type: types.TxTypeTransfer, Timestamp: 100, BlockHeight: 1},
+		{TxHash: "hash2", Address: "addr1", Type: types.TxTypeTransfer, Timestamp: 100, BlockHeight: 2},
+	})
+	require.NoError(t, err)
+	_, err = orm.AddDeals([]*types.Deal{
+		{BlockHeight: 1, OrderID: "ID1", Sender: "addr1", Product: types.TestTokenPair, Timestamp: 100},
+		{BlockHeight: 2, OrderID: "ID2", Sender: "addr1", Product: types.TestTokenPair, Timestamp: 100},
+	})
+	require.NoError(t, err)
+
+	// the records of the block 2 stored before the interruption are deleted only
+	require.NoError(t, orm.DeleteBlockRecords(2))
+	fees, total := orm.GetFeeDetails("addr1", 0, 0, 0, 10)
+	require.Equal(t, 1, total)
+	require.EqualValues(t, 1, fees[0].BlockHeight)
+	txs, total := orm.GetTransactionList("addr1", 0, 0, 0, 0, 10)
+	require.Equal(t, 1, total)
+	require.Equal(t, "hash1", txs[0].TxHash)
+	deals, total := orm.GetDeals("addr1", "", "", 0, 0, 0, 10)
+	require.Equal(t, 1, total)
+	require.EqualValues(t, 1, deals[0].BlockHeight)
+
+	// the block height of the records added for the deletion isn't a part of their json
+	for _, record := range []interface{}{fees[0], txs[0], types.SwapInfo{BlockHeight: 1}} {
+		bz, err := json.Marshal(record)
+		require.NoError(t, err)
+		require.NotContains(t, string(bz), "block_height")
+		require.NotContains(t, string(bz), "BlockHeight")
+	}
+}
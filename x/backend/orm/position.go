@@ -0,0 +1,87 @@
+package orm
+
+import (
+	"github.com/jinzhu/gorm"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// UpdatePositions applies the trades to the positions in order and saves the daily snapshots of the positions
+// changed, return count of the trades applied
+func (orm *ORM) UpdatePositions(trades []types.PositionTrade) (appliedCnt int, err error) {
+	orm.singleEntryLock.Lock()
+	defer orm.singleEntryLock.Unlock()
+
+	tx := orm.db.Begin()
+	defer orm.deferRollbackTx(tx, err)
+	cnt := 0
+
+	// the positions the trades are applied to in this call
+	updated := make(map[types.Position]bool)
+	for _, trade := range trades {
+		position := types.Position{Address: trade.Address, Product: trade.Product}
+		ret := tx.Where("address = ? and product = ?", trade.Address, trade.Product).First(&position)
+		if ret.Error != nil && !gorm.IsRecordNotFoundError(ret.Error) {
+			return cnt, ret.Error
+		}
+
+		// the trades of a block applied to the position before are skipped, so that the block replayed by the reindex
+		// isn't counted twice
+		key := types.Position{Address: trade.Address, Product: trade.Product}
+		if !updated[key] && trade.BlockHeight <= position.BlockHeight {
+			continue
+		}
+		updated[key] = true
+
+		position.ApplyTrade(trade)
+		if ret = tx.Save(&position); ret.Error != nil {
+			return cnt, ret.Error
+		}
+		snapshot := types.NewPositionSnapshot(position)
+		if ret = tx.Save(&snapshot); ret.Error != nil {
+			return cnt, ret.Error
+		}
+		cnt++
+	}
+
+	tx.Commit()
+	return cnt, nil
+}
+
+// nolint
+func (orm *ORM) GetPositionList(address, product string, offset, limit int) ([]types.Position, int) {
+	var positions []types.Position
+	query := orm.db.Model(types.Position{}).Where("address = ?", address)
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+
+	var total int
+	query.Count(&total)
+	if offset >= total {
+		return positions, total
+	}
+
+	query.Order("timestamp desc").Offset(offset).Limit(limit).Find(&positions)
+	return positions, total
+}
+
+// nolint
+func (orm *ORM) GetPositionSnapshotList(address, product string, startTime, endTime int64,
+	offset, limit int) ([]types.PositionSnapshot, int) {
+	var snapshots []types.PositionSnapshot
+	query := orm.db.Model(types.PositionSnapshot{}).Where("address = ?", address)
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+	query = whereTimeRange(query, startTime, endTime)
+
+	var total int
+	query.Count(&total)
+	if offset >= total {
+		return snapshots, total
+	}
+
+	query.Order("timestamp desc").Offset(offset).Limit(limit).Find(&snapshots)
+	return snapshots, total
+}
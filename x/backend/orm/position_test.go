@@ -0,0 +1,88 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+func TestORM_UpdatePositions(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	day := int64(types.SecondsInADay) * 10
+	trades := []types.PositionTrade{
+		{Address: "addr1", Product: types.TestTokenPair, Side: types.BuyOrder, Price: 10, Quantity: 2, BlockHeight: 1, Timestamp: day + 100},
+		{Address: "addr1", Product: types.TestTokenPair, Side: types.SellOrder, Price: 12, Quantity: 1, BlockHeight: 1, Timestamp: day + 200},
+		{Address: "addr1", Product: "ammswap_xxb_okt", Side: types.BuyOrder, Price: 3, Quantity: 1, BlockHeight: 1, Timestamp: day + 300},
+		{Address: "addr2", Product: types.TestTokenPair, Side: types.SellOrder, Price: 10, Quantity: 2, BlockHeight: 1, Timestamp: day + 100},
+	}
+	cnt, err := orm.UpdatePositions(trades)
+	require.NoError(t, err)
+	require.Equal(t, 4, cnt)
+
+	// the block replayed isn't applied twice
+	cnt, err = orm.UpdatePositions(trades)
+	require.NoError(t, err)
+	require.Equal(t, 0, cnt)
+
+	// the next day
+	cnt, err = orm.UpdatePositions([]types.PositionTrade{
+		{Address: "addr1", Product: types.TestTokenPair, Side: types.SellOrder, Price: 14, Quantity: 1, BlockHeight: 2, Timestamp: day + types.SecondsInADay + 100},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, cnt)
+
+	positions, total := orm.GetPositionList("addr1", "", 0, 10)
+	require.Equal(t, 2, total)
+	require.Equal(t, types.TestTokenPair, positions[0].Product)
+	require.EqualValues(t, 0, positions[0].Quantity)
+	require.EqualValues(t, 6, positions[0].RealizedPnL)
+
+	positions, total = orm.GetPositionList("addr2", types.TestTokenPair, 0, 10)
+	require.Equal(t, 1, total)
+	require.EqualValues(t, -2, positions[0].Quantity)
+
+	snapshots, total := orm.GetPositionSnapshotList("addr1", types.TestTokenPair, 0, 0, 0, 10)
+	require.Equal(t, 2, total)
+	require.EqualValues(t, day+types.SecondsInADay, snapshots[0].Timestamp)
+	require.EqualValues(t, 6, snapshots[0].RealizedPnL)
+	require.EqualValues(t, day, snapshots[1].Timestamp)
+	require.EqualValues(t, 1, snapshots[1].Quantity)
+	require.EqualValues(t, 2, snapshots[1].RealizedPnL)
+
+	snapshots, total = orm.GetPositionSnapshotList("addr1", "", day+types.SecondsInADay, 0, 0, 10)
+	require.Equal(t, 1, total)
+}
+
+func TestORM_UpdatePositionsSkipAppliedBlocks(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	day := int64(types.SecondsInADay) * 10
+	buy := func(address string, height int64) types.PositionTrade {
+		return types.PositionTrade{Address: address, Product: types.TestTokenPair, Side: types.BuyOrder, Price: 10,
+			Quantity: 1, BlockHeight: height, Timestamp: day + height}
+	}
+
+	// the trades of the same block are all applied to the position, though it's at the block after the first one
+	cnt, err := orm.UpdatePositions([]types.PositionTrade{buy("addr1", 2), buy("addr1", 2)})
+	require.NoError(t, err)
+	require.Equal(t, 2, cnt)
+
+	// the replayed block and the earlier blocks are skipped, while the later block and the positions without the
+	// block applied are applied in the same call
+	cnt, err = orm.UpdatePositions([]types.PositionTrade{buy("addr1", 1), buy("addr1", 2), buy("addr2", 2),
+		buy("addr1", 3), buy("addr1", 3)})
+	require.NoError(t, err)
+	require.Equal(t, 3, cnt)
+
+	positions, _ := orm.GetPositionList("addr1", types.TestTokenPair, 0, 10)
+	require.EqualValues(t, 4, positions[0].Quantity)
+	require.EqualValues(t, 3, positions[0].BlockHeight)
+	positions, _ = orm.GetPositionList("addr2", types.TestTokenPair, 0, 10)
+	require.EqualValues(t, 1, positions[0].Quantity)
+	require.EqualValues(t, 2, positions[0].BlockHeight)
+}
@@ -36,3 +36,26 @@ func (orm *ORM) GetSwapInfo(startTime int64) []types.SwapInfo {
 	query.Order("timestamp asc").Find(&swapInfos)
 	return swapInfos
 }
+
+// nolint
+func (orm *ORM) GetSwapInfoList(address, tokenPairName string, startTime, endTime int64,
+	offset, limit int) ([]types.SwapInfo, int) {
+	var swapInfos []types.SwapInfo
+	query := orm.db.Model(types.SwapInfo{})
+	if address != "" {
+		query = query.Where("address = ?", address)
+	}
+	if tokenPairName != "" {
+		query = query.Where("token_pair_name = ?", tokenPairName)
+	}
+	query = whereTimeRange(query, startTime, endTime)
+
+	var total int
+	query.Count(&total)
+	if offset >= total {
+		return swapInfos, total
+	}
+
+	query.Order("timestamp desc").Offset(offset).Limit(limit).Find(&swapInfos)
+	return swapInfos, total
+}
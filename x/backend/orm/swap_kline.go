@@ -0,0 +1,127 @@
+package orm
+
+import (
+	"fmt"
+
+	ammswaptypes "github.com/okex/okexchain/x/ammswap/types"
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+// SwapInfoDataSource is the kline data source of the swap token pairs, the product of a swap token pair is
+// types.SwapKlineProduct of its name. Swaps stored without the swap price are ignored
+type SwapInfoDataSource struct {
+	Orm *ORM
+}
+
+func (dm *SwapInfoDataSource) getDataSourceMinTimestamp() int64 {
+	return dm.Orm.getMinTimestamp("swap_infos")
+}
+
+func (dm *SwapInfoDataSource) getMaxMinSumByGroupSQL(startTS, endTS int64) string {
+	sql := fmt.Sprintf("select %s as product, sum(base_volume) as quantity, max(swap_price) as high, min(swap_price) as low, count(swap_price) as cnt from swap_infos "+
+		"where Timestamp >= %d and Timestamp < %d and swap_price > 0 group by token_pair_name", dm.Orm.swapProductColumn(), startTS, endTS)
+	return sql
+}
+
+func (dm *SwapInfoDataSource) getOpenClosePrice(startTS, endTS int64, product string) (float64, float64) {
+	var openSwap, closeSwap types.SwapInfo
+	query := dm.Orm.db.Model(types.SwapInfo{}).Where("Timestamp >= ? and Timestamp < ? and token_pair_name = ? and swap_price > 0",
+		startTS, endTS, types.SwapTokenPairNameFromProduct(product))
+	query.Order("Timestamp desc").Limit(1).First(&closeSwap)
+	query.Order("Timestamp asc").Limit(1).First(&openSwap)
+	return openSwap.SwapPrice, closeSwap.SwapPrice
+}
+
+// MergeResultSwapDataSource is the kline data source of both the dex products and the swap token pairs
+type MergeResultSwapDataSource struct {
+	Orm *ORM
+}
+
+func (dm *MergeResultSwapDataSource) getDataSourceMinTimestamp() int64 {
+	mergeResultTS := (&MergeResultDataSource{Orm: dm.Orm}).getDataSourceMinTimestamp()
+	swapTS := (&SwapInfoDataSource{Orm: dm.Orm}).getDataSourceMinTimestamp()
+	if mergeResultTS == -1 || (swapTS != -1 && swapTS < mergeResultTS) {
+		return swapTS
+	}
+	return mergeResultTS
+}
+
+func (dm *MergeResultSwapDataSource) getMaxMinSumByGroupSQL(startTS, endTS int64) string {
+	return (&MergeResultDataSource{Orm: dm.Orm}).getMaxMinSumByGroupSQL(startTS, endTS) + " union all " +
+		(&SwapInfoDataSource{Orm: dm.Orm}).getMaxMinSumByGroupSQL(startTS, endTS)
+}
+
+func (dm *MergeResultSwapDataSource) getOpenClosePrice(startTS, endTS int64, product string) (float64, float64) {
+	if types.IsSwapKlineProduct(product) {
+		return (&SwapInfoDataSource{Orm: dm.Orm}).getOpenClosePrice(startTS, endTS, product)
+	}
+	return (&MergeResultDataSource{Orm: dm.Orm}).getOpenClosePrice(startTS, endTS, product)
+}
+
+// swapProductColumn returns the sql expression of the kline product of the swap infos
+func (orm *ORM) swapProductColumn() string {
+	if orm.engineType == EngineTypeMysql {
+		return fmt.Sprintf("concat('%s', token_pair_name)", ammswaptypes.PoolTokenPrefix)
+	}
+	return fmt.Sprintf("'%s' || token_pair_name", ammswaptypes.PoolTokenPrefix)
+}
+
+// getUpdatedSwapProducts returns the products of the swap token pairs swapped in [startTS, endTS)
+func (orm *ORM) getUpdatedSwapProducts(startTS, endTS int64) ([]string, error) {
+	var tokenPairNames []string
+	r := orm.db.Model(types.SwapInfo{}).Where("Timestamp >= ? and Timestamp < ? and swap_price > 0", startTS, endTS).
+		Pluck("distinct(token_pair_name)", &tokenPairNames)
+	if r.Error != nil {
+		return nil, r.Error
+	}
+
+	products := make([]string, len(tokenPairNames))
+	for i, name := range tokenPairNames {
+		products[i] = types.SwapKlineProduct(name)
+	}
+	return products, nil
+}
+
+// getSwapMatchResults returns the swaps of the swap token pair product in [startTS, endTS) as match results sorted by
+// timestamp desc, the time range is ignored if endTS is not positive
+func (orm *ORM) getSwapMatchResults(product string, startTS, endTS int64, limit int) ([]types.MatchResult, error) {
+	query := orm.db.Model(types.SwapInfo{}).Where("token_pair_name = ? and swap_price > 0",
+		types.SwapTokenPairNameFromProduct(product))
+	if endTS > 0 {
+		query = query.Where("Timestamp >= ? and Timestamp < ?", startTS, endTS)
+	}
+
+	var swapInfos []types.SwapInfo
+	if r := query.Order("Timestamp desc").Limit(limit).Find(&swapInfos); r.Error != nil {
+		return nil, r.Error
+	}
+
+	matchResults := make([]types.MatchResult, len(swapInfos))
+	for i, swapInfo := range swapInfos {
+		matchResults[i] = types.MatchResult{
+			Timestamp: swapInfo.Timestamp,
+			Product:   product,
+			Price:     swapInfo.SwapPrice,
+			Quantity:  swapInfo.BaseVolume,
+		}
+	}
+	return matchResults, nil
+}
+
+// getTickerMatchResultsByTimeRange returns the match results of the product in [startTS, endTS), which are made up
+// of the swaps if the product is a swap token pair
+func (orm *ORM) getTickerMatchResultsByTimeRange(product string, startTS, endTS int64) ([]types.MatchResult, error) {
+	if types.IsSwapKlineProduct(product) {
+		return orm.getSwapMatchResults(product, startTS, endTS, -1)
+	}
+	return orm.getMatchResultsByTimeRange(product, startTS, endTS)
+}
+
+// getLatestTickerMatchResults returns the latest match results of the product, which are made up of the swaps if the
+// product is a swap token pair
+func (orm *ORM) getLatestTickerMatchResults(product string, limit int) ([]types.MatchResult, error) {
+	if types.IsSwapKlineProduct(product) {
+		return orm.getSwapMatchResults(product, 0, 0, limit)
+	}
+	return orm.getLatestMatchResults(product, limit)
+}
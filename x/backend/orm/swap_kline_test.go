@@ -0,0 +1,69 @@
+package orm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/backend/types"
+)
+
+func TestORM_SwapKline1M(t *testing.T) {
+	orm, dbPath := MockSqlite3ORM()
+	defer DeleteDB(dbPath)
+
+	swapInfos := []*types.SwapInfo{
+		// stored before the swap price, ignored
+		{TokenPairName: "xxb_okt", Timestamp: 60},
+		{TokenPairName: "xxb_okt", SwapPrice: 2, BaseVolume: 1, Timestamp: 125},
+		{TokenPairName: "xxb_okt", SwapPrice: 4, BaseVolume: 2, Timestamp: 130},
+		{TokenPairName: "xxb_okt", SwapPrice: 3, BaseVolume: 3, Timestamp: 170},
+		{TokenPairName: "yyb_okt", SwapPrice: 5, BaseVolume: 1, Timestamp: 200},
+	}
+	cnt, err := orm.AddSwapInfo(swapInfos)
+	require.NoError(t, err)
+	require.Equal(t, 5, cnt)
+
+	ds := MergeResultSwapDataSource{Orm: orm}
+	require.EqualValues(t, 60, ds.getDataSourceMinTimestamp())
+
+	xxbProduct := types.SwapKlineProduct("xxb_okt")
+	require.Equal(t, "ammswap_xxb_okt", xxbProduct)
+	openPrice, closePrice := ds.getOpenClosePrice(0, 300, xxbProduct)
+	require.EqualValues(t, 2, openPrice)
+	require.EqualValues(t, 3, closePrice)
+
+	_, _, klines, err := orm.CreateKline1M(0, 300, &ds)
+	require.NoError(t, err)
+	require.Len(t, klines, 2)
+
+	xxbKlines := klines[xxbProduct]
+	require.Len(t, xxbKlines, 1)
+	require.EqualValues(t, 120, xxbKlines[0].Timestamp)
+	require.EqualValues(t, 2, xxbKlines[0].Open)
+	require.EqualValues(t, 3, xxbKlines[0].Close)
+	require.EqualValues(t, 4, xxbKlines[0].High)
+	require.EqualValues(t, 2, xxbKlines[0].Low)
+	require.EqualValues(t, 6, xxbKlines[0].Volume)
+	require.Len(t, klines[types.SwapKlineProduct("yyb_okt")], 1)
+
+	products, err := orm.getUpdatedSwapProducts(0, 300)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{xxbProduct, types.SwapKlineProduct("yyb_okt")}, products)
+
+	matchResults, err := orm.getTickerMatchResultsByTimeRange(xxbProduct, 0, 300)
+	require.NoError(t, err)
+	require.Len(t, matchResults, 3)
+	require.EqualValues(t, 3, matchResults[0].Price)
+
+	matchResults, err = orm.getLatestTickerMatchResults(xxbProduct, 1)
+	require.NoError(t, err)
+	require.Len(t, matchResults, 1)
+	require.EqualValues(t, 170, matchResults[0].Timestamp)
+
+	tickers, err := orm.RefreshTickers(0, 300, []string{xxbProduct})
+	require.NoError(t, err)
+	require.EqualValues(t, 3, tickers[xxbProduct].Close)
+	require.EqualValues(t, 4, tickers[xxbProduct].High)
+	require.EqualValues(t, 2, tickers[xxbProduct].Low)
+}
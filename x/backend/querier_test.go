@@ -144,7 +144,7 @@ func TestQuerier_QueryCandlesByMarketService(t *testing.T) {
 func TestQuerier_QueryFeeDetails(t *testing.T) {
 	mapp, ctx, querier, _ := mockQuerier(t)
 
-	params := types.NewQueryFeeDetailsParams("NotExists", 1, 10)
+	params := types.NewQueryFeeDetailsParams("NotExists", 0, 0, 1, 10)
 	requestData, errMarshal := mapp.Cdc.MarshalJSON(params)
 	require.Nil(t, errMarshal)
 	request := abci.RequestQuery{Data: requestData}
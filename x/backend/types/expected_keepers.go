@@ -6,8 +6,10 @@ import (
 	ammswaptypes "github.com/okex/okexchain/x/ammswap/types"
 	"github.com/okex/okexchain/x/dex"
 	dextypes "github.com/okex/okexchain/x/dex/types"
+	farmtypes "github.com/okex/okexchain/x/farm/types"
 	"github.com/okex/okexchain/x/order"
 	ordertypes "github.com/okex/okexchain/x/order/types"
+	stakingtypes "github.com/okex/okexchain/x/staking/types"
 	"github.com/okex/okexchain/x/token"
 	"github.com/willf/bitset"
 )
@@ -21,6 +23,8 @@ type OrderKeeper interface {
 	GetBlockMatchResult() *ordertypes.BlockMatchResult
 	GetLastPrice(ctx sdk.Context, product string) sdk.Dec
 	GetBestBidAndAsk(ctx sdk.Context, product string) (sdk.Dec, sdk.Dec)
+	GetAccountFeeTier(ctx sdk.Context, addr sdk.AccAddress) ordertypes.AccountFeeTier
+	GetBlockProductHalts() []ordertypes.ProductHalt
 }
 
 // TokenKeeper expected token keeper
@@ -52,3 +56,13 @@ type SwapKeeper interface {
 	GetPoolTokenAmount(ctx sdk.Context, poolTokenName string) sdk.Dec
 	SetObserverKeeper(k ammswaptypes.BackendKeeper)
 }
+
+// FarmKeeper expected farm keeper
+type FarmKeeper interface {
+	SetObserverKeeper(ok farmtypes.ObserverKeeper)
+}
+
+// StakingKeeper expected staking keeper
+type StakingKeeper interface {
+	SetObserverKeeper(ok stakingtypes.ObserverKeeper)
+}
@@ -0,0 +1,60 @@
+// nolint
+package types
+
+// types of the liquidity, the farm and the staking records
+const (
+	LiquidityTypeAdd    = "add"
+	LiquidityTypeRemove = "remove"
+
+	FarmTypeLock   = "lock"
+	FarmTypeUnlock = "unlock"
+	FarmTypeClaim  = "claim"
+
+	StakingTypeDeposit   = "deposit"
+	StakingTypeWithdraw  = "withdraw"
+	StakingTypeAddShares = "add_shares"
+)
+
+// LiquidityInfo is the record of adding liquidity to or removing liquidity from a swap token pair
+type LiquidityInfo struct {
+	Address       string `gorm:"index;type:varchar(80)" json:"address" v2:"address"`
+	TokenPairName string `gorm:"index;type:varchar(80)" json:"swap_pair" v2:"swap_pair"`
+	Type          string `gorm:"type:varchar(10)" json:"type" v2:"type"` // add, remove
+	BaseAmount    string `gorm:"type:varchar(40)" json:"base_amount" v2:"base_amount"`
+	QuoteAmount   string `gorm:"type:varchar(40)" json:"quote_amount" v2:"quote_amount"`
+	Liquidity     string `gorm:"type:varchar(40)" json:"liquidity" v2:"liquidity"`
+	BlockHeight   int64  `gorm:"type:bigint" json:"block_height" v2:"block_height"`
+	Timestamp     int64  `gorm:"index;" json:"timestamp" v2:"timestamp"`
+}
+
+// FarmInfo is the record of locking to, unlocking from or claiming rewards from a farm pool
+type FarmInfo struct {
+	Address     string `gorm:"index;type:varchar(80)" json:"address" v2:"address"`
+	PoolName    string `gorm:"index;type:varchar(80)" json:"pool_name" v2:"pool_name"`
+	Type        string `gorm:"type:varchar(10)" json:"type" v2:"type"` // lock, unlock, claim
+	Amount      string `gorm:"type:varchar(1024)" json:"amount" v2:"amount"`
+	BlockHeight int64  `gorm:"type:bigint" json:"block_height" v2:"block_height"`
+	Timestamp   int64  `gorm:"index;" json:"timestamp" v2:"timestamp"`
+}
+
+// StakingInfo is the record of depositing, withdrawing or adding shares of a delegator. The validators are joined
+// with ',' and only set when adding shares
+type StakingInfo struct {
+	Address     string `gorm:"index;type:varchar(80)" json:"address" v2:"address"`
+	Type        string `gorm:"type:varchar(10)" json:"type" v2:"type"` // deposit, withdraw, add_shares
+	Amount      string `gorm:"type:varchar(40)" json:"amount" v2:"amount"`
+	Validators  string `gorm:"type:varchar(2048)" json:"validators" v2:"validators"`
+	Shares      string `gorm:"type:varchar(40)" json:"shares" v2:"shares"`
+	BlockHeight int64  `gorm:"type:bigint" json:"block_height" v2:"block_height"`
+	Timestamp   int64  `gorm:"index;" json:"timestamp" v2:"timestamp"`
+}
+
+// ProductHalt is the record of the matching of a product paused by the circuit breaker from BlockHeight to EndHeight
+type ProductHalt struct {
+	Product     string `gorm:"index;type:varchar(80)" json:"product" v2:"product"`
+	BlockHeight int64  `gorm:"type:bigint" json:"block_height" v2:"block_height"`
+	EndHeight   int64  `gorm:"type:bigint" json:"end_height" v2:"end_height"`
+	Price       string `gorm:"type:varchar(40)" json:"price" v2:"price"`
+	RefPrice    string `gorm:"type:varchar(40)" json:"ref_price" v2:"ref_price"`
+	Timestamp   int64  `gorm:"index;" json:"timestamp" v2:"timestamp"`
+}
@@ -19,18 +19,29 @@ const (
 	QueryTickerList    = "tickers"
 	QueryDexFeesList   = "dexFees"
 	QuerySwapWatchlist = "swapWatchlist"
+	QuerySwapInfoList  = "swapInfos"
+	QueryLiquidityList = "liquidity"
+	QueryFarmList      = "farm"
+	QueryStakingList   = "staking"
+	QueryPositionList  = "positions"
+	QueryPositionDaily = "positionDaily"
+	QueryFeeTier       = "feeTier"
+	QueryProductHalts  = "productHalts"
 
 	// v2
-	QueryTickerListV2   = "tickerListV2"
-	QueryTickerV2       = "tickerV2"
-	QueryInstrumentsV2  = "instrumentsV2"
-	QueryOrderListV2    = "orderListV2"
-	QueryOrderV2        = "orderV2"
-	QueryCandleListV2   = "candlesV2"
-	QueryMatchResultsV2 = "matchesV2"
-	QueryFeeDetailsV2   = "feesV2"
-	QueryDealListV2     = "dealsV2"
-	QueryTxListV2       = "txsV2"
+	QueryTickerListV2    = "tickerListV2"
+	QueryTickerV2        = "tickerV2"
+	QueryInstrumentsV2   = "instrumentsV2"
+	QueryOrderListV2     = "orderListV2"
+	QueryOrderV2         = "orderV2"
+	QueryCandleListV2    = "candlesV2"
+	QueryMatchResultsV2  = "matchesV2"
+	QueryFeeDetailsV2    = "feesV2"
+	QueryDealListV2      = "dealsV2"
+	QueryTxListV2        = "txsV2"
+	QueryLiquidityListV2 = "liquidityV2"
+	QueryFarmListV2      = "farmV2"
+	QueryStakingListV2   = "stakingV2"
 
 	// kline const
 
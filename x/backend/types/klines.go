@@ -127,7 +127,7 @@ func (klines IKlinesDsc) Less(i, j int) bool {
 
 // BaseKline define the basic data of Kine
 type BaseKline struct {
-	Product   string  `gorm:"PRIMARY_KEY;type:varchar(20)" json:"product"`
+	Product   string  `gorm:"PRIMARY_KEY;type:varchar(40)" json:"product"`
 	Timestamp int64   `gorm:"PRIMARY_KEY;type:bigint;" json:"timestamp"`
 	Open      float64 `gorm:"type:DOUBLE" json:"open"`
 	Close     float64 `gorm:"type:DOUBLE" json:"close"`
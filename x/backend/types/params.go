@@ -63,18 +63,22 @@ func NewQueryMatchParams(product string, start, end int64, page, perPage int) Qu
 // nolint
 type QueryFeeDetailsParams struct {
 	Address string
+	Start   int64
+	End     int64
 	Page    int
 	PerPage int
 }
 
 // NewQueryFeeDetailsParams creates a new instance of QueryFeeDetailsParams
-func NewQueryFeeDetailsParams(addr string, page, perPage int) QueryFeeDetailsParams {
+func NewQueryFeeDetailsParams(addr string, start, end int64, page, perPage int) QueryFeeDetailsParams {
 	if page == 0 && perPage == 0 {
 		page = DefaultPage
 		perPage = DefaultPerPage
 	}
 	return QueryFeeDetailsParams{
 		Address: addr,
+		Start:   start,
+		End:     end,
 		Page:    page,
 		PerPage: perPage,
 	}
@@ -186,3 +190,163 @@ func NewQueryDexFeesParams(dexHandlingAddr, baseAsset, quoteAsset string, page,
 		PerPage:         perPage,
 	}
 }
+
+// nolint
+type QueryLiquidityInfoParams struct {
+	Address       string
+	TokenPairName string
+	Start         int64
+	End           int64
+	Page          int
+	PerPage       int
+}
+
+// NewQueryLiquidityInfoParams creates a new instance of QueryLiquidityInfoParams
+func NewQueryLiquidityInfoParams(addr, tokenPairName string, start, end int64, page, perPage int) QueryLiquidityInfoParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryLiquidityInfoParams{
+		Address:       addr,
+		TokenPairName: tokenPairName,
+		Start:         start,
+		End:           end,
+		Page:          page,
+		PerPage:       perPage,
+	}
+}
+
+// nolint
+type QueryFarmInfoParams struct {
+	Address  string
+	PoolName string
+	Start    int64
+	End      int64
+	Page     int
+	PerPage  int
+}
+
+// NewQueryFarmInfoParams creates a new instance of QueryFarmInfoParams
+func NewQueryFarmInfoParams(addr, poolName string, start, end int64, page, perPage int) QueryFarmInfoParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryFarmInfoParams{
+		Address:  addr,
+		PoolName: poolName,
+		Start:    start,
+		End:      end,
+		Page:     page,
+		PerPage:  perPage,
+	}
+}
+
+// nolint
+type QueryStakingInfoParams struct {
+	Address   string
+	Validator string
+	Start     int64
+	End       int64
+	Page      int
+	PerPage   int
+}
+
+// NewQueryStakingInfoParams creates a new instance of QueryStakingInfoParams
+func NewQueryStakingInfoParams(addr, validator string, start, end int64, page, perPage int) QueryStakingInfoParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryStakingInfoParams{
+		Address:   addr,
+		Validator: validator,
+		Start:     start,
+		End:       end,
+		Page:      page,
+		PerPage:   perPage,
+	}
+}
+
+// nolint
+type QueryPositionParams struct {
+	Address string
+	Product string
+	Page    int
+	PerPage int
+}
+
+// NewQueryPositionParams creates a new instance of QueryPositionParams
+func NewQueryPositionParams(addr, product string, page, perPage int) QueryPositionParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryPositionParams{
+		Address: addr,
+		Product: product,
+		Page:    page,
+		PerPage: perPage,
+	}
+}
+
+// nolint
+type QueryPositionSnapshotParams struct {
+	Address string
+	Product string
+	Start   int64
+	End     int64
+	Page    int
+	PerPage int
+}
+
+// NewQueryPositionSnapshotParams creates a new instance of QueryPositionSnapshotParams
+func NewQueryPositionSnapshotParams(addr, product string, start, end int64, page, perPage int) QueryPositionSnapshotParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryPositionSnapshotParams{
+		Address: addr,
+		Product: product,
+		Start:   start,
+		End:     end,
+		Page:    page,
+		PerPage: perPage,
+	}
+}
+
+// QueryFeeTierParams as input parameters when querying the fee tier of an account
+type QueryFeeTierParams struct {
+	Address string
+}
+
+// NewQueryFeeTierParams creates a new instance of QueryFeeTierParams
+func NewQueryFeeTierParams(addr string) QueryFeeTierParams {
+	return QueryFeeTierParams{Address: addr}
+}
+
+// nolint
+type QueryProductHaltParams struct {
+	Product string
+	Start   int64
+	End     int64
+	Page    int
+	PerPage int
+}
+
+// NewQueryProductHaltParams creates a new instance of QueryProductHaltParams
+func NewQueryProductHaltParams(product string, start, end int64, page, perPage int) QueryProductHaltParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QueryProductHaltParams{
+		Product: product,
+		Start:   start,
+		End:     end,
+		Page:    page,
+		PerPage: perPage,
+	}
+}
@@ -38,10 +38,12 @@ func TestNewQueryDealsParams(t *testing.T) {
 func TestNewQueryFeeDetailsParams(t *testing.T) {
 	want := QueryFeeDetailsParams{
 		Address: "address",
+		Start:   100,
+		End:     200,
 		Page:    10,
 		PerPage: 10,
 	}
-	got := NewQueryFeeDetailsParams(want.Address, want.Page, want.PerPage)
+	got := NewQueryFeeDetailsParams(want.Address, want.Start, want.End, want.Page, want.PerPage)
 	require.Equal(t, want, got)
 
 	want = QueryFeeDetailsParams{
@@ -49,7 +51,7 @@ func TestNewQueryFeeDetailsParams(t *testing.T) {
 		Page:    DefaultPage,
 		PerPage: DefaultPerPage,
 	}
-	got = NewQueryFeeDetailsParams(want.Address, 0, 0)
+	got = NewQueryFeeDetailsParams(want.Address, 0, 0, 0, 0)
 	require.Equal(t, want, got)
 }
 
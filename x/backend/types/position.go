@@ -0,0 +1,142 @@
+package types
+
+import (
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Position is the position of an address in a product accounted by the average cost. The quantity is the net base
+// token bought, it's negative if more is sold than bought since the accounting started. The realized pnl is in the
+// quote token and the fee is the sum of the deal fees and the swap fees paid in the product
+type Position struct {
+	Address     string  `gorm:"PRIMARY_KEY;type:varchar(80)" json:"address"`
+	Product     string  `gorm:"PRIMARY_KEY;type:varchar(40)" json:"product"`
+	Quantity    float64 `gorm:"type:DOUBLE" json:"quantity"`
+	AvgPrice    float64 `gorm:"type:DOUBLE" json:"avg_price"`
+	RealizedPnL float64 `gorm:"column:realized_pnl;type:DOUBLE" json:"realized_pnl"`
+	Fee         string  `gorm:"type:varchar(1024)" json:"fee"`
+	BlockHeight int64   `gorm:"type:bigint" json:"block_height"`
+	Timestamp   int64   `gorm:"index;" json:"timestamp"`
+}
+
+// PositionSnapshot is the position at the end of a day, the timestamp is the start of the day in UTC. There's no
+// snapshot of the day on which the position isn't changed
+type PositionSnapshot struct {
+	Address     string  `gorm:"PRIMARY_KEY;type:varchar(80)" json:"address"`
+	Product     string  `gorm:"PRIMARY_KEY;type:varchar(40)" json:"product"`
+	Timestamp   int64   `gorm:"PRIMARY_KEY;type:bigint" json:"timestamp"`
+	Quantity    float64 `gorm:"type:DOUBLE" json:"quantity"`
+	AvgPrice    float64 `gorm:"type:DOUBLE" json:"avg_price"`
+	RealizedPnL float64 `gorm:"column:realized_pnl;type:DOUBLE" json:"realized_pnl"`
+	Fee         string  `gorm:"type:varchar(1024)" json:"fee"`
+}
+
+// NewPositionSnapshot creates the snapshot of the position on the day of its last change
+func NewPositionSnapshot(position Position) PositionSnapshot {
+	return PositionSnapshot{
+		Address:     position.Address,
+		Product:     position.Product,
+		Timestamp:   position.Timestamp - position.Timestamp%SecondsInADay,
+		Quantity:    position.Quantity,
+		AvgPrice:    position.AvgPrice,
+		RealizedPnL: position.RealizedPnL,
+		Fee:         position.Fee,
+	}
+}
+
+// PositionTrade is a deal or a swap applied to the position of an address
+type PositionTrade struct {
+	Address     string
+	Product     string
+	Side        string
+	Price       float64
+	Quantity    float64
+	Fee         string
+	BlockHeight int64
+	Timestamp   int64
+}
+
+// NewPositionTradeFromDeal creates a new instance of PositionTrade from a deal
+func NewPositionTradeFromDeal(deal *Deal) PositionTrade {
+	return PositionTrade{
+		Address:     deal.Sender,
+		Product:     deal.Product,
+		Side:        deal.Side,
+		Price:       deal.Price,
+		Quantity:    deal.Quantity,
+		Fee:         deal.Fee,
+		BlockHeight: deal.BlockHeight,
+		Timestamp:   deal.Timestamp,
+	}
+}
+
+// NewPositionTradeFromSwap creates a new instance of PositionTrade from a swap, the product is the one of the klines
+// of the swap token pair. It returns false if the swap is stored without the swap price
+func NewPositionTradeFromSwap(swapInfo *SwapInfo, blockHeight int64) (PositionTrade, bool) {
+	if swapInfo.SwapPrice <= 0 {
+		return PositionTrade{}, false
+	}
+
+	side := BuyOrder
+	sellCoin, err := sdk.ParseDecCoin(swapInfo.SellAmount)
+	if err != nil {
+		return PositionTrade{}, false
+	}
+	if strings.HasPrefix(swapInfo.TokenPairName, sellCoin.Denom+"_") {
+		side = SellOrder
+	}
+
+	return PositionTrade{
+		Address:     swapInfo.Address,
+		Product:     SwapKlineProduct(swapInfo.TokenPairName),
+		Side:        side,
+		Price:       swapInfo.SwapPrice,
+		Quantity:    swapInfo.BaseVolume,
+		Fee:         swapInfo.Fee,
+		BlockHeight: blockHeight,
+		Timestamp:   swapInfo.Timestamp,
+	}, true
+}
+
+// ApplyTrade updates the position by the trade. The trade in the direction of the position increases it at the
+// average cost, the one in the opposite direction realizes the pnl of the part closed and opens a new position at the
+// trade price with the rest
+func (p *Position) ApplyTrade(trade PositionTrade) {
+	quantity := trade.Quantity
+	if trade.Side == SellOrder {
+		quantity = -quantity
+	}
+
+	switch {
+	case p.Quantity == 0 || (p.Quantity > 0) == (quantity > 0):
+		total := math.Abs(p.Quantity) + math.Abs(quantity)
+		if total > 0 {
+			p.AvgPrice = (math.Abs(p.Quantity)*p.AvgPrice + math.Abs(quantity)*trade.Price) / total
+		}
+	default:
+		closed := math.Min(math.Abs(p.Quantity), math.Abs(quantity))
+		if p.Quantity > 0 {
+			p.RealizedPnL += closed * (trade.Price - p.AvgPrice)
+		} else {
+			p.RealizedPnL += closed * (p.AvgPrice - trade.Price)
+		}
+		if math.Abs(quantity) > math.Abs(p.Quantity) {
+			p.AvgPrice = trade.Price
+		} else if math.Abs(quantity) == math.Abs(p.Quantity) {
+			p.AvgPrice = 0
+		}
+	}
+	p.Quantity += quantity
+
+	if fee, err := sdk.ParseDecCoins(trade.Fee); err == nil && !fee.IsZero() {
+		paid, err := sdk.ParseDecCoins(p.Fee)
+		if err != nil {
+			paid = sdk.DecCoins{}
+		}
+		p.Fee = paid.Add(fee...).String()
+	}
+	p.BlockHeight = trade.BlockHeight
+	p.Timestamp = trade.Timestamp
+}
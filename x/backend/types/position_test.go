@@ -0,0 +1,62 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPosition_ApplyTrade(t *testing.T) {
+	p := Position{Address: "addr", Product: TestTokenPair}
+
+	p.ApplyTrade(PositionTrade{Side: BuyOrder, Price: 10, Quantity: 2, Fee: "0.1okt", Timestamp: 100})
+	p.ApplyTrade(PositionTrade{Side: BuyOrder, Price: 13, Quantity: 1, Fee: "0.2okt", Timestamp: 200})
+	require.EqualValues(t, 3, p.Quantity)
+	require.EqualValues(t, 11, p.AvgPrice)
+	require.EqualValues(t, 0, p.RealizedPnL)
+	require.Equal(t, "0.300000000000000000okt", p.Fee)
+
+	// close a part
+	p.ApplyTrade(PositionTrade{Side: SellOrder, Price: 15, Quantity: 1, Timestamp: 300})
+	require.EqualValues(t, 2, p.Quantity)
+	require.EqualValues(t, 11, p.AvgPrice)
+	require.EqualValues(t, 4, p.RealizedPnL)
+	require.EqualValues(t, 300, p.Timestamp)
+
+	// close all and open the opposite side with the rest
+	p.ApplyTrade(PositionTrade{Side: SellOrder, Price: 10, Quantity: 3})
+	require.EqualValues(t, -1, p.Quantity)
+	require.EqualValues(t, 10, p.AvgPrice)
+	require.EqualValues(t, 2, p.RealizedPnL)
+
+	p.ApplyTrade(PositionTrade{Side: BuyOrder, Price: 8, Quantity: 1})
+	require.EqualValues(t, 0, p.Quantity)
+	require.EqualValues(t, 0, p.AvgPrice)
+	require.EqualValues(t, 4, p.RealizedPnL)
+}
+
+func TestNewPositionTradeFromSwap(t *testing.T) {
+	swapInfo := &SwapInfo{Address: "addr", TokenPairName: "xxb_okt", SellAmount: "4xxb", SwapPrice: 1.5, BaseVolume: 4,
+		Fee: "0.012000000000000000xxb", Timestamp: 100}
+	trade, ok := NewPositionTradeFromSwap(swapInfo, 10)
+	require.True(t, ok)
+	require.Equal(t, SellOrder, trade.Side)
+	require.Equal(t, SwapKlineProduct("xxb_okt"), trade.Product)
+
+	// the fee of the swap is added to the fee of the position
+	p := Position{Fee: "0.100000000000000000okt"}
+	p.ApplyTrade(trade)
+	require.Equal(t, "0.100000000000000000okt,0.012000000000000000xxb", p.Fee)
+
+	swapInfo.SellAmount = "6okt"
+	trade, ok = NewPositionTradeFromSwap(swapInfo, 10)
+	require.True(t, ok)
+	require.Equal(t, BuyOrder, trade.Side)
+
+	swapInfo.SwapPrice = 0
+	_, ok = NewPositionTradeFromSwap(swapInfo, 10)
+	require.False(t, ok)
+
+	snapshot := NewPositionSnapshot(Position{Timestamp: SecondsInADay + 100})
+	require.EqualValues(t, SecondsInADay, snapshot.Timestamp)
+}
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"strconv"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap"
+	ammswaptypes "github.com/okex/okexchain/x/ammswap/types"
 )
 
 const (
@@ -40,6 +45,32 @@ func NewQuerySwapWatchlistParams(sortColumn string, sortDirection string, page i
 	}
 }
 
+// nolint
+type QuerySwapInfoParams struct {
+	Address       string
+	TokenPairName string
+	Start         int64
+	End           int64
+	Page          int
+	PerPage       int
+}
+
+// NewQuerySwapInfoParams creates a new instance of QuerySwapInfoParams
+func NewQuerySwapInfoParams(addr, tokenPairName string, start, end int64, page, perPage int) QuerySwapInfoParams {
+	if page == 0 && perPage == 0 {
+		page = DefaultPage
+		perPage = DefaultPerPage
+	}
+	return QuerySwapInfoParams{
+		Address:       addr,
+		TokenPairName: tokenPairName,
+		Start:         start,
+		End:           end,
+		Page:          page,
+		PerPage:       perPage,
+	}
+}
+
 type SwapVolumePriceInfo struct {
 	Volume    sdk.Dec
 	Price24h  sdk.Dec
@@ -115,5 +146,57 @@ type SwapInfo struct {
 	SellAmount       string `gorm:"type:varchar(40)"`
 	BuysAmount       string `gorm:"type:varchar(40)"`
 	Price            string `gorm:"type:varchar(40)"`
-	Timestamp        int64  `gorm:"index;"`
+	// SwapPrice is the executed price of the base token in the quote token and BaseVolume is the amount of the base
+	// token sold or bought, the klines of the swap token pair are built from them
+	SwapPrice  float64 `gorm:"type:DOUBLE"`
+	BaseVolume float64 `gorm:"type:DOUBLE"`
+	// Fee is the fee charged at the swap fee rate, which is paid in the token sold and kept in the pool
+	Fee       string `gorm:"type:varchar(40)"`
+	Timestamp int64  `gorm:"index;"`
+	// BlockHeight is used to delete the records of a block, it isn't a part of the json
+	BlockHeight int64 `gorm:"index;type:bigint" json:"-"`
+}
+
+// NewSwapInfo creates a new instance of SwapInfo from a swap on the token pair
+func NewSwapInfo(address sdk.AccAddress, swapTokenPair ammswap.SwapTokenPair, sellAmount, buyAmount sdk.SysCoin,
+	timestamp int64) *SwapInfo {
+	baseAmount, quoteAmount := sellAmount.Amount, buyAmount.Amount
+	if sellAmount.Denom != swapTokenPair.BasePooledCoin.Denom {
+		baseAmount, quoteAmount = buyAmount.Amount, sellAmount.Amount
+	}
+
+	var swapPrice, baseVolume float64
+	if baseAmount.IsPositive() {
+		swapPrice, _ = strconv.ParseFloat(quoteAmount.Quo(baseAmount).String(), 64)
+		baseVolume, _ = strconv.ParseFloat(baseAmount.String(), 64)
+	}
+
+	return &SwapInfo{
+		Address:          address.String(),
+		TokenPairName:    swapTokenPair.TokenPairName(),
+		BaseTokenAmount:  swapTokenPair.BasePooledCoin.String(),
+		QuoteTokenAmount: swapTokenPair.QuotePooledCoin.String(),
+		SellAmount:       sellAmount.String(),
+		BuysAmount:       buyAmount.String(),
+		Price:            swapTokenPair.BasePooledCoin.Amount.Quo(swapTokenPair.QuotePooledCoin.Amount).String(),
+		SwapPrice:        swapPrice,
+		BaseVolume:       baseVolume,
+		Timestamp:        timestamp,
+	}
+}
+
+// SwapKlineProduct returns the product of the klines and the ticker of a swap token pair. It's the name of the pool
+// token, so that it never collides with a product of the dex
+func SwapKlineProduct(tokenPairName string) string {
+	return ammswaptypes.PoolTokenPrefix + tokenPairName
+}
+
+// IsSwapKlineProduct returns whether the product is the one of a swap token pair
+func IsSwapKlineProduct(product string) bool {
+	return strings.HasPrefix(product, ammswaptypes.PoolTokenPrefix)
+}
+
+// SwapTokenPairNameFromProduct returns the name of the swap token pair of the product
+func SwapTokenPairNameFromProduct(product string) string {
+	return strings.TrimPrefix(product, ammswaptypes.PoolTokenPrefix)
 }
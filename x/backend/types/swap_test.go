@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/ammswap"
 )
 
 func TestSwapWatchlistSorter(t *testing.T) {
@@ -135,3 +136,28 @@ func TestSwapWatchlistSorter(t *testing.T) {
 		require.Equal(t, test.want, sortedNames)
 	}
 }
+
+func TestNewSwapInfo(t *testing.T) {
+	swapTokenPair := ammswap.SwapTokenPair{
+		BasePooledCoin:  sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+		QuotePooledCoin: sdk.NewDecCoinFromDec("okt", sdk.NewDec(200)),
+	}
+	addr := sdk.AccAddress([]byte("addr"))
+
+	// sell the base token
+	swapInfo := NewSwapInfo(addr, swapTokenPair, sdk.NewDecCoinFromDec("xxb", sdk.NewDec(4)),
+		sdk.NewDecCoinFromDec("okt", sdk.NewDec(6)), 100)
+	require.Equal(t, "xxb_okt", swapInfo.TokenPairName)
+	require.EqualValues(t, 1.5, swapInfo.SwapPrice)
+	require.EqualValues(t, 4, swapInfo.BaseVolume)
+
+	// sell the quote token
+	swapInfo = NewSwapInfo(addr, swapTokenPair, sdk.NewDecCoinFromDec("okt", sdk.NewDec(10)),
+		sdk.NewDecCoinFromDec("xxb", sdk.NewDec(4)), 100)
+	require.EqualValues(t, 2.5, swapInfo.SwapPrice)
+	require.EqualValues(t, 4, swapInfo.BaseVolume)
+
+	require.True(t, IsSwapKlineProduct(SwapKlineProduct("xxb_okt")))
+	require.False(t, IsSwapKlineProduct("xxb_okt"))
+	require.Equal(t, "xxb_okt", SwapTokenPairNameFromProduct(SwapKlineProduct("xxb_okt")))
+}
@@ -34,6 +34,9 @@ func GenerateTx(tx *auth.StdTx, txHash string, ctx sdk.Context, orderKeeper Orde
 			continue
 		}
 	}
+	for _, transaction := range txs {
+		transaction.BlockHeight = ctx.BlockHeight()
+	}
 	return txs
 }
 
@@ -78,7 +81,7 @@ func buildTransactionNew(handlerMsgResult bitset.BitSet, msg orderTypes.MsgNewOr
 
 		tx := Transaction{
 			TxHash:    txHash,
-			Address:   msg.Sender.String(),
+			Address:   msg.GetOwner().String(),
 			Type:      TxTypeOrderNew,
 			Side:      int64(side),
 			Symbol:    item.Product,
@@ -115,7 +118,7 @@ func buildTransactionCancel(handlerMsgResult bitset.BitSet, msg orderTypes.MsgCa
 		}
 		tx := Transaction{
 			TxHash:    txHash,
-			Address:   msg.Sender.String(),
+			Address:   msg.GetOwner().String(),
 			Type:      TxTypeOrderCancel,
 			Side:      int64(side),
 			Symbol:    order.Product,
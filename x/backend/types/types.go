@@ -114,4 +114,6 @@ type Transaction struct {
 	Quantity  string `gorm:"type:varchar(40)" json:"quantity" v2:"quantity"`
 	Fee       string `gorm:"type:varchar(40)" json:"fee" v2:"fee"`
 	Timestamp int64  `gorm:"index" json:"timestamp" v2:"timestamp"`
+	// BlockHeight is used to delete the records of a block, it isn't a part of the json
+	BlockHeight int64 `gorm:"index;type:bigint" json:"-" v2:"-"`
 }
@@ -32,6 +32,7 @@ type Deal struct {
 	Quantity    float64 `gorm:"type:DOUBLE" json:"volume" v2:"volume"`
 	Fee         string  `gorm:"type:varchar(40)" json:"fee" v2:"fee"`
 	FeeReceiver string  `gorm:"index;type:varchar(80)" json:"fee_receiver" v2:"fee_receiver"`
+	Role        string  `gorm:"type:varchar(10)" json:"role" v2:"role"`
 }
 
 type TickerV2 struct {
@@ -166,6 +167,30 @@ type QueryTxListParamsV2 struct {
 	Limit   int
 }
 
+type QueryLiquidityInfoParamsV2 struct {
+	Address       string
+	TokenPairName string
+	After         string
+	Before        string
+	Limit         int
+}
+
+type QueryFarmInfoParamsV2 struct {
+	Address  string
+	PoolName string
+	After    string
+	Before   string
+	Limit    int
+}
+
+type QueryStakingInfoParamsV2 struct {
+	Address   string
+	Validator string
+	After     string
+	Before    string
+	Limit     int
+}
+
 type DexFees struct {
 	Timestamp       int64  `json:"timestamp"`
 	OrderID         string `json:"order_id"`
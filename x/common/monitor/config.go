@@ -6,6 +6,7 @@ const (
 	orderSubSystem   = "order"
 	stakingSubSystem = "staking"
 	streamSubSystem  = "stream"
+	rpcSubSystem     = "rpc"
 )
 
 type prometheusConfig struct {
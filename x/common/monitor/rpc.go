@@ -0,0 +1,62 @@
+package monitor
+
+import (
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+// RPCMethodLabel is the label of the json-rpc method in the rpc metrics
+const RPCMethodLabel = "method"
+
+// RPCMetrics is the struct of metric in the json-rpc server
+type RPCMetrics struct {
+	RequestDuration metrics.Histogram
+	RequestErrors   metrics.Counter
+	LimitedRequests metrics.Counter
+}
+
+// DefaultRPCMetrics returns Metrics build using Prometheus client library if Prometheus is enabled
+// Otherwise, it returns no-op Metrics
+func DefaultRPCMetrics(config *prometheusConfig) *RPCMetrics {
+	if config.Prometheus {
+		return NewRPCMetrics()
+	}
+	return NopRPCMetrics()
+}
+
+// NewRPCMetrics returns a pointer of a new RPCMetrics object. The metrics are labeled by the json-rpc method
+func NewRPCMetrics() *RPCMetrics {
+	labels := []string{RPCMethodLabel}
+	return &RPCMetrics{
+		RequestDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+			Namespace: xNameSpace,
+			Subsystem: rpcSubSystem,
+			Name:      "request_duration_seconds",
+			Help:      "the latency of the json-rpc requests in seconds.",
+			Buckets:   []float64{0.005, 0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, labels),
+		RequestErrors: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: xNameSpace,
+			Subsystem: rpcSubSystem,
+			Name:      "request_errors",
+			Help:      "the number of the json-rpc requests responded with an error.",
+		}, labels),
+		LimitedRequests: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: xNameSpace,
+			Subsystem: rpcSubSystem,
+			Name:      "limited_requests",
+			Help:      "the number of the json-rpc requests rejected by the rate limits.",
+		}, labels),
+	}
+}
+
+// NopRPCMetrics returns a pointer of no-op Metrics
+func NopRPCMetrics() *RPCMetrics {
+	return &RPCMetrics{
+		RequestDuration: discard.NewHistogram(),
+		RequestErrors:   discard.NewCounter(),
+		LimitedRequests: discard.NewCounter(),
+	}
+}
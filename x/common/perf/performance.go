@@ -17,15 +17,17 @@ var (
 )
 
 const (
-	orderModule        = "order"
-	dexModule          = "dex"
-	swapModule         = "ammswap"
-	tokenModule        = "token"
-	stakingModule      = "staking"
-	govModule          = "gov"
-	distributionModule = "distribution"
-	farmModule         = "farm"
-	summaryFormat      = "BlockHeight<%d>, " +
+	orderModule          = "order"
+	dexModule            = "dex"
+	swapModule           = "ammswap"
+	tokenModule          = "token"
+	stakingModule        = "staking"
+	govModule            = "gov"
+	distributionModule   = "distribution"
+	farmModule           = "farm"
+	makerIncentiveModule = "makerincentive"
+	upgradeModule        = "upgrade"
+	summaryFormat        = "BlockHeight<%d>, " +
 		"Abci<%dms>, " +
 		"Tx<%d>, " +
 		"%s"
@@ -150,6 +152,8 @@ func newPerf() *performance {
 	p.moduleInfoMap[distributionModule] = newHanlderMetrics()
 	p.moduleInfoMap[stakingModule] = newHanlderMetrics()
 	p.moduleInfoMap[farmModule] = newHanlderMetrics()
+	p.moduleInfoMap[makerIncentiveModule] = newHanlderMetrics()
+	p.moduleInfoMap[upgradeModule] = newHanlderMetrics()
 
 	return p
 }
@@ -320,6 +324,8 @@ func (p *performance) OnCommitExit(height int64, seq uint64, logger log.Logger)
 	p.moduleInfoMap[distributionModule] = newHanlderMetrics()
 	p.moduleInfoMap[stakingModule] = newHanlderMetrics()
 	p.moduleInfoMap[farmModule] = newHanlderMetrics()
+	p.moduleInfoMap[makerIncentiveModule] = newHanlderMetrics()
+	p.moduleInfoMap[upgradeModule] = newHanlderMetrics()
 }
 
 ////////////////////////////////////////////////////////////////////////////////////
@@ -45,6 +45,8 @@ type (
 	MsgConfirmOwnership  = types.MsgConfirmOwnership
 	MsgUpdateOperator    = types.MsgUpdateOperator
 	MsgCreateOperator    = types.MsgCreateOperator
+	MsgSetProductFee     = types.MsgSetProductFee
+	MsgUpdateTradingRule = types.MsgUpdateTradingRule
 
 	TokenPair     = types.TokenPair
 	Params        = types.Params
@@ -52,6 +54,9 @@ type (
 	WithdrawInfos = types.WithdrawInfos
 	DEXOperator   = types.DEXOperator
 	DEXOperators  = types.DEXOperators
+
+	ProductFeeConfig  = types.ProductFeeConfig
+	TradingRuleUpdate = types.TradingRuleUpdate
 )
 
 var (
@@ -68,6 +73,12 @@ var (
 	NewMsgDeposit  = types.NewMsgDeposit
 	NewMsgWithdraw = types.NewMsgWithdraw
 
+	NewMsgSetProductFee = types.NewMsgSetProductFee
+	NewProductFeeConfig = types.NewProductFeeConfig
+
+	NewMsgUpdateTradingRule = types.NewMsgUpdateTradingRule
+	NewTradingRuleUpdate    = types.NewTradingRuleUpdate
+
 	ErrInvalidProduct      = types.ErrInvalidProduct
 	ErrTokenPairNotFound   = types.ErrTokenPairNotFound
 	ErrDelistOwnerNotMatch = types.ErrDelistOwnerNotMatch
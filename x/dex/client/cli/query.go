@@ -29,6 +29,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryProductsUnderDelisting(queryRoute, cdc),
 		GetCmdQueryOperator(queryRoute, cdc),
 		GetCmdQueryOperators(queryRoute, cdc),
+		GetCmdQueryProductFee(queryRoute, cdc),
 	)...)
 
 	return queryCmd
@@ -233,6 +234,30 @@ func GetCmdQueryOperators(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryProductFee queries the fee config of a product
+func GetCmdQueryProductFee(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "product-fee [product]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the fee config of a product",
+		Long: strings.TrimSpace(`Query the fee rates and the operator fee share set on a product with the bounds in the params:
+
+$ okexchaincli query dex product-fee mytoken_okt
+`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryProductFee, args[0]), nil)
+			if err != nil {
+				return err
+			}
+			var productFee types.ProductFee
+			cdc.MustUnmarshalJSON(res, &productFee)
+			return cliCtx.PrintOutput(productFee)
+		},
+	}
+}
+
 // Strings is just for the object of []string could be inputted into cliCtx.PrintOutput(...)
 type Strings []string
 
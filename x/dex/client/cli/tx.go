@@ -33,6 +33,13 @@ const (
 	FlagTo                 = "to"
 	FlagWebsite            = "website"
 	FlagHandlingFeeAddress = "handling-fee-address"
+	FlagMakerFeeRate       = "maker-fee-rate"
+	FlagTakerFeeRate       = "taker-fee-rate"
+	FlagOperatorFeeShare   = "operator-fee-share"
+	FlagMaxPriceDigit      = "max-price-digit"
+	FlagMaxSizeDigit       = "max-size-digit"
+	FlagMinTradeSize       = "min-trade-size"
+	FlagEffectiveHeight    = "effective-height"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -50,6 +57,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		getCmdConfirmOwnership(cdc),
 		getCmdRegisterOperator(cdc),
 		getCmdEditOperator(cdc),
+		getCmdSetProductFee(cdc),
+		getCmdUpdateTradingRule(cdc),
 	)...)
 
 	return txCmd
@@ -376,3 +385,104 @@ $ okexchaincli tx dex edit-operator --website http://xxx/operator.json --handlin
 
 	return cmd
 }
+
+func getCmdSetProductFee(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-product-fee [product]",
+		Short: "set the fee rates of a product",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Set the deal fee rates of a product and the share of the deal fees sent to its operator,
+the rest of the deal fees goes to the fee collector. The rates must be within the bounds in the dex params:
+
+$ okexchaincli tx dex set-product-fee mytoken_okt --maker-fee-rate 0.0005 --taker-fee-rate 0.001 --operator-fee-share 0.8 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := auth.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			flags := cmd.Flags()
+			var rates [3]sdk.Dec
+			for i, flag := range []string{FlagMakerFeeRate, FlagTakerFeeRate, FlagOperatorFeeShare} {
+				str, err := flags.GetString(flag)
+				if err != nil {
+					return err
+				}
+				if rates[i], err = sdk.NewDecFromStr(str); err != nil {
+					return fmt.Errorf("invalid %s: %s", flag, str)
+				}
+			}
+
+			feeConfig := types.NewProductFeeConfig(rates[0], rates[1], rates[2])
+			msg := types.NewMsgSetProductFee(cliCtx.GetFromAddress(), args[0], feeConfig)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagMakerFeeRate, "0.001", "the fee rate of the orders providing liquidity")
+	cmd.Flags().String(FlagTakerFeeRate, "0.001", "the fee rate of the orders taking liquidity")
+	cmd.Flags().String(FlagOperatorFeeShare, "1", "the share of the deal fees sent to the handling fee address of the operator")
+
+	return cmd
+}
+
+func getCmdUpdateTradingRule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-trading-rule [product]",
+		Short: "update the tick size and the lot size of a product",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(`Update the max price digit, the max size digit and the min trade size of a product from a future
+block height, which must be within the bounds in the dex params. The open orders that don't conform to the new rule
+are either kept or canceled and refunded at that height, as the trading rule update policy in the dex params says:
+
+$ okexchaincli tx dex update-trading-rule mytoken_okt --max-price-digit 2 --max-size-digit 6 --min-trade-size 0.001 --effective-height 100000 --from mykey
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			if err := auth.NewAccountRetriever(cliCtx).EnsureExists(cliCtx.FromAddress); err != nil {
+				return err
+			}
+
+			flags := cmd.Flags()
+			maxPriceDigit, err := flags.GetInt64(FlagMaxPriceDigit)
+			if err != nil {
+				return err
+			}
+			maxSizeDigit, err := flags.GetInt64(FlagMaxSizeDigit)
+			if err != nil {
+				return err
+			}
+			effectiveHeight, err := flags.GetInt64(FlagEffectiveHeight)
+			if err != nil {
+				return err
+			}
+			minTradeSizeStr, err := flags.GetString(FlagMinTradeSize)
+			if err != nil {
+				return err
+			}
+			minTradeSize, err := sdk.NewDecFromStr(minTradeSizeStr)
+			if err != nil {
+				return fmt.Errorf("invalid %s: %s", FlagMinTradeSize, minTradeSizeStr)
+			}
+
+			tradingRule := types.NewTradingRuleUpdate(maxPriceDigit, maxSizeDigit, minTradeSize, effectiveHeight)
+			msg := types.NewMsgUpdateTradingRule(cliCtx.GetFromAddress(), args[0], tradingRule)
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(FlagMaxPriceDigit, types.DefaultMaxPriceDigitSize, "the max number of the decimal places of the prices")
+	cmd.Flags().Int64(FlagMaxSizeDigit, types.DefaultMaxQuantityDigitSize, "the max number of the decimal places of the quantities")
+	cmd.Flags().String(FlagMinTradeSize, "0.0001", "the min quantity of the orders")
+	cmd.Flags().Int64(FlagEffectiveHeight, 0, "the block height that the new rule takes effect from")
+	cmd.MarkFlagRequired(FlagEffectiveHeight)
+
+	return cmd
+}
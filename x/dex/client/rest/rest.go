@@ -24,6 +24,7 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/dex/product_rank", matchOrderHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/dexoperator/{address}", operatorHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/dexoperators", operatorsHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/dex/product_fee/{product}", productFeeHandler(cliCtx)).Methods("GET")
 }
 
 func productsHandler(cliContext context.CLIContext) func(http.ResponseWriter, *http.Request) {
@@ -179,6 +180,26 @@ func operatorsHandler(cliContext context.CLIContext) func(http.ResponseWriter, *
 	}
 }
 
+func productFeeHandler(cliContext context.CLIContext) func(http.ResponseWriter, *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		product := mux.Vars(r)["product"]
+		res, _, err := cliContext.Query(fmt.Sprintf("custom/%s/%s/%s", types.QuerierRoute, types.QueryProductFee, product))
+		if err != nil {
+			common.HandleErrorMsg(w, cliContext, err.Error())
+			return
+		}
+
+		result := common.GetBaseResponse("hello")
+		result2, err2 := json.Marshal(result)
+		if err2 != nil {
+			common.HandleErrorMsg(w, cliContext, err2.Error())
+			return
+		}
+		result2 = []byte(strings.Replace(string(result2), "\"hello\"", string(res), 1))
+		rest.PostProcessResponse(w, cliContext, result2)
+	}
+}
+
 // DelistProposalRESTHandler defines dex proposal handler
 func DelistProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
 	return govRest.ProposalRESTHandler{}
@@ -66,6 +66,10 @@ func InitGenesis(ctx sdk.Context, keeper IKeeper, data GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		// rebuild the index of the pending trading rule update
+		if pair.PendingTradingRule != nil {
+			keeper.ScheduleTradingRuleUpdate(ctx, pair, *pair.PendingTradingRule)
+		}
 	}
 
 	// reset delay withdraw queue
@@ -58,6 +58,16 @@ func NewHandler(k IKeeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgUpdateOperator(ctx, k, msg, logger)
 			}
+		case MsgSetProductFee:
+			name = "handleMsgSetProductFee"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgSetProductFee(ctx, k, msg, logger)
+			}
+		case MsgUpdateTradingRule:
+			name = "handleMsgUpdateTradingRule"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgUpdateTradingRule(ctx, k, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("unrecognized dex message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -333,3 +343,67 @@ func handleMsgUpdateOperator(ctx sdk.Context, keeper IKeeper, msg MsgUpdateOpera
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+func handleMsgSetProductFee(ctx sdk.Context, keeper IKeeper, msg MsgSetProductFee, logger log.Logger) (*sdk.Result, error) {
+	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
+	if tokenPair == nil {
+		return types.ErrTokenPairNotFound(fmt.Sprintf("non-exist product: %s", msg.Product)).Result()
+	}
+	if !tokenPair.Owner.Equals(msg.Owner) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the owner of product(%s)", msg.Owner.String(), msg.Product)).Result()
+	}
+	if err := msg.FeeConfig.ValidateBounds(keeper.GetParams(ctx)); err != nil {
+		return types.ErrInvalidProductFee(err.Error()).Result()
+	}
+
+	feeConfig := msg.FeeConfig
+	tokenPair.FeeConfig = &feeConfig
+	keeper.UpdateTokenPair(ctx, msg.Product, tokenPair)
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgSetProductFee: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute("product", msg.Product),
+			sdk.NewAttribute("maker-fee-rate", feeConfig.MakerFeeRate.String()),
+			sdk.NewAttribute("taker-fee-rate", feeConfig.TakerFeeRate.String()),
+			sdk.NewAttribute("operator-fee-share", feeConfig.OperatorFeeShare.String()),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgUpdateTradingRule(ctx sdk.Context, keeper IKeeper, msg MsgUpdateTradingRule,
+	logger log.Logger) (*sdk.Result, error) {
+	tokenPair := keeper.GetTokenPair(ctx, msg.Product)
+	if tokenPair == nil {
+		return types.ErrTokenPairNotFound(fmt.Sprintf("non-exist product: %s", msg.Product)).Result()
+	}
+	if !tokenPair.Owner.Equals(msg.Owner) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not the owner of product(%s)", msg.Owner.String(), msg.Product)).Result()
+	}
+	if err := msg.TradingRule.ValidateBounds(keeper.GetParams(ctx), ctx.BlockHeight()); err != nil {
+		return types.ErrInvalidTradingRule(err.Error()).Result()
+	}
+
+	keeper.ScheduleTradingRuleUpdate(ctx, tokenPair, msg.TradingRule)
+
+	logger.Debug(fmt.Sprintf("successfully handleMsgUpdateTradingRule: "+
+		"BlockHeight: %d, Msg: %+v", ctx.BlockHeight(), msg))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute("product", msg.Product),
+			sdk.NewAttribute("max-price-digit", strconv.FormatInt(msg.TradingRule.MaxPriceDigit, 10)),
+			sdk.NewAttribute("max-size-digit", strconv.FormatInt(msg.TradingRule.MaxQuantityDigit, 10)),
+			sdk.NewAttribute("min-trade-size", msg.TradingRule.MinQuantity.String()),
+			sdk.NewAttribute("effective-height", strconv.FormatInt(msg.TradingRule.EffectiveHeight, 10)),
+		),
+	)
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -161,3 +161,79 @@ func TestHandler_handleMsgTransferOwnership(t *testing.T) {
 	spKeeper.behaveEvil = false
 	handlerFunctor(ctx, msgFailedConfirmOwnership)
 }
+
+func TestHandler_HandleMsgSetProductFee(t *testing.T) {
+	mApp, _, _, mDexKeeper, ctx := getMockTestCaseEvn(t)
+	mDexKeeper.getFakeTokenPair = false
+
+	tokenPair := GetBuiltInTokenPair()
+	err := mDexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	handlerFunctor := NewHandler(mApp.dexKeeper)
+	other := mApp.GenesisAccounts[0].GetAddress()
+	feeConfig := types.NewProductFeeConfig(sdk.MustNewDecFromStr("0.0005"), sdk.MustNewDecFromStr("0.002"),
+		sdk.MustNewDecFromStr("0.8"))
+
+	// fail case : the product doesn't exist
+	_, err = handlerFunctor(ctx, types.NewMsgSetProductFee(tokenPair.Owner, "no-product", feeConfig))
+	require.NotNil(t, err)
+
+	// fail case : the address isn't the owner of the product
+	_, err = handlerFunctor(ctx, types.NewMsgSetProductFee(other, tokenPair.Name(), feeConfig))
+	require.NotNil(t, err)
+
+	// fail case : the fee rate is out of the bounds in the params
+	params := mDexKeeper.GetParams(ctx)
+	params.MaxProductFeeRate = sdk.MustNewDecFromStr("0.001")
+	mDexKeeper.SetParams(ctx, params)
+	_, err = handlerFunctor(ctx, types.NewMsgSetProductFee(tokenPair.Owner, tokenPair.Name(), feeConfig))
+	require.NotNil(t, err)
+	require.Nil(t, mDexKeeper.GetTokenPair(ctx, tokenPair.Name()).FeeConfig)
+
+	// successful case
+	params.MaxProductFeeRate = sdk.MustNewDecFromStr("0.01")
+	mDexKeeper.SetParams(ctx, params)
+	_, err = handlerFunctor(ctx, types.NewMsgSetProductFee(tokenPair.Owner, tokenPair.Name(), feeConfig))
+	require.Nil(t, err)
+	require.Equal(t, &feeConfig, mDexKeeper.GetTokenPair(ctx, tokenPair.Name()).FeeConfig)
+}
+
+func TestHandler_HandleMsgUpdateTradingRule(t *testing.T) {
+	mApp, _, _, mDexKeeper, ctx := getMockTestCaseEvn(t)
+	mDexKeeper.getFakeTokenPair = false
+	ctx = ctx.WithBlockHeight(10)
+
+	tokenPair := GetBuiltInTokenPair()
+	err := mDexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	handlerFunctor := NewHandler(mApp.dexKeeper)
+	other := mApp.GenesisAccounts[0].GetAddress()
+	params := mDexKeeper.GetParams(ctx)
+	params.MinTradingRuleUpdateDelay = 10
+	mDexKeeper.SetParams(ctx, params)
+	tradingRule := types.NewTradingRuleUpdate(2, 6, sdk.MustNewDecFromStr("0.001"), 20)
+
+	// fail case : the product doesn't exist
+	_, err = handlerFunctor(ctx, types.NewMsgUpdateTradingRule(tokenPair.Owner, "no-product", tradingRule))
+	require.NotNil(t, err)
+
+	// fail case : the address isn't the owner of the product
+	_, err = handlerFunctor(ctx, types.NewMsgUpdateTradingRule(other, tokenPair.Name(), tradingRule))
+	require.NotNil(t, err)
+
+	// fail case : the digit is out of the bound in the params
+	_, err = handlerFunctor(ctx, types.NewMsgUpdateTradingRule(tokenPair.Owner, tokenPair.Name(),
+		types.NewTradingRuleUpdate(params.MaxTradingRuleDigit+1, 6, sdk.MustNewDecFromStr("0.001"), 20)))
+	require.NotNil(t, err)
+
+	// fail case : the effective height is too close
+	_, err = handlerFunctor(ctx, types.NewMsgUpdateTradingRule(tokenPair.Owner, tokenPair.Name(),
+		types.NewTradingRuleUpdate(2, 6, sdk.MustNewDecFromStr("0.001"), 19)))
+	require.NotNil(t, err)
+	require.Nil(t, mDexKeeper.GetTokenPair(ctx, tokenPair.Name()).PendingTradingRule)
+
+	// successful case
+	_, err = handlerFunctor(ctx, types.NewMsgUpdateTradingRule(tokenPair.Owner, tokenPair.Name(), tradingRule))
+	require.Nil(t, err)
+	require.Equal(t, &tradingRule, mDexKeeper.GetTokenPair(ctx, tokenPair.Name()).PendingTradingRule)
+}
@@ -61,6 +61,7 @@ type IKeeper interface {
 	DeleteConfirmOwnership(ctx sdk.Context, product string)
 	UpdateUserTokenPair(ctx sdk.Context, product string, owner, to sdk.AccAddress)
 	UpdateTokenPair(ctx sdk.Context, product string, tokenPair *types.TokenPair)
+	ScheduleTradingRuleUpdate(ctx sdk.Context, tokenPair *types.TokenPair, update types.TradingRuleUpdate)
 }
 
 // StakingKeeper defines the expected staking Keeper (noalias)
@@ -321,6 +321,16 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.GetParamSubspace().SetParamSet(ctx, &params)
 }
 
+// MigrateParams completes the params stored by an older software with the default values of the params not stored
+// yet, whose missing keys make GetParams panic
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.GetParamSubspace().GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	k.SetParams(ctx, *params)
+}
+
 // GetParamSubspace returns paramSubspace
 func (k Keeper) GetParamSubspace() params.Subspace {
 	return k.paramSubspace
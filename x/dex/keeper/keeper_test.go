@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/dex/types"
 )
@@ -401,3 +402,22 @@ func TestKeeper_CheckTokenPairUnderDexDelist(t *testing.T) {
 	require.Equal(t, isDelisting, tokenPair.Delisting)
 
 }
+
+func TestMigrateParams(t *testing.T) {
+	testInput := createTestInput(t)
+	ctx, keeper := testInput.Ctx, testInput.DexKeeper
+	params := *types.DefaultParams()
+	params.ListFee = sdk.NewDecCoinFromDec(common.NativeToken, sdk.NewDec(100))
+	keeper.SetParams(ctx, params)
+
+	// the params stored by the software before the product fees and the trading rule updates
+	store := prefix.NewStore(ctx.KVStore(testInput.ParamsKey), []byte(types.DefaultParamspace+"/"))
+	for _, key := range []string{"MinProductFeeRate", "MaxProductFeeRate", "MaxOperatorFeeShare",
+		"MaxTradingRuleDigit", "MinTradingRuleUpdateDelay", "TradingRuleUpdatePolicy"} {
+		store.Delete([]byte(key))
+	}
+	require.Panics(t, func() { keeper.GetParams(ctx) })
+
+	keeper.MigrateParams(ctx)
+	require.Equal(t, params, keeper.GetParams(ctx))
+}
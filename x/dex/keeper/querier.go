@@ -32,6 +32,8 @@ func NewQuerier(keeper IKeeper) sdk.Querier {
 			return queryOperator(ctx, req, keeper)
 		case types.QueryOperators:
 			return queryOperators(ctx, keeper)
+		case types.QueryProductFee:
+			return queryProductFee(ctx, path[1:], keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown dex query endpoint")
 		}
@@ -275,3 +277,28 @@ func queryOperators(ctx sdk.Context, keeper IKeeper) ([]byte, sdk.Error) {
 	}
 	return bz, nil
 }
+
+// queryProductFee queries the fee config of the product and the bounds in the params
+func queryProductFee(ctx sdk.Context, path []string, keeper IKeeper) ([]byte, sdk.Error) {
+	if len(path) == 0 || path[0] == "" {
+		return nil, types.ErrInvalidProduct("product can not be empty")
+	}
+	tokenPair := keeper.GetTokenPair(ctx, path[0])
+	if tokenPair == nil {
+		return nil, types.ErrTokenPairNotFound(fmt.Sprintf("non-exist product: %s", path[0]))
+	}
+
+	params := keeper.GetParams(ctx)
+	productFee := types.ProductFee{
+		Product:             tokenPair.Name(),
+		FeeConfig:           tokenPair.FeeConfig,
+		MinProductFeeRate:   params.MinProductFeeRate,
+		MaxProductFeeRate:   params.MaxProductFeeRate,
+		MaxOperatorFeeShare: params.MaxOperatorFeeShare,
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, productFee)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -179,6 +179,36 @@ func TestQuerier_QueryProductsDelisting(t *testing.T) {
 
 }
 
+func TestQuerier_QueryProductFee(t *testing.T) {
+	testInput := createTestInputWithBalance(t, 1, 10000)
+	ctx := testInput.Ctx
+	testInput.DexKeeper.SetParams(ctx, *types.DefaultParams())
+	querier := NewQuerier(testInput.DexKeeper)
+
+	tokenPair := GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	_, err = querier(ctx, []string{types.QueryProductFee, "no-product"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+
+	res, err := querier(ctx, []string{types.QueryProductFee, tokenPair.Name()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var productFee types.ProductFee
+	require.Nil(t, types.ModuleCdc.UnmarshalJSON(res, &productFee))
+	require.Nil(t, productFee.FeeConfig)
+	require.Equal(t, types.DefaultParams().MaxProductFeeRate, productFee.MaxProductFeeRate)
+
+	feeConfig := types.NewProductFeeConfig(sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002"),
+		sdk.MustNewDecFromStr("0.5"))
+	tokenPair.FeeConfig = &feeConfig
+	testInput.DexKeeper.UpdateTokenPair(ctx, tokenPair.Name(), tokenPair)
+	res, err = querier(ctx, []string{types.QueryProductFee, tokenPair.Name()}, abci.RequestQuery{})
+	require.Nil(t, err)
+	require.Nil(t, types.ModuleCdc.UnmarshalJSON(res, &productFee))
+	require.Equal(t, &feeConfig, productFee.FeeConfig)
+}
+
 func TestQueryParam(t *testing.T) {
 	// NewQueryDexInfoParams
 	tests := []struct {
@@ -33,6 +33,7 @@ type testInput struct {
 	TestAddrs []sdk.AccAddress
 
 	DexKeeper Keeper
+	ParamsKey sdk.StoreKey
 }
 
 // create a codec used only for testing
@@ -139,7 +140,7 @@ func createTestInputWithBalance(t *testing.T, numAddrs, initQuantity int64) test
 		require.Nil(t, err)
 	}
 
-	return testInput{ctx, cdc, testAddrs, dexKeeper}
+	return testInput{ctx, cdc, testAddrs, dexKeeper, keyParams}
 }
 
 // nolint
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/dex/types"
+)
+
+// ScheduleTradingRuleUpdate sets the trading rule update as the pending one of the token pair, which replaces the
+// previous pending update if any
+func (k Keeper) ScheduleTradingRuleUpdate(ctx sdk.Context, tokenPair *types.TokenPair,
+	update types.TradingRuleUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	product := tokenPair.Name()
+	if tokenPair.PendingTradingRule != nil {
+		store.Delete(types.GetTradingRuleUpdateKey(tokenPair.PendingTradingRule.EffectiveHeight, product))
+	}
+
+	tokenPair.PendingTradingRule = &update
+	k.UpdateTokenPair(ctx, product, tokenPair)
+	store.Set(types.GetTradingRuleUpdateKey(update.EffectiveHeight, product), []byte{})
+}
+
+// ApplyTradingRuleUpdates applies the pending trading rule updates effective at or before the current block, and
+// returns the updated token pairs. The update of a locked product is deferred until the product is unlocked
+func (k Keeper) ApplyTradingRuleUpdates(ctx sdk.Context) (tokenPairs []*types.TokenPair) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.TradingRuleUpdateKeyPrefix,
+		types.GetTradingRuleUpdateHeightPrefix(ctx.BlockHeight()+1))
+	var appliedKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		product := types.SplitTradingRuleUpdateKey(iter.Key())
+		if k.IsTokenPairLocked(ctx, product) {
+			continue
+		}
+		appliedKeys = append(appliedKeys, iter.Key())
+
+		// the product may have been delisted since the update was scheduled
+		tokenPair := k.GetTokenPair(ctx, product)
+		if tokenPair == nil || tokenPair.PendingTradingRule == nil {
+			continue
+		}
+		tokenPair.ApplyTradingRuleUpdate()
+		tokenPairs = append(tokenPairs, tokenPair)
+	}
+	iter.Close()
+
+	for _, key := range appliedKeys {
+		store.Delete(key)
+	}
+	for _, tokenPair := range tokenPairs {
+		k.UpdateTokenPair(ctx, tokenPair.Name(), tokenPair)
+	}
+	return tokenPairs
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/dex/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+func TestApplyTradingRuleUpdates(t *testing.T) {
+	testInput := createTestInput(t)
+	keeper := testInput.DexKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := getTestTokenPair()
+	require.Nil(t, keeper.SaveTokenPair(ctx, tokenPair))
+	product := tokenPair.Name()
+
+	update := types.NewTradingRuleUpdate(2, 4, sdk.MustNewDecFromStr("0.1"), 20)
+	keeper.ScheduleTradingRuleUpdate(ctx, tokenPair, update)
+	require.Equal(t, &update, keeper.GetTokenPair(ctx, product).PendingTradingRule)
+
+	// the pending update is replaced
+	update = types.NewTradingRuleUpdate(2, 4, sdk.MustNewDecFromStr("0.1"), 15)
+	keeper.ScheduleTradingRuleUpdate(ctx, keeper.GetTokenPair(ctx, product), update)
+	require.Empty(t, keeper.ApplyTradingRuleUpdates(ctx.WithBlockHeight(14)))
+
+	// deferred while the product is locked
+	keeper.LockTokenPair(ctx, product, &ordertypes.ProductLock{BlockHeight: 15})
+	require.Empty(t, keeper.ApplyTradingRuleUpdates(ctx.WithBlockHeight(15)))
+	keeper.UnlockTokenPair(ctx, product)
+
+	tokenPairs := keeper.ApplyTradingRuleUpdates(ctx.WithBlockHeight(16))
+	require.Equal(t, 1, len(tokenPairs))
+	tokenPair = keeper.GetTokenPair(ctx, product)
+	require.Equal(t, tokenPairs[0], tokenPair)
+	require.Nil(t, tokenPair.PendingTradingRule)
+	require.EqualValues(t, 2, tokenPair.MaxPriceDigit)
+	require.EqualValues(t, 4, tokenPair.MaxQuantityDigit)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), tokenPair.MinQuantity)
+
+	// the replaced update at height 20 is dropped
+	require.Empty(t, keeper.ApplyTradingRuleUpdates(ctx.WithBlockHeight(20)))
+}
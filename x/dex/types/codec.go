@@ -12,6 +12,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(DelistProposal{}, "okexchain/dex/DelistProposal", nil)
 	cdc.RegisterConcrete(MsgCreateOperator{}, "okexchain/dex/CreateOperator", nil)
 	cdc.RegisterConcrete(MsgUpdateOperator{}, "okexchain/dex/UpdateOperator", nil)
+	cdc.RegisterConcrete(MsgSetProductFee{}, "okexchain/dex/MsgSetProductFee", nil)
+	cdc.RegisterConcrete(MsgUpdateTradingRule{}, "okexchain/dex/MsgUpdateTradingRule", nil)
 }
 
 // ModuleCdc represents generic sealed codec to be used throughout this module
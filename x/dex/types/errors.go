@@ -17,6 +17,8 @@ const (
 	codeExistOperator           uint32 = 7
 	codeInvalidWebsiteLength    uint32 = 8
 	codeInvalidWebsiteURL       uint32 = 9
+	codeInvalidProductFee       uint32 = 10
+	codeInvalidTradingRule      uint32 = 11
 )
 
 var (
@@ -29,6 +31,8 @@ var (
 	errExistOperator 			= sdkerrors.Register(DefaultCodespace, codeExistOperator, "exist operator")
 	errInvalidWebsiteLength 	= sdkerrors.Register(DefaultCodespace, codeInvalidWebsiteLength, "invalid website length")
 	errInvalidWebsiteURL 		= sdkerrors.Register(DefaultCodespace, codeInvalidWebsiteURL, "invalid website URL")
+	errInvalidProductFee 		= sdkerrors.Register(DefaultCodespace, codeInvalidProductFee, "invalid product fee")
+	errInvalidTradingRule 		= sdkerrors.Register(DefaultCodespace, codeInvalidTradingRule, "invalid trading rule")
 )
 
 // CodeType to Message
@@ -86,6 +90,16 @@ func ErrInvalidWebsiteURL(msg string) sdk.EnvelopedErr {
 	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInvalidWebsiteURL, fmt.Sprintf("invalid website URL: %s", msg))}
 }
 
+// ErrInvalidProductFee returns an error when the fee config of a product is invalid
+func ErrInvalidProductFee(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrap(errInvalidProductFee, msg)}
+}
+
+// ErrInvalidTradingRule returns an error when the trading rule update of a product is invalid
+func ErrInvalidTradingRule(msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrap(errInvalidTradingRule, msg)}
+}
+
 // ErrTokenPairExisted returns an error when the token pair is existed during the process of listing
 // ErrTokenPairExisted returns an error when the token pair is existing during the process of listing
 func ErrTokenPairExisted(baseAsset, quoteAsset string) sdk.EnvelopedErr {
@@ -4,6 +4,10 @@ const (
 	defaultFeeList              = "20000"
 	defaultFeeTransferOwnership = "10"
 	defaultDelistMinDeposit     = "100"
+	defaultMaxProductFeeRate    = "0.01"
+
+	defaultMaxTradingRuleDigit       = 8
+	defaultMinTradingRuleUpdateDelay = 1200 // blocks
 
 	// DefaultMaxPriceDigitSize defines default max price digit size
 	DefaultMaxPriceDigitSize = 4
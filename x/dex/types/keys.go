@@ -37,6 +37,8 @@ const (
 	QueryOperator = "operator"
 	// QueryOperators defines operators query route path
 	QueryOperators = "operators"
+	// QueryProductFee defines product fee query route path
+	QueryProductFee = "product-fee"
 )
 
 var (
@@ -63,6 +65,8 @@ var (
 	UserTokenPairKeyPrefix = []byte{0x06}
     //the prefix of the confirm ownership key
 	PrefixConfirmOwnershipKey = []byte{0x07}
+	// TradingRuleUpdateKeyPrefix is the store key prefix for the trading rule updates indexed by the effective height
+	TradingRuleUpdateKeyPrefix = []byte{0x08}
 )
 
 // GetUserTokenPairAddressPrefix returns token pair address prefix key
@@ -126,4 +130,19 @@ func GetOperatorAddressKey(addr sdk.AccAddress) []byte {
 
 func GetConfirmOwnershipKey(product string) []byte {
 	return append(PrefixConfirmOwnershipKey, []byte(product)...)
-}
\ No newline at end of file
+}
+
+// GetTradingRuleUpdateHeightPrefix returns the prefix key of the trading rule updates effective at the block height
+func GetTradingRuleUpdateHeightPrefix(height int64) []byte {
+	return append(TradingRuleUpdateKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetTradingRuleUpdateKey returns the key of the trading rule update of the product effective at the block height
+func GetTradingRuleUpdateKey(height int64, product string) []byte {
+	return append(GetTradingRuleUpdateHeightPrefix(height), []byte(product)...)
+}
+
+// SplitTradingRuleUpdateKey splits the product out from a TradingRuleUpdateKey
+func SplitTradingRuleUpdateKey(key []byte) string {
+	return string(key[len(TradingRuleUpdateKeyPrefix)+8:])
+}
@@ -14,6 +14,8 @@ const (
 	typeMsgTransferOwnership = "transferOwnership"
 	typeMsgUpdateOperator    = "updateOperator"
 	typeMsgCreateOperator    = "createOperator"
+	typeMsgSetProductFee     = "setProductFee"
+	typeMsgUpdateTradingRule = "updateTradingRule"
 )
 
 // MsgList - high level transaction of the dex module
@@ -324,6 +326,96 @@ func (msg MsgUpdateOperator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+// MsgSetProductFee sets the deal fee rates of the product and the share of the deal fees sent to its operator,
+// which must be within the bounds in the params
+type MsgSetProductFee struct {
+	Owner     sdk.AccAddress   `json:"owner"`
+	Product   string           `json:"product"`
+	FeeConfig ProductFeeConfig `json:"fee_config"`
+}
+
+// NewMsgSetProductFee creates a new MsgSetProductFee
+func NewMsgSetProductFee(owner sdk.AccAddress, product string, feeConfig ProductFeeConfig) MsgSetProductFee {
+	return MsgSetProductFee{
+		Owner:     owner,
+		Product:   product,
+		FeeConfig: feeConfig,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgSetProductFee) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgSetProductFee) Type() string { return typeMsgSetProductFee }
+
+// ValidateBasic Implements Msg
+func (msg MsgSetProductFee) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if len(strings.TrimSpace(msg.Product)) == 0 {
+		return ErrInvalidProduct("product can not be empty")
+	}
+	return msg.FeeConfig.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg
+func (msg MsgSetProductFee) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg
+func (msg MsgSetProductFee) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgUpdateTradingRule schedules the update of the tick size and the lot size of the product, which must be within
+// the bounds in the params
+type MsgUpdateTradingRule struct {
+	Owner       sdk.AccAddress    `json:"owner"`
+	Product     string            `json:"product"`
+	TradingRule TradingRuleUpdate `json:"trading_rule"`
+}
+
+// NewMsgUpdateTradingRule creates a new MsgUpdateTradingRule
+func NewMsgUpdateTradingRule(owner sdk.AccAddress, product string, tradingRule TradingRuleUpdate) MsgUpdateTradingRule {
+	return MsgUpdateTradingRule{
+		Owner:       owner,
+		Product:     product,
+		TradingRule: tradingRule,
+	}
+}
+
+// Route Implements Msg
+func (msg MsgUpdateTradingRule) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgUpdateTradingRule) Type() string { return typeMsgUpdateTradingRule }
+
+// ValidateBasic Implements Msg
+func (msg MsgUpdateTradingRule) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInvalidAddress("missing owner address")
+	}
+	if len(strings.TrimSpace(msg.Product)) == 0 {
+		return ErrInvalidProduct("product can not be empty")
+	}
+	return msg.TradingRule.ValidateBasic()
+}
+
+// GetSignBytes Implements Msg
+func (msg MsgUpdateTradingRule) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg
+func (msg MsgUpdateTradingRule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 func checkWebsite(website string) sdk.Error {
 	if len(website) == 0 {
 		return nil
@@ -22,6 +22,10 @@ func TestMsg(t *testing.T) {
 	msgDeposit := NewMsgDeposit(product, sdk.NewDecCoin(common.NativeToken, sdk.NewInt(100)), addr)
 	msgWithdraw := NewMsgWithdraw(product, sdk.NewDecCoin(common.NativeToken, sdk.NewInt(100)), addr)
 	msgTransferOwnership := NewMsgTransferOwnership(addr, addr, product)
+	feeConfig := NewProductFeeConfig(sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.002"), sdk.OneDec())
+	msgSetProductFee := NewMsgSetProductFee(addr, product, feeConfig)
+	tradingRule := NewTradingRuleUpdate(2, 6, sdk.MustNewDecFromStr("0.001"), 100)
+	msgUpdateTradingRule := NewMsgUpdateTradingRule(addr, product, tradingRule)
 
 	// test msg.Route()、msg.Type()、msg.GetSigners()、GetSignBytes()
 	type Want struct {
@@ -43,6 +47,10 @@ func TestMsg(t *testing.T) {
 			Want{"dex", typeMsgWithdraw, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgWithdraw)), []sdk.AccAddress{addr}}},
 		{"msgTransferOwnership", msgTransferOwnership,
 			Want{"dex", typeMsgTransferOwnership, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgTransferOwnership)), []sdk.AccAddress{addr}}},
+		{"msgSetProductFee", msgSetProductFee,
+			Want{"dex", typeMsgSetProductFee, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgSetProductFee)), []sdk.AccAddress{addr}}},
+		{"msgUpdateTradingRule", msgUpdateTradingRule,
+			Want{"dex", typeMsgUpdateTradingRule, sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msgUpdateTradingRule)), []sdk.AccAddress{addr}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -88,6 +96,26 @@ func TestMsg(t *testing.T) {
 		{"transfer-no-from", NewMsgTransferOwnership(nil, toAddr, product), false},
 		{"transfer-no-to", NewMsgTransferOwnership(fromAddr, nil, product), false},
 		{"transfer-no-product", NewMsgTransferOwnership(fromAddr, toAddr, ""), false},
+
+		{"msgSetProductFee", msgSetProductFee, true},
+		{"set-product-fee-no-owner", NewMsgSetProductFee(nil, product, feeConfig), false},
+		{"set-product-fee-no-product", NewMsgSetProductFee(fromAddr, "", feeConfig), false},
+		{"set-product-fee-negative-rate", NewMsgSetProductFee(fromAddr, product,
+			NewProductFeeConfig(sdk.NewDec(-1), sdk.ZeroDec(), sdk.OneDec())), false},
+		{"set-product-fee-share-too-large", NewMsgSetProductFee(fromAddr, product,
+			NewProductFeeConfig(sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDec(2))), false},
+
+		{"msgUpdateTradingRule", msgUpdateTradingRule, true},
+		{"update-trading-rule-no-owner", NewMsgUpdateTradingRule(nil, product, tradingRule), false},
+		{"update-trading-rule-no-product", NewMsgUpdateTradingRule(fromAddr, "", tradingRule), false},
+		{"update-trading-rule-negative-digit", NewMsgUpdateTradingRule(fromAddr, product,
+			NewTradingRuleUpdate(-1, 6, sdk.MustNewDecFromStr("0.001"), 100)), false},
+		{"update-trading-rule-digit-too-large", NewMsgUpdateTradingRule(fromAddr, product,
+			NewTradingRuleUpdate(2, sdk.Precision+1, sdk.MustNewDecFromStr("0.001"), 100)), false},
+		{"update-trading-rule-min-size-over-accuracy", NewMsgUpdateTradingRule(fromAddr, product,
+			NewTradingRuleUpdate(2, 2, sdk.MustNewDecFromStr("0.001"), 100)), false},
+		{"update-trading-rule-no-effective-height", NewMsgUpdateTradingRule(fromAddr, product,
+			NewTradingRuleUpdate(2, 6, sdk.MustNewDecFromStr("0.001"), 0)), false},
 	}
 	for _, tb := range testBasics {
 		t.Run(tb.name, func(t *testing.T) {
@@ -23,6 +23,11 @@ type TokenPair struct {
 	Owner            sdk.AccAddress `json:"owner"`
 	Deposits         sdk.SysCoin    `json:"deposits"`
 	BlockHeight      int64          `json:"block_height"`
+	// FeeConfig is set by the owner of the product, the order module charges its default fee rates if it's nil
+	FeeConfig *ProductFeeConfig `json:"fee_config,omitempty"`
+	// PendingTradingRule is set by the owner of the product, it replaces the digits and the min quantity above at
+	// its effective height
+	PendingTradingRule *TradingRuleUpdate `json:"pending_trading_rule,omitempty"`
 }
 
 // Name returns name of token pair
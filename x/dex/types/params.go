@@ -18,6 +18,13 @@ var (
 	keyDelistVotingPeriod     = []byte("DelistVotingPeriod")
 	keyWithdrawPeriod         = []byte("WithdrawPeriod")
 	keyOwnershipConfirmWindow = []byte("OwnershipConfirmWindow")
+	keyMinProductFeeRate      = []byte("MinProductFeeRate")
+	keyMaxProductFeeRate      = []byte("MaxProductFeeRate")
+	keyMaxOperatorFeeShare    = []byte("MaxOperatorFeeShare")
+
+	keyMaxTradingRuleDigit       = []byte("MaxTradingRuleDigit")
+	keyMinTradingRuleUpdateDelay = []byte("MinTradingRuleUpdateDelay")
+	keyTradingRuleUpdatePolicy   = []byte("TradingRuleUpdatePolicy")
 )
 
 // Params defines param object
@@ -35,6 +42,19 @@ type Params struct {
 
 	WithdrawPeriod         time.Duration `json:"withdraw_period"`
 	OwnershipConfirmWindow time.Duration `json:"ownership_confirm_window"`
+
+	// the bounds of the maker and the taker fee rates set by the operators on their products
+	MinProductFeeRate sdk.Dec `json:"min_product_fee_rate"`
+	MaxProductFeeRate sdk.Dec `json:"max_product_fee_rate"`
+	// the max share of the deal fees sent to the operator of the product, the rest goes to the fee collector
+	MaxOperatorFeeShare sdk.Dec `json:"max_operator_fee_share"`
+
+	// the max price digit and max size digit that the operators can set on their products
+	MaxTradingRuleDigit int64 `json:"max_trading_rule_digit"`
+	// the min blocks between a trading rule update and the height it takes effect
+	MinTradingRuleUpdateDelay int64 `json:"min_trading_rule_update_delay"`
+	// the policy on the open orders that don't conform to the updated trading rule, grandfather or cancel
+	TradingRuleUpdatePolicy string `json:"trading_rule_update_policy"`
 }
 
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
@@ -48,9 +68,34 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: keyDelistVotingPeriod, Value: &p.DelistVotingPeriod, ValidatorFn: common.ValidateDurationPositive("delist voting period")},
 		{Key: keyWithdrawPeriod, Value: &p.WithdrawPeriod, ValidatorFn: common.ValidateDurationPositive("withdraw period")},
 		{Key: keyOwnershipConfirmWindow, Value: &p.OwnershipConfirmWindow, ValidatorFn: common.ValidateDurationPositive("ownership confirm window")},
+		{Key: keyMinProductFeeRate, Value: &p.MinProductFeeRate, ValidatorFn: common.ValidateRateNotNeg("min product fee rate")},
+		{Key: keyMaxProductFeeRate, Value: &p.MaxProductFeeRate, ValidatorFn: common.ValidateRateNotNeg("max product fee rate")},
+		{Key: keyMaxOperatorFeeShare, Value: &p.MaxOperatorFeeShare, ValidatorFn: common.ValidateRateNotNeg("max operator fee share")},
+		{Key: keyMaxTradingRuleDigit, Value: &p.MaxTradingRuleDigit, ValidatorFn: validateMaxTradingRuleDigit},
+		{Key: keyMinTradingRuleUpdateDelay, Value: &p.MinTradingRuleUpdateDelay, ValidatorFn: common.ValidateInt64Positive("min trading rule update delay")},
+		{Key: keyTradingRuleUpdatePolicy, Value: &p.TradingRuleUpdatePolicy, ValidatorFn: validateTradingRuleUpdatePolicy},
 	}
 }
 
+func validateMaxTradingRuleDigit(value interface{}) error {
+	digit, ok := value.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+	if digit < 0 || digit > sdk.Precision {
+		return fmt.Errorf("max trading rule digit must be between 0 and %d: %d", sdk.Precision, digit)
+	}
+	return nil
+}
+
+func validateTradingRuleUpdatePolicy(value interface{}) error {
+	policy, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+	return ValidateTradingRulePolicy(policy)
+}
+
 // ParamKeyTable for auth module
 func ParamKeyTable() params.KeyTable {
 	return params.NewKeyTable().RegisterParamSet(&Params{})
@@ -70,12 +115,23 @@ func DefaultParams() *Params {
 		DelistVotingPeriod:     time.Hour * 72,
 		WithdrawPeriod:         DefaultWithdrawPeriod,
 		OwnershipConfirmWindow: DefaultOwnershipConfirmWindow,
+		MinProductFeeRate:      sdk.ZeroDec(),
+		MaxProductFeeRate:      sdk.MustNewDecFromStr(defaultMaxProductFeeRate),
+		MaxOperatorFeeShare:    sdk.OneDec(),
+
+		MaxTradingRuleDigit:       defaultMaxTradingRuleDigit,
+		MinTradingRuleUpdateDelay: defaultMinTradingRuleUpdateDelay,
+		TradingRuleUpdatePolicy:   TradingRulePolicyGrandfather,
 	}
 }
 
 // String implements the stringer interface.
 func (p Params) String() string {
 	return fmt.Sprintf("Params: \nDexListFee:%s\nTransferOwnershipFee:%s\nRegisterOperatorFee:%s\nDelistMaxDepositPeriod:%s\n"+
-		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n",
-		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow)
+		"DelistMinDeposit:%s\nDelistVotingPeriod:%s\nWithdrawPeriod:%d\nOwnershipConfirmWindow: %s\n"+
+		"MinProductFeeRate:%s\nMaxProductFeeRate:%s\nMaxOperatorFeeShare:%s\n"+
+		"MaxTradingRuleDigit:%d\nMinTradingRuleUpdateDelay:%d\nTradingRuleUpdatePolicy:%s\n",
+		p.ListFee, p.TransferOwnershipFee, p.RegisterOperatorFee, p.DelistMaxDepositPeriod, p.DelistMinDeposit, p.DelistVotingPeriod, p.WithdrawPeriod, p.OwnershipConfirmWindow,
+		p.MinProductFeeRate, p.MaxProductFeeRate, p.MaxOperatorFeeShare,
+		p.MaxTradingRuleDigit, p.MinTradingRuleUpdateDelay, p.TradingRuleUpdatePolicy)
 }
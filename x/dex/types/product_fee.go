@@ -0,0 +1,82 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProductFeeConfig is the deal fee rates of a product and the share of the deal fees sent to its operator
+type ProductFeeConfig struct {
+	MakerFeeRate     sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate     sdk.Dec `json:"taker_fee_rate"`
+	OperatorFeeShare sdk.Dec `json:"operator_fee_share"`
+}
+
+// NewProductFeeConfig creates a new instance of ProductFeeConfig
+func NewProductFeeConfig(makerFeeRate, takerFeeRate, operatorFeeShare sdk.Dec) ProductFeeConfig {
+	return ProductFeeConfig{
+		MakerFeeRate:     makerFeeRate,
+		TakerFeeRate:     takerFeeRate,
+		OperatorFeeShare: operatorFeeShare,
+	}
+}
+
+// ValidateBasic returns an error if any rate is out of [0, 1]
+func (c ProductFeeConfig) ValidateBasic() sdk.Error {
+	if !isValidRate(c.MakerFeeRate) {
+		return ErrInvalidProductFee("maker fee rate must be between 0 and 1")
+	}
+	if !isValidRate(c.TakerFeeRate) {
+		return ErrInvalidProductFee("taker fee rate must be between 0 and 1")
+	}
+	if !isValidRate(c.OperatorFeeShare) {
+		return ErrInvalidProductFee("operator fee share must be between 0 and 1")
+	}
+	return nil
+}
+
+func isValidRate(rate sdk.Dec) bool {
+	return !rate.IsNil() && !rate.IsNegative() && rate.LTE(sdk.OneDec())
+}
+
+// ValidateBounds returns an error if the config is out of the bounds in the params
+func (c ProductFeeConfig) ValidateBounds(params Params) error {
+	if c.MakerFeeRate.LT(params.MinProductFeeRate) || c.MakerFeeRate.GT(params.MaxProductFeeRate) ||
+		c.TakerFeeRate.LT(params.MinProductFeeRate) || c.TakerFeeRate.GT(params.MaxProductFeeRate) {
+		return fmt.Errorf("fee rates must be between %s and %s", params.MinProductFeeRate, params.MaxProductFeeRate)
+	}
+	if c.OperatorFeeShare.GT(params.MaxOperatorFeeShare) {
+		return fmt.Errorf("operator fee share must not be greater than %s", params.MaxOperatorFeeShare)
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (c ProductFeeConfig) String() string {
+	return fmt.Sprintf(`MakerFeeRate: %s
+TakerFeeRate: %s
+OperatorFeeShare: %s`, c.MakerFeeRate, c.TakerFeeRate, c.OperatorFeeShare)
+}
+
+// ProductFee is the query result of the fee config of a product with the bounds in the params
+type ProductFee struct {
+	Product             string            `json:"product"`
+	FeeConfig           *ProductFeeConfig `json:"fee_config"`
+	MinProductFeeRate   sdk.Dec           `json:"min_product_fee_rate"`
+	MaxProductFeeRate   sdk.Dec           `json:"max_product_fee_rate"`
+	MaxOperatorFeeShare sdk.Dec           `json:"max_operator_fee_share"`
+}
+
+// String implements the stringer interface
+func (f ProductFee) String() string {
+	feeConfig := "default fee rates of the order module"
+	if f.FeeConfig != nil {
+		feeConfig = "\n" + f.FeeConfig.String()
+	}
+	return fmt.Sprintf(`Product: %s
+FeeConfig: %s
+MinProductFeeRate: %s
+MaxProductFeeRate: %s
+MaxOperatorFeeShare: %s`, f.Product, feeConfig, f.MinProductFeeRate, f.MaxProductFeeRate, f.MaxOperatorFeeShare)
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// the policies on the open orders that don't conform to the updated trading rule of their product
+const (
+	// TradingRulePolicyGrandfather keeps the nonconforming orders in the depth book until they're filled, cancelled
+	// or expired
+	TradingRulePolicyGrandfather = "grandfather"
+	// TradingRulePolicyCancel cancels the nonconforming orders and refunds their fees
+	TradingRulePolicyCancel = "cancel"
+)
+
+// ValidateTradingRulePolicy returns an error if the policy is unknown
+func ValidateTradingRulePolicy(policy string) error {
+	switch policy {
+	case TradingRulePolicyGrandfather, TradingRulePolicyCancel:
+		return nil
+	default:
+		return fmt.Errorf("invalid trading rule update policy: %s", policy)
+	}
+}
+
+// TradingRuleUpdate is the new tick size and lot size of a product set by its owner, which replace the ones of the
+// token pair at EffectiveHeight
+type TradingRuleUpdate struct {
+	MaxPriceDigit    int64   `json:"max_price_digit"`
+	MaxQuantityDigit int64   `json:"max_size_digit"`
+	MinQuantity      sdk.Dec `json:"min_trade_size"`
+	EffectiveHeight  int64   `json:"effective_height"`
+}
+
+// NewTradingRuleUpdate creates a new instance of TradingRuleUpdate
+func NewTradingRuleUpdate(maxPriceDigit, maxQuantityDigit int64, minQuantity sdk.Dec,
+	effectiveHeight int64) TradingRuleUpdate {
+	return TradingRuleUpdate{
+		MaxPriceDigit:    maxPriceDigit,
+		MaxQuantityDigit: maxQuantityDigit,
+		MinQuantity:      minQuantity,
+		EffectiveHeight:  effectiveHeight,
+	}
+}
+
+// ValidateBasic returns an error if the digits are out of the precision of sdk.Dec or the min quantity is over the
+// quantity digit
+func (u TradingRuleUpdate) ValidateBasic() sdk.Error {
+	if u.MaxPriceDigit < 0 || u.MaxPriceDigit > sdk.Precision {
+		return ErrInvalidTradingRule(fmt.Sprintf("max price digit must be between 0 and %d", sdk.Precision))
+	}
+	if u.MaxQuantityDigit < 0 || u.MaxQuantityDigit > sdk.Precision {
+		return ErrInvalidTradingRule(fmt.Sprintf("max size digit must be between 0 and %d", sdk.Precision))
+	}
+	if u.MinQuantity.IsNil() || u.MinQuantity.IsNegative() {
+		return ErrInvalidTradingRule("min trade size must not be negative")
+	}
+	if !u.MinQuantity.RoundDecimal(u.MaxQuantityDigit).Equal(u.MinQuantity) {
+		return ErrInvalidTradingRule(fmt.Sprintf("min trade size(%s) over accuracy(%d)", u.MinQuantity,
+			u.MaxQuantityDigit))
+	}
+	if u.EffectiveHeight <= 0 {
+		return ErrInvalidTradingRule("effective height must be positive")
+	}
+	return nil
+}
+
+// ValidateBounds returns an error if the digits are out of the bound in the params, or the update doesn't give the
+// traders enough notice at the block height
+func (u TradingRuleUpdate) ValidateBounds(params Params, blockHeight int64) error {
+	if u.MaxPriceDigit > params.MaxTradingRuleDigit || u.MaxQuantityDigit > params.MaxTradingRuleDigit {
+		return fmt.Errorf("digits must not be greater than %d", params.MaxTradingRuleDigit)
+	}
+	if u.EffectiveHeight < blockHeight+params.MinTradingRuleUpdateDelay {
+		return fmt.Errorf("effective height must not be less than %d", blockHeight+params.MinTradingRuleUpdateDelay)
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (u TradingRuleUpdate) String() string {
+	return fmt.Sprintf(`MaxPriceDigit: %d
+MaxSizeDigit: %d
+MinTradeSize: %s
+EffectiveHeight: %d`, u.MaxPriceDigit, u.MaxQuantityDigit, u.MinQuantity, u.EffectiveHeight)
+}
+
+// ConformsToTradingRule returns true if the price and the quantity of an order conform to the tick size and the lot
+// size of the token pair
+func (tp *TokenPair) ConformsToTradingRule(price, quantity sdk.Dec) bool {
+	return price.RoundDecimal(tp.MaxPriceDigit).Equal(price) &&
+		quantity.RoundDecimal(tp.MaxQuantityDigit).Equal(quantity) &&
+		quantity.GTE(tp.MinQuantity)
+}
+
+// ApplyTradingRuleUpdate replaces the tick size and the lot size of the token pair with the pending ones
+func (tp *TokenPair) ApplyTradingRuleUpdate() {
+	if tp.PendingTradingRule == nil {
+		return
+	}
+	tp.MaxPriceDigit = tp.PendingTradingRule.MaxPriceDigit
+	tp.MaxQuantityDigit = tp.PendingTradingRule.MaxQuantityDigit
+	tp.MinQuantity = tp.PendingTradingRule.MinQuantity
+	tp.PendingTradingRule = nil
+}
@@ -47,3 +47,23 @@ func (k Keeper) distributeFromFeePool(ctx sdk.Context, amount sdk.Coins, receive
 	k.SetFeePool(ctx, feePool)
 	return nil
 }
+
+// DistributeFromFeePoolToModule distributes funds from the community pool to a module account, which is used by the
+// modules funded by governance
+func (k Keeper) DistributeFromFeePoolToModule(ctx sdk.Context, amount sdk.Coins, recipientModule string) error {
+	feePool := k.GetFeePool(ctx)
+
+	newPool, negative := feePool.CommunityPool.SafeSub(amount)
+	if negative {
+		return types.ErrBadDistribution(types.DefaultCodespace)
+	}
+	feePool.CommunityPool = newPool
+
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipientModule, amount)
+	if err != nil {
+		return err
+	}
+
+	k.SetFeePool(ctx, feePool)
+	return nil
+}
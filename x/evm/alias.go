@@ -15,8 +15,11 @@ const (
 
 // nolint
 var (
-	NewKeeper = keeper.NewKeeper
-	TxDecoder = types.TxDecoder
+	NewKeeper                                    = keeper.NewKeeper
+	TxDecoder                                    = types.TxDecoder
+	HashFromContext                              = types.HashFromContext
+	NewManageContractDeploymentWhitelistProposal = types.NewManageContractDeploymentWhitelistProposal
+	NewManageContractBlockedListProposal         = types.NewManageContractBlockedListProposal
 )
 
 //nolint
@@ -28,6 +28,8 @@ func GetQueryCmd(moduleName string, cdc *codec.Codec) *cobra.Command {
 		GetCmdGetStorageAt(moduleName, cdc),
 		GetCmdGetCode(moduleName, cdc),
 		GetCmdQueryParams(moduleName, cdc),
+		GetCmdQueryContractDeploymentWhitelist(moduleName, cdc),
+		GetCmdQueryContractBlockedList(moduleName, cdc),
 	)...)
 	return evmQueryCmd
 }
@@ -114,3 +116,55 @@ $ okexchaincli query evm params
 		},
 	}
 }
+
+// GetCmdQueryContractDeploymentWhitelist gets the contract deployment whitelist info
+func GetCmdQueryContractDeploymentWhitelist(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-deployment-whitelist",
+		Short: "Query the whitelist of contract deployers",
+		Long: strings.TrimSpace(`Query the whitelist of the addresses which are allowed to deploy contracts:
+
+$ okexchaincli query evm contract-deployment-whitelist
+`),
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContractDeploymentWhitelist)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var whitelist types.AddressList
+			cdc.MustUnmarshalJSON(bz, &whitelist)
+			return cliCtx.PrintOutput(whitelist)
+		},
+	}
+}
+
+// GetCmdQueryContractBlockedList gets the contract blocked list info
+func GetCmdQueryContractBlockedList(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-blocked-list",
+		Short: "Query the list of contracts which are blocked",
+		Long: strings.TrimSpace(`Query the list of the contract addresses which are not allowed to be called:
+
+$ okexchaincli query evm contract-blocked-list
+`),
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContractBlockedList)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var blockedList types.AddressList
+			cdc.MustUnmarshalJSON(bz, &blockedList)
+			return cliCtx.PrintOutput(blockedList)
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	govRest "github.com/okex/okexchain/x/gov/client/rest"
+)
+
+// ManageContractDeploymentWhitelistProposalRESTHandler defines evm proposal handler
+func ManageContractDeploymentWhitelistProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// ManageContractBlockedListProposalRESTHandler defines evm proposal handler
+func ManageContractBlockedListProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
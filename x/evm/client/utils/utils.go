@@ -0,0 +1,55 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// ManageContractDeploymentWhitelistProposalJSON defines a ManageContractDeploymentWhitelistProposal with a deposit
+// used to parse manage contract deployment whitelist proposals from a JSON file.
+type ManageContractDeploymentWhitelistProposalJSON struct {
+	Title         string            `json:"title" yaml:"title"`
+	Description   string            `json:"description" yaml:"description"`
+	DeployerAddrs types.AddressList `json:"deployer_addresses" yaml:"deployer_addresses"`
+	IsAdded       bool              `json:"is_added" yaml:"is_added"`
+	Deposit       sdk.SysCoins      `json:"deposit" yaml:"deposit"`
+}
+
+// ManageContractBlockedListProposalJSON defines a ManageContractBlockedListProposal with a deposit used to parse
+// manage contract blocked list proposals from a JSON file.
+type ManageContractBlockedListProposalJSON struct {
+	Title         string            `json:"title" yaml:"title"`
+	Description   string            `json:"description" yaml:"description"`
+	ContractAddrs types.AddressList `json:"contract_addresses" yaml:"contract_addresses"`
+	IsAdded       bool              `json:"is_added" yaml:"is_added"`
+	Deposit       sdk.SysCoins      `json:"deposit" yaml:"deposit"`
+}
+
+// ParseManageContractDeploymentWhitelistProposalJSON parses json from proposal file to
+// ManageContractDeploymentWhitelistProposalJSON struct
+func ParseManageContractDeploymentWhitelistProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageContractDeploymentWhitelistProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// ParseManageContractBlockedListProposalJSON parses json from proposal file to ManageContractBlockedListProposalJSON
+// struct
+func ParseManageContractBlockedListProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal ManageContractBlockedListProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
@@ -56,6 +56,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, accountKeeper types.AccountKeeper, d
 	k.SetChainConfig(ctx, data.ChainConfig)
 	k.SetParams(ctx, data.Params)
 
+	csdb := k.CommitStateDB.WithContext(ctx)
+	csdb.SetContractDeploymentWhitelist(data.ContractDeploymentWhitelist)
+	csdb.SetContractBlockedList(data.ContractBlockedList)
+
 	// set state objects and code to store
 	_, err = k.Commit(ctx, false)
 	if err != nil {
@@ -101,11 +105,14 @@ func ExportGenesis(ctx sdk.Context, k Keeper, ak types.AccountKeeper) GenesisSta
 	})
 
 	config, _ := k.GetChainConfig(ctx)
+	csdb := k.CommitStateDB.WithContext(ctx)
 
 	return GenesisState{
-		Accounts:    ethGenAccounts,
-		TxsLogs:     k.GetAllTxLogs(ctx),
-		ChainConfig: config,
-		Params:      k.GetParams(ctx),
+		Accounts:                    ethGenAccounts,
+		TxsLogs:                     k.GetAllTxLogs(ctx),
+		ChainConfig:                 config,
+		Params:                      k.GetParams(ctx),
+		ContractDeploymentWhitelist: csdb.GetContractDeploymentWhitelist(),
+		ContractBlockedList:         csdb.GetContractBlockedList(),
 	}
 }
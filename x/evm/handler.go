@@ -51,6 +51,7 @@ func handleMsgEthereumTx(ctx sdk.Context, k Keeper, msg types.MsgEthereumTx) (*s
 		Recipient:    msg.Data.Recipient,
 		Amount:       msg.Data.Amount,
 		Payload:      msg.Data.Payload,
+		AccessList:   msg.Data.Accesses,
 		Csdb:         k.CommitStateDB.WithContext(ctx),
 		ChainID:      chainIDEpoch,
 		TxHash:       &ethHash,
@@ -87,6 +88,9 @@ func handleMsgEthereumTx(ctx sdk.Context, k Keeper, msg types.MsgEthereumTx) (*s
 		if err != nil {
 			panic(err)
 		}
+
+		// update the node-local log index
+		k.AddTxLogsToIndex(ctx, executionResult.Logs)
 	}
 
 	// log successful execution
@@ -140,6 +144,7 @@ func handleMsgEthermint(ctx sdk.Context, k Keeper, msg types.MsgEthermint) (*sdk
 		TxHash:       &ethHash,
 		Sender:       common.BytesToAddress(msg.From.Bytes()),
 		Simulate:     ctx.IsCheckTx(),
+		AccessList:   msg.Accesses,
 	}
 
 	if msg.Recipient != nil {
@@ -173,6 +178,9 @@ func handleMsgEthermint(ctx sdk.Context, k Keeper, msg types.MsgEthermint) (*sdk
 		if err != nil {
 			panic(err)
 		}
+
+		// update the node-local log index
+		k.AddTxLogsToIndex(ctx, executionResult.Logs)
 	}
 
 	// log successful execution
@@ -201,6 +201,29 @@ func (suite *EvmTestSuite) TestMsgEthermint() {
 	}
 }
 
+func (suite *EvmTestSuite) TestMsgEthermintAccessList() {
+	from := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	to := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	accessList := types.AccessList{{
+		Address:     ethcmn.BytesToAddress(to.Bytes()),
+		StorageKeys: []ethcmn.Hash{{0x1}},
+	}}
+
+	// the simulation of the eth_call and the eth_estimateGas charges the intrinsic gas of the access list
+	simulate := func(msg types.MsgEthermint) uint64 {
+		suite.SetupTest() // reset
+		suite.app.EvmKeeper.SetBalance(suite.ctx, ethcmn.BytesToAddress(from.Bytes()), big.NewInt(100))
+		ctx := suite.ctx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter())
+		_, err := suite.handler(ctx, msg)
+		suite.Require().NoError(err)
+		return ctx.GasMeter().GasConsumed()
+	}
+	gas := simulate(types.NewMsgEthermint(0, &to, sdk.NewInt(1), 100000, sdk.NewInt(2), []byte("test"), from))
+	accessListGas := simulate(types.NewMsgEthermintAccessList(0, &to, sdk.NewInt(1), 100000, sdk.NewInt(2),
+		[]byte("test"), from, accessList))
+	suite.Require().Equal(types.AccessListAddressGas+types.AccessListStorageKeyGas, accessListGas-gas)
+}
+
 func (suite *EvmTestSuite) TestHandlerLogs() {
 	// Test contract:
 
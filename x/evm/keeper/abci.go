@@ -57,5 +57,12 @@ func (k Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.Valid
 	bloom := ethtypes.BytesToBloom(k.Bloom.Bytes())
 	k.SetBlockBloom(ctx, req.Height, bloom)
 
+	// flush the logs of the block into the node-local log index
+	if k.logIndexer != nil {
+		if err := k.logIndexer.Commit(req.Height); err != nil {
+			k.Logger(ctx).Error("failed to update the log index", "error", err, "height", req.Height)
+		}
+	}
+
 	return []abci.ValidatorUpdate{}
 }
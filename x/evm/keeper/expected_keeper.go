@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// GovKeeper defines the expected gov Keeper
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
+
+// LogIndexer defines the expected node-local index of evm logs, which is fed with the DeliverTx results
+type LogIndexer interface {
+	AddTxLogs(height int64, logs []*ethtypes.Log)
+	Commit(height int64) error
+}
@@ -30,6 +30,8 @@ type Keeper struct {
 	// - storing block height -> bloom filter map. Needed for the Web3 API.
 	// - storing block hash -> block height map. Needed for the Web3 API.
 	storeKey sdk.StoreKey
+	// Param space of the evm params, which is shared with the CommitStateDB
+	paramSpace params.Subspace
 	// Account Keeper for fetching accounts
 	accountKeeper types.AccountKeeper
 	// Ethermint concrete implementation on the EVM StateDB interface
@@ -39,6 +41,10 @@ type Keeper struct {
 	// on the KVStore or adding it as a field on the EVM genesis state.
 	TxCount int
 	Bloom   *big.Int
+
+	govKeeper GovKeeper
+	// optional node-local index of the transaction logs
+	logIndexer LogIndexer
 }
 
 // NewKeeper generates new evm module keeper
@@ -54,6 +60,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:           cdc,
 		storeKey:      storeKey,
+		paramSpace:    paramSpace,
 		accountKeeper: ak,
 		CommitStateDB: types.NewCommitStateDB(sdk.Context{}, storeKey, paramSpace, ak),
 		TxCount:       0,
@@ -61,6 +68,24 @@ func NewKeeper(
 	}
 }
 
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk GovKeeper) {
+	k.govKeeper = gk
+}
+
+// SetLogIndexer sets the node-local log index which is updated with the logs of the delivered transactions
+func (k *Keeper) SetLogIndexer(indexer LogIndexer) {
+	k.logIndexer = indexer
+}
+
+// AddTxLogsToIndex buffers the logs of a delivered message into the log index if it's enabled. The logs are kept
+// only if the whole transaction succeeds, which is checked on DeliverTx of the app
+func (k Keeper) AddTxLogsToIndex(ctx sdk.Context, logs []*ethtypes.Log) {
+	if k.logIndexer != nil && len(logs) != 0 {
+		k.logIndexer.AddTxLogs(ctx.BlockHeight(), logs)
+	}
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -183,3 +208,38 @@ func (k Keeper) SetChainConfig(ctx sdk.Context, config types.ChainConfig) {
 	// get to an empty key that's already prefixed by KeyPrefixChainConfig
 	store.Set([]byte{}, bz)
 }
+
+// ----------------------------------------------------------------------------
+// Contract deployment whitelist and contract blocked list functions
+// Managed by the governance proposals of evm module.
+// ----------------------------------------------------------------------------
+
+// GetContractDeploymentWhitelist gets the whole contract deployment whitelist currently
+func (k Keeper) GetContractDeploymentWhitelist(ctx sdk.Context) types.AddressList {
+	return k.CommitStateDB.WithContext(ctx).GetContractDeploymentWhitelist()
+}
+
+// SetContractDeploymentWhitelist sets the target address list into the contract deployment whitelist
+func (k Keeper) SetContractDeploymentWhitelist(ctx sdk.Context, addrList types.AddressList) {
+	k.CommitStateDB.WithContext(ctx).SetContractDeploymentWhitelist(addrList)
+}
+
+// DeleteContractDeploymentWhitelist removes the target address list from the contract deployment whitelist
+func (k Keeper) DeleteContractDeploymentWhitelist(ctx sdk.Context, addrList types.AddressList) {
+	k.CommitStateDB.WithContext(ctx).DeleteContractDeploymentWhitelist(addrList)
+}
+
+// GetContractBlockedList gets the whole contract blocked list currently
+func (k Keeper) GetContractBlockedList(ctx sdk.Context) types.AddressList {
+	return k.CommitStateDB.WithContext(ctx).GetContractBlockedList()
+}
+
+// SetContractBlockedList sets the target address list into the contract blocked list
+func (k Keeper) SetContractBlockedList(ctx sdk.Context, addrList types.AddressList) {
+	k.CommitStateDB.WithContext(ctx).SetContractBlockedList(addrList)
+}
+
+// DeleteContractBlockedList removes the target address list from the contract blocked list
+func (k Keeper) DeleteContractBlockedList(ctx sdk.Context, addrList types.AddressList) {
+	k.CommitStateDB.WithContext(ctx).DeleteContractBlockedList(addrList)
+}
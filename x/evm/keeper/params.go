@@ -15,3 +15,13 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.CommitStateDB.WithContext(ctx).SetParams(params)
 }
+
+// MigrateParams completes the params stored by an older software with the default values of the params not stored
+// yet, whose missing keys make GetParams panic
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+	k.SetParams(ctx, params)
+}
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+
 	"github.com/okex/okexchain/x/evm/types"
+	"github.com/okex/okexchain/x/params"
 )
 
 func (suite *KeeperTestSuite) TestParams() {
@@ -12,3 +15,25 @@ func (suite *KeeperTestSuite) TestParams() {
 	newParams := suite.app.EvmKeeper.GetParams(suite.ctx)
 	suite.Require().Equal(newParams, params)
 }
+
+func (suite *KeeperTestSuite) TestMigrateParams() {
+	evmParams := types.DefaultParams()
+	evmParams.EvmDenom = "ara"
+	evmParams.EnableCreate = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+
+	// the params stored by the software before the contract deployment whitelist and the contract blocked list
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(params.StoreKey)), []byte(types.DefaultParamspace+"/"))
+	store.Delete(types.ParamStoreKeyContractDeploymentWhitelist)
+	store.Delete(types.ParamStoreKeyContractBlockedList)
+	suite.Require().Panics(func() { suite.app.EvmKeeper.GetParams(suite.ctx) })
+
+	suite.app.EvmKeeper.MigrateParams(suite.ctx)
+	suite.Require().Equal(evmParams, suite.app.EvmKeeper.GetParams(suite.ctx))
+
+	// the params already migrated are kept
+	evmParams.EnableContractBlockedList = true
+	suite.app.EvmKeeper.SetParams(suite.ctx, evmParams)
+	suite.app.EvmKeeper.MigrateParams(suite.ctx)
+	suite.Require().Equal(evmParams, suite.app.EvmKeeper.GetParams(suite.ctx))
+}
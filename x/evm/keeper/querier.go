@@ -42,6 +42,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryExportAccount(ctx, path, keeper)
 		case types.QueryParameters:
 			return queryParams(ctx, keeper)
+		case types.QueryContractDeploymentWhitelist:
+			return queryContractDeploymentWhitelist(ctx, keeper)
+		case types.QueryContractBlockedList:
+			return queryContractBlockedList(ctx, keeper)
 		default:
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, "unknown query endpoint")
 		}
@@ -221,3 +225,23 @@ func queryParams(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryContractDeploymentWhitelist(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	whitelist := keeper.GetContractDeploymentWhitelist(ctx)
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, whitelist)
+	if errUnmarshal != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, errUnmarshal.Error())
+	}
+
+	return res, nil
+}
+
+func queryContractBlockedList(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	blockedList := keeper.GetContractBlockedList(ctx)
+	res, errUnmarshal := codec.MarshalJSONIndent(types.ModuleCdc, blockedList)
+	if errUnmarshal != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, errUnmarshal.Error())
+	}
+
+	return res, nil
+}
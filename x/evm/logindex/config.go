@@ -0,0 +1,56 @@
+package logindex
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// nolint
+const (
+	FlagEnableLogIndex = "evm-log-index"
+	FlagMaxBlockRange  = "evm-log-index-max-range"
+	FlagMaxResults     = "evm-log-index-max-results"
+
+	DefaultMaxBlockRange = 100000
+	DefaultMaxResults    = 10000
+)
+
+// Config defines the node-local configuration of the log index
+type Config struct {
+	// Enable toggles the indexing of evm logs on DeliverTx and its usage by eth_getLogs/eth_newFilter
+	Enable bool `json:"enable"`
+	// MaxBlockRange is the max number of blocks an indexed range query is allowed to cover
+	MaxBlockRange int64 `json:"max_block_range"`
+	// MaxResults is the max number of logs an indexed range query is allowed to return
+	MaxResults int `json:"max_results"`
+}
+
+// DefaultConfig returns the default configuration of the log index, which is disabled
+func DefaultConfig() Config {
+	return Config{
+		Enable:        false,
+		MaxBlockRange: DefaultMaxBlockRange,
+		MaxResults:    DefaultMaxResults,
+	}
+}
+
+// GetConfig builds the log index configuration from the flags bound to viper
+func GetConfig() Config {
+	cfg := DefaultConfig()
+	cfg.Enable = viper.GetBool(FlagEnableLogIndex)
+	if viper.IsSet(FlagMaxBlockRange) {
+		cfg.MaxBlockRange = viper.GetInt64(FlagMaxBlockRange)
+	}
+	if viper.IsSet(FlagMaxResults) {
+		cfg.MaxResults = viper.GetInt(FlagMaxResults)
+	}
+
+	return cfg
+}
+
+// AddFlags adds the log index flags to the command
+func AddFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(FlagEnableLogIndex, false, "Enable the node-local evm log index for eth_getLogs range queries")
+	cmd.PersistentFlags().Int64(FlagMaxBlockRange, DefaultMaxBlockRange, "Max number of blocks covered by an indexed eth_getLogs query")
+	cmd.PersistentFlags().Int(FlagMaxResults, DefaultMaxResults, "Max number of logs returned by an indexed eth_getLogs query")
+}
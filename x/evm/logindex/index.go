@@ -0,0 +1,347 @@
+package logindex
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/x/evm/nodestore"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// DBName is the name of the node-local database that holds the log index
+const DBName = "evm_log_index"
+
+// key prefixes of the log index database
+var (
+	prefixBlockLogs = []byte{0x01} // prefix | height -> amino encoded logs of the block
+	prefixAddress   = []byte{0x02} // prefix | address | height -> nil
+	prefixTopic     = []byte{0x03} // prefix | position | topic | height -> nil
+
+	// the value of the posting keys
+	emptyValue = []byte{}
+)
+
+var (
+	// ErrBlockRangeTooLarge is returned when the queried block range exceeds the configured max block range
+	ErrBlockRangeTooLarge = errors.New("block range exceeds the max block range of the log index")
+	// ErrTooManyResults is returned when the logs matched exceed the configured max results
+	ErrTooManyResults = errors.New("query returned more than the max results of the log index")
+)
+
+var (
+	globalMtx   sync.RWMutex
+	globalIndex *Index
+)
+
+// SetGlobalIndex sets the log index shared by the app and the in-process rpc server
+func SetGlobalIndex(idx *Index) {
+	globalMtx.Lock()
+	defer globalMtx.Unlock()
+	globalIndex = idx
+}
+
+// GetGlobalIndex returns the log index shared by the app and the in-process rpc server. It returns nil if the
+// log index is disabled or the rpc server runs in a standalone process
+func GetGlobalIndex() *Index {
+	globalMtx.RLock()
+	defer globalMtx.RUnlock()
+	return globalIndex
+}
+
+// Index stores the evm logs with their address and topic postings per block, so that the range queries of
+// eth_getLogs only load the blocks that contain matched logs
+type Index struct {
+	*nodestore.Store
+	cfg Config
+
+	mtx sync.Mutex
+	// logs of the block being delivered, flushed on Commit
+	pendingHeight int64
+	pendingLogs   []*ethtypes.Log
+	// logs of the transaction being delivered, moved into the logs of the block on EndTx
+	pendingTxLogs []*ethtypes.Log
+}
+
+// Open opens the log index database in the data directory
+func Open(dataDir string, cfg Config) (*Index, error) {
+	store, err := nodestore.Open(DBName, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Index{Store: store, cfg: cfg}, nil
+}
+
+// OpenGlobalIndex opens the log index database in the data directory and sets it as the global log index. The
+// global log index is returned directly if it has been opened, since the app may be created more than once in a
+// process
+func OpenGlobalIndex(dataDir string, cfg Config) (*Index, error) {
+	globalMtx.Lock()
+	defer globalMtx.Unlock()
+
+	if globalIndex != nil {
+		return globalIndex, nil
+	}
+
+	idx, err := Open(dataDir, cfg)
+	if err != nil {
+		return nil, err
+	}
+	globalIndex = idx
+	return idx, nil
+}
+
+// NewIndex creates a new log index over the db
+func NewIndex(db dbm.DB, cfg Config) *Index {
+	return &Index{
+		Store: nodestore.NewStore(db),
+		cfg:   cfg,
+	}
+}
+
+// Config returns the configuration of the log index
+func (idx *Index) Config() Config {
+	return idx.cfg
+}
+
+// AddTxLogs buffers the logs of a successfully executed message until the transaction is ended
+func (idx *Index) AddTxLogs(height int64, logs []*ethtypes.Log) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if idx.pendingHeight != height {
+		// drop the logs left by a block which was never committed
+		idx.pendingHeight = height
+		idx.pendingLogs, idx.pendingTxLogs = nil, nil
+	}
+	idx.pendingTxLogs = append(idx.pendingTxLogs, logs...)
+}
+
+// EndTx keeps the buffered logs of the delivered transaction until the block is committed only if the whole
+// transaction succeeded, since a failed message reverts the state changes of the earlier messages in the same
+// transaction as well
+func (idx *Index) EndTx(height int64, ok bool) {
+	idx.mtx.Lock()
+	defer idx.mtx.Unlock()
+
+	if ok && idx.pendingHeight == height {
+		idx.pendingLogs = append(idx.pendingLogs, idx.pendingTxLogs...)
+	}
+	idx.pendingTxLogs = nil
+}
+
+// Commit writes the buffered logs of the block into the index
+func (idx *Index) Commit(height int64) error {
+	idx.mtx.Lock()
+	var logs []*ethtypes.Log
+	if idx.pendingHeight == height {
+		logs = idx.pendingLogs
+	}
+	idx.pendingHeight, idx.pendingLogs, idx.pendingTxLogs = 0, nil, nil
+	idx.mtx.Unlock()
+
+	return idx.IndexBlock(height, logs)
+}
+
+// IndexBlock writes the logs of the block and their postings into the index. Indexing a block again overwrites
+// the previous entries, which makes it safe to replay or rebuild blocks
+func (idx *Index) IndexBlock(height int64, logs []*ethtypes.Log) error {
+	return idx.WriteBlock(height, func(batch dbm.Batch) error {
+		if len(logs) == 0 {
+			return nil
+		}
+
+		bz, err := types.MarshalLogs(logs)
+		if err != nil {
+			return err
+		}
+		batch.Set(blockLogsKey(height), bz)
+
+		for _, log := range logs {
+			batch.Set(addressKey(log.Address, height), emptyValue)
+			for pos, topic := range log.Topics {
+				batch.Set(topicKey(pos, topic, height), emptyValue)
+			}
+		}
+		return nil
+	})
+}
+
+// Logs returns the logs within the block range [from, to] which match the addresses and the positional topics,
+// following the same criteria as eth_getLogs
+func (idx *Index) Logs(from, to int64, addresses []ethcmn.Address, topics [][]ethcmn.Hash) ([]*ethtypes.Log, error) {
+	if from > to {
+		return []*ethtypes.Log{}, nil
+	}
+	if idx.cfg.MaxBlockRange > 0 && to-from+1 > idx.cfg.MaxBlockRange {
+		return nil, fmt.Errorf("%w: %d > %d", ErrBlockRangeTooLarge, to-from+1, idx.cfg.MaxBlockRange)
+	}
+
+	heights, err := idx.candidateHeights(from, to, addresses, topics)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []*ethtypes.Log{}
+	for _, height := range heights {
+		bz, err := idx.DB().Get(blockLogsKey(height))
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) == 0 {
+			continue
+		}
+
+		blockLogs, err := types.UnmarshalLogs(bz)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, log := range blockLogs {
+			if !matchLog(log, addresses, topics) {
+				continue
+			}
+			logs = append(logs, log)
+			if idx.cfg.MaxResults > 0 && len(logs) > idx.cfg.MaxResults {
+				return nil, fmt.Errorf("%w: %d", ErrTooManyResults, idx.cfg.MaxResults)
+			}
+		}
+	}
+
+	return logs, nil
+}
+
+// candidateHeights returns the sorted heights of the blocks which may contain matched logs
+func (idx *Index) candidateHeights(from, to int64, addresses []ethcmn.Address, topics [][]ethcmn.Hash,
+) ([]int64, error) {
+	var candidates map[int64]struct{}
+	intersect := func(set map[int64]struct{}) {
+		if candidates == nil {
+			candidates = set
+			return
+		}
+		for height := range candidates {
+			if _, ok := set[height]; !ok {
+				delete(candidates, height)
+			}
+		}
+	}
+
+	if len(addresses) != 0 {
+		set := make(map[int64]struct{})
+		for _, addr := range addresses {
+			if err := idx.collectHeights(addressPrefix(addr), from, to, set); err != nil {
+				return nil, err
+			}
+		}
+		intersect(set)
+	}
+
+	for pos, sub := range topics {
+		// empty rule set == wildcard
+		if len(sub) == 0 {
+			continue
+		}
+		set := make(map[int64]struct{})
+		for _, topic := range sub {
+			if err := idx.collectHeights(topicPrefix(pos, topic), from, to, set); err != nil {
+				return nil, err
+			}
+		}
+		intersect(set)
+	}
+
+	if candidates == nil {
+		// no criteria, every block with logs is a candidate
+		candidates = make(map[int64]struct{})
+		if err := idx.collectHeights(prefixBlockLogs, from, to, candidates); err != nil {
+			return nil, err
+		}
+	}
+
+	heights := make([]int64, 0, len(candidates))
+	for height := range candidates {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// collectHeights adds the heights within [from, to] of the keys under the prefix into the set
+func (idx *Index) collectHeights(prefix []byte, from, to int64, set map[int64]struct{}) error {
+	start := append(append([]byte{}, prefix...), nodestore.Int64ToBytes(from)...)
+	end := append(append([]byte{}, prefix...), nodestore.Int64ToBytes(to+1)...)
+	iter, err := idx.DB().Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		set[nodestore.BytesToInt64(key[len(key)-8:])] = struct{}{}
+	}
+
+	return nil
+}
+
+// matchLog checks the address and the positional topics of the log the same way as eth_getLogs
+func matchLog(log *ethtypes.Log, addresses []ethcmn.Address, topics [][]ethcmn.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, addr := range addresses {
+			if addr == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	// If the to filtered topics is greater than the amount of topics in logs, skip.
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, sub := range topics {
+		match := len(sub) == 0 // empty rule set == wildcard
+		for _, topic := range sub {
+			if log.Topics[i] == topic {
+				match = true
+				break
+			}
+		}
+		if !match {
+			return false
+		}
+	}
+
+	return true
+}
+
+func blockLogsKey(height int64) []byte {
+	return append(append([]byte{}, prefixBlockLogs...), nodestore.Int64ToBytes(height)...)
+}
+
+func addressPrefix(addr ethcmn.Address) []byte {
+	return append(append([]byte{}, prefixAddress...), addr.Bytes()...)
+}
+
+func addressKey(addr ethcmn.Address, height int64) []byte {
+	return append(addressPrefix(addr), nodestore.Int64ToBytes(height)...)
+}
+
+func topicPrefix(pos int, topic ethcmn.Hash) []byte {
+	return append(append(append([]byte{}, prefixTopic...), byte(pos)), topic.Bytes()...)
+}
+
+func topicKey(pos int, topic ethcmn.Hash, height int64) []byte {
+	return append(topicPrefix(pos, topic), nodestore.Int64ToBytes(height)...)
+}
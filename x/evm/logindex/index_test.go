@@ -0,0 +1,149 @@
+package logindex
+
+import (
+	"errors"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	addr1  = ethcmn.BytesToAddress([]byte("address1"))
+	addr2  = ethcmn.BytesToAddress([]byte("address2"))
+	topic1 = ethcmn.BytesToHash([]byte("topic1"))
+	topic2 = ethcmn.BytesToHash([]byte("topic2"))
+	topic3 = ethcmn.BytesToHash([]byte("topic3"))
+)
+
+func newLog(height int64, addr ethcmn.Address, topics ...ethcmn.Hash) *ethtypes.Log {
+	return &ethtypes.Log{
+		Address:     addr,
+		Topics:      topics,
+		Data:        []byte("data"),
+		BlockNumber: uint64(height),
+		TxHash:      ethcmn.BytesToHash([]byte("tx_hash")),
+		BlockHash:   ethcmn.BytesToHash([]byte("block_hash")),
+	}
+}
+
+func newTestIndex(t *testing.T, cfg Config) *Index {
+	idx := NewIndex(dbm.NewMemDB(), cfg)
+
+	require.NoError(t, idx.IndexBlock(1, []*ethtypes.Log{newLog(1, addr1, topic1, topic2)}))
+	require.NoError(t, idx.IndexBlock(2, nil))
+	require.NoError(t, idx.IndexBlock(3, []*ethtypes.Log{newLog(3, addr2, topic2), newLog(3, addr1, topic3)}))
+	require.NoError(t, idx.IndexBlock(4, []*ethtypes.Log{newLog(4, addr2, topic1, topic3)}))
+	return idx
+}
+
+func TestIndexLogs(t *testing.T) {
+	idx := newTestIndex(t, DefaultConfig())
+
+	testCases := []struct {
+		name       string
+		from, to   int64
+		addresses  []ethcmn.Address
+		topics     [][]ethcmn.Hash
+		expHeights []uint64
+	}{
+		{"all logs", 1, 4, nil, nil, []uint64{1, 3, 3, 4}},
+		{"sub range", 2, 3, nil, nil, []uint64{3, 3}},
+		{"empty block", 2, 2, nil, nil, []uint64{}},
+		{"reversed range", 4, 1, nil, nil, []uint64{}},
+		{"address", 1, 4, []ethcmn.Address{addr1}, nil, []uint64{1, 3}},
+		{"addresses", 1, 4, []ethcmn.Address{addr1, addr2}, nil, []uint64{1, 3, 3, 4}},
+		{"first topic", 1, 4, nil, [][]ethcmn.Hash{{topic1}}, []uint64{1, 4}},
+		{"second topic", 1, 4, nil, [][]ethcmn.Hash{{}, {topic3}}, []uint64{4}},
+		{"topic alternatives", 1, 4, nil, [][]ethcmn.Hash{{topic2, topic3}}, []uint64{3, 3}},
+		{"address and topic", 1, 4, []ethcmn.Address{addr2}, [][]ethcmn.Hash{{topic1}}, []uint64{4}},
+		{"not matched", 1, 3, []ethcmn.Address{addr2}, [][]ethcmn.Hash{{topic1}}, []uint64{}},
+		{"more topics than logs", 1, 4, nil, [][]ethcmn.Hash{{topic2}, {topic3}}, []uint64{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logs, err := idx.Logs(tc.from, tc.to, tc.addresses, tc.topics)
+			require.NoError(t, err)
+
+			heights := make([]uint64, len(logs))
+			for i, log := range logs {
+				heights[i] = log.BlockNumber
+			}
+			require.Equal(t, tc.expHeights, heights)
+		})
+	}
+}
+
+func TestIndexLimits(t *testing.T) {
+	idx := newTestIndex(t, Config{Enable: true, MaxBlockRange: 3, MaxResults: 2})
+
+	_, err := idx.Logs(1, 4, nil, nil)
+	require.True(t, errors.Is(err, ErrBlockRangeTooLarge))
+
+	_, err = idx.Logs(1, 3, nil, nil)
+	require.True(t, errors.Is(err, ErrTooManyResults))
+
+	logs, err := idx.Logs(2, 4, []ethcmn.Address{addr2}, nil)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+}
+
+func TestIndexCoverage(t *testing.T) {
+	idx := NewIndex(dbm.NewMemDB(), DefaultConfig())
+	require.False(t, idx.Covers(1, 1))
+
+	// logs of a block are flushed on commit
+	idx.AddTxLogs(5, []*ethtypes.Log{newLog(5, addr1, topic1)})
+	idx.EndTx(5, true)
+	idx.AddTxLogs(5, []*ethtypes.Log{newLog(5, addr2, topic2)})
+	idx.EndTx(5, true)
+	require.NoError(t, idx.Commit(5))
+	require.Equal(t, int64(5), idx.BaseHeight())
+	require.Equal(t, int64(5), idx.LatestHeight())
+
+	// logs left by a block which was never committed are dropped
+	idx.AddTxLogs(6, []*ethtypes.Log{newLog(6, addr1, topic1)})
+	idx.EndTx(6, true)
+	idx.AddTxLogs(7, []*ethtypes.Log{newLog(7, addr1, topic1)})
+	idx.EndTx(7, true)
+	require.NoError(t, idx.Commit(7))
+	require.NoError(t, idx.Commit(8))
+
+	require.True(t, idx.Covers(5, 8))
+	require.False(t, idx.Covers(4, 8))
+	require.False(t, idx.Covers(5, 9))
+
+	logs, err := idx.Logs(5, 8, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, logs, 3)
+	require.Equal(t, uint64(7), logs[2].BlockNumber)
+
+	// a rebuild of the earlier blocks moves the base height back
+	require.NoError(t, idx.IndexBlock(4, nil))
+	require.Equal(t, int64(5), idx.BaseHeight())
+	require.NoError(t, idx.SetBaseHeight(4))
+	require.True(t, idx.Covers(4, 8))
+}
+
+func TestIndexFailedTx(t *testing.T) {
+	idx := NewIndex(dbm.NewMemDB(), DefaultConfig())
+
+	// the logs of the earlier messages are dropped if a later message of the transaction fails
+	idx.AddTxLogs(5, []*ethtypes.Log{newLog(5, addr1, topic1)})
+	idx.AddTxLogs(5, []*ethtypes.Log{newLog(5, addr1, topic2)})
+	idx.EndTx(5, false)
+	idx.AddTxLogs(5, []*ethtypes.Log{newLog(5, addr2, topic3)})
+	idx.EndTx(5, true)
+	// the logs of a transaction which was never ended aren't committed
+	idx.AddTxLogs(5, []*ethtypes.Log{newLog(5, addr2, topic1)})
+	require.NoError(t, idx.Commit(5))
+
+	logs, err := idx.Logs(5, 5, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	require.Equal(t, addr2, logs[0].Address)
+	require.Equal(t, []ethcmn.Hash{topic3}, logs[0].Topics)
+}
@@ -0,0 +1,110 @@
+package nodestore
+
+import (
+	"encoding/binary"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// keys of the block range written into the store
+var (
+	keyBaseHeight   = []byte("baseHeight")
+	keyLatestHeight = []byte("latestHeight")
+)
+
+// Store is a node-local database in the data directory which is written block by block, e.g. the evm log index and
+// the evm receipts. It's out of the consensus state, so every node enables or rebuilds it on its own, and it tracks
+// the range of the blocks written to tell whether a query can be served by it
+type Store struct {
+	db dbm.DB
+}
+
+// Open opens the database with the name in the data directory
+func Open(name, dataDir string) (*Store, error) {
+	db, err := dbm.NewGoLevelDB(name, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStore(db), nil
+}
+
+// NewStore creates a new node-local store over the db
+func NewStore(db dbm.DB) *Store {
+	return &Store{
+		db: db,
+	}
+}
+
+// DB returns the underlying database
+func (s *Store) DB() dbm.DB {
+	return s.db
+}
+
+// Close closes the underlying database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// WriteBlock writes the entries of the block set by write and extends the block range to the height in a batch, so
+// a block is either written with the range or not at all
+func (s *Store) WriteBlock(height int64, write func(batch dbm.Batch) error) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := write(batch); err != nil {
+		return err
+	}
+
+	// the range starts from the first block written, a rebuild of the earlier blocks moves it back by SetBaseHeight
+	if s.BaseHeight() == 0 {
+		batch.Set(keyBaseHeight, Int64ToBytes(height))
+	}
+	if height > s.LatestHeight() {
+		batch.Set(keyLatestHeight, Int64ToBytes(height))
+	}
+
+	return batch.WriteSync()
+}
+
+// BaseHeight returns the lowest block height written, or 0 if nothing has been written
+func (s *Store) BaseHeight() int64 {
+	return s.getHeight(keyBaseHeight)
+}
+
+// SetBaseHeight sets the lowest block height from which all the blocks have been written
+func (s *Store) SetBaseHeight(height int64) error {
+	return s.db.SetSync(keyBaseHeight, Int64ToBytes(height))
+}
+
+// LatestHeight returns the highest block height written, or 0 if nothing has been written
+func (s *Store) LatestHeight() int64 {
+	return s.getHeight(keyLatestHeight)
+}
+
+// Covers returns whether all blocks within [from, to] have been written
+func (s *Store) Covers(from, to int64) bool {
+	base := s.BaseHeight()
+	return base != 0 && from >= base && to <= s.LatestHeight()
+}
+
+func (s *Store) getHeight(key []byte) int64 {
+	bz, err := s.db.Get(key)
+	if err != nil || len(bz) != 8 {
+		return 0
+	}
+
+	return BytesToInt64(bz)
+}
+
+// Int64ToBytes encodes the int64 in big endian, so the heights in the keys are iterated in order
+func Int64ToBytes(i int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(i))
+	return bz
+}
+
+// BytesToInt64 decodes the big endian int64
+func BytesToInt64(bz []byte) int64 {
+	return int64(binary.BigEndian.Uint64(bz))
+}
@@ -0,0 +1,43 @@
+package nodestore
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestWriteBlock(t *testing.T) {
+	store := NewStore(dbm.NewMemDB())
+	require.Zero(t, store.BaseHeight())
+	require.False(t, store.Covers(1, 1))
+
+	for height := int64(5); height <= 7; height++ {
+		require.NoError(t, store.WriteBlock(height, func(batch dbm.Batch) error {
+			batch.Set(Int64ToBytes(height), []byte{0x01})
+			return nil
+		}))
+	}
+	require.Equal(t, int64(5), store.BaseHeight())
+	require.Equal(t, int64(7), store.LatestHeight())
+	require.True(t, store.Covers(5, 7))
+	require.False(t, store.Covers(4, 7))
+	require.False(t, store.Covers(5, 8))
+
+	// a block failed to be written leaves neither its entries nor the range
+	require.Error(t, store.WriteBlock(8, func(batch dbm.Batch) error {
+		batch.Set(Int64ToBytes(8), []byte{0x01})
+		return errors.New("failed")
+	}))
+	require.Equal(t, int64(7), store.LatestHeight())
+	bz, err := store.DB().Get(Int64ToBytes(8))
+	require.NoError(t, err)
+	require.Nil(t, bz)
+
+	// rewriting an earlier block doesn't move the range, the rebuild moves the base explicitly
+	require.NoError(t, store.WriteBlock(3, func(dbm.Batch) error { return nil }))
+	require.Equal(t, int64(5), store.BaseHeight())
+	require.NoError(t, store.SetBaseHeight(3))
+	require.True(t, store.Covers(3, 7))
+}
@@ -0,0 +1,47 @@
+package evm
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/evm/types"
+	govTypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// NewManageContractProposalHandler handles the contract deployment whitelist and contract blocked list proposals
+func NewManageContractProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.ManageContractDeploymentWhitelistProposal:
+			return handleManageContractDeploymentWhitelistProposal(ctx, k, content)
+		case types.ManageContractBlockedListProposal:
+			return handleManageContractBlockedListProposal(ctx, k, content)
+		default:
+			return types.ErrUnexpectedProposalType
+		}
+	}
+}
+
+func handleManageContractDeploymentWhitelistProposal(ctx sdk.Context, k *Keeper,
+	p types.ManageContractDeploymentWhitelistProposal) sdk.Error {
+	if p.IsAdded {
+		// add deployer addresses into whitelist
+		k.SetContractDeploymentWhitelist(ctx, p.DeployerAddrs)
+		return nil
+	}
+
+	// remove deployer addresses from whitelist
+	k.DeleteContractDeploymentWhitelist(ctx, p.DeployerAddrs)
+	return nil
+}
+
+func handleManageContractBlockedListProposal(ctx sdk.Context, k *Keeper,
+	p types.ManageContractBlockedListProposal) sdk.Error {
+	if p.IsAdded {
+		// add contract addresses into blocked list
+		k.SetContractBlockedList(ctx, p.ContractAddrs)
+		return nil
+	}
+
+	// remove contract addresses from blocked list
+	k.DeleteContractBlockedList(ctx, p.ContractAddrs)
+	return nil
+}
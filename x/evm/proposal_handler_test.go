@@ -0,0 +1,51 @@
+package evm_test
+
+import (
+	ethcmn "github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/evm"
+	"github.com/okex/okexchain/x/evm/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+func (suite *EvmTestSuite) TestProposalHandler_ManageContractDeploymentWhitelistProposal() {
+	addr1 := sdk.AccAddress(ethcmn.BytesToAddress([]byte{0x01}).Bytes())
+	addr2 := sdk.AccAddress(ethcmn.BytesToAddress([]byte{0x02}).Bytes())
+	handler := evm.NewManageContractProposalHandler(&suite.app.EvmKeeper)
+
+	proposal := govtypes.Proposal{Content: types.NewManageContractDeploymentWhitelistProposal(
+		"default title", "default description", types.AddressList{addr1, addr2}, true,
+	)}
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	whitelist := suite.app.EvmKeeper.GetContractDeploymentWhitelist(suite.ctx)
+	suite.Require().Equal(2, len(whitelist))
+
+	proposal = govtypes.Proposal{Content: types.NewManageContractDeploymentWhitelistProposal(
+		"default title", "default description", types.AddressList{addr1}, false,
+	)}
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	whitelist = suite.app.EvmKeeper.GetContractDeploymentWhitelist(suite.ctx)
+	suite.Require().Equal(1, len(whitelist))
+	suite.Require().Equal(addr2, whitelist[0])
+}
+
+func (suite *EvmTestSuite) TestProposalHandler_ManageContractBlockedListProposal() {
+	addr1 := sdk.AccAddress(ethcmn.BytesToAddress([]byte{0x01}).Bytes())
+	addr2 := sdk.AccAddress(ethcmn.BytesToAddress([]byte{0x02}).Bytes())
+	handler := evm.NewManageContractProposalHandler(&suite.app.EvmKeeper)
+
+	proposal := govtypes.Proposal{Content: types.NewManageContractBlockedListProposal(
+		"default title", "default description", types.AddressList{addr1, addr2}, true,
+	)}
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	blockedList := suite.app.EvmKeeper.GetContractBlockedList(suite.ctx)
+	suite.Require().Equal(2, len(blockedList))
+
+	proposal = govtypes.Proposal{Content: types.NewManageContractBlockedListProposal(
+		"default title", "default description", types.AddressList{addr1, addr2}, false,
+	)}
+	suite.Require().NoError(handler(suite.ctx, &proposal))
+	suite.Require().Zero(len(suite.app.EvmKeeper.GetContractBlockedList(suite.ctx)))
+}
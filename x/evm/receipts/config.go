@@ -0,0 +1,35 @@
+package receipts
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// FlagEnableReceipts is the flag to enable the node-local receipt store
+const FlagEnableReceipts = "evm-receipts"
+
+// Config defines the node-local configuration of the receipt store
+type Config struct {
+	// Enable toggles the persistence of the evm receipts on DeliverTx and their usage by the rpc server
+	Enable bool `json:"enable"`
+}
+
+// DefaultConfig returns the default configuration of the receipt store, which is disabled
+func DefaultConfig() Config {
+	return Config{
+		Enable: false,
+	}
+}
+
+// GetConfig builds the receipt store configuration from the flags bound to viper
+func GetConfig() Config {
+	cfg := DefaultConfig()
+	cfg.Enable = viper.GetBool(FlagEnableReceipts)
+	return cfg
+}
+
+// AddFlags adds the receipt store flags to the command
+func AddFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().Bool(FlagEnableReceipts, false,
+		"Enable the node-local evm receipt store for eth_getTransactionReceipt and eth_getBlockReceipts")
+}
@@ -0,0 +1,305 @@
+package receipts
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math/big"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/x/evm/nodestore"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+// DBName is the name of the node-local database that holds the receipts
+const DBName = "evm_receipts"
+
+// key prefixes of the receipt database
+var (
+	prefixReceipt    = []byte{0x01} // prefix | tx hash -> receipt
+	prefixBlockIndex = []byte{0x02} // prefix | height | tx index -> tx hash
+)
+
+var (
+	globalMtx   sync.RWMutex
+	globalStore *Store
+)
+
+// GetGlobalStore returns the receipt store shared by the app and the in-process rpc server. It returns nil if the
+// receipt store is disabled or the rpc server runs in a standalone process
+func GetGlobalStore() *Store {
+	globalMtx.RLock()
+	defer globalMtx.RUnlock()
+	return globalStore
+}
+
+// OpenGlobalStore opens the receipt database in the data directory and sets it as the global receipt store. The
+// opened store is returned directly on the later calls
+func OpenGlobalStore(dataDir string) (*Store, error) {
+	globalMtx.Lock()
+	defer globalMtx.Unlock()
+
+	if globalStore != nil {
+		return globalStore, nil
+	}
+
+	store, err := nodestore.Open(DBName, dataDir)
+	if err != nil {
+		return nil, err
+	}
+	globalStore = &Store{Store: store}
+	return globalStore, nil
+}
+
+// Receipt is the canonical receipt of an evm transaction, which is persisted at DeliverTx
+type Receipt struct {
+	Type              uint8
+	Status            uint64
+	CumulativeGasUsed uint64
+	GasUsed           uint64
+	Bloom             ethtypes.Bloom
+	Logs              []*ethtypes.Log
+	TxHash            ethcmn.Hash
+	ContractAddress   *ethcmn.Address
+	EffectiveGasPrice *big.Int
+	BlockHash         ethcmn.Hash
+	BlockNumber       uint64
+	TransactionIndex  uint64
+	From              ethcmn.Address
+	To                *ethcmn.Address
+}
+
+// storedReceipt is the db encoding of Receipt. The logs are amino encoded as the logs in the evm store
+type storedReceipt struct {
+	Type              uint8           `json:"type"`
+	Status            uint64          `json:"status"`
+	CumulativeGasUsed uint64          `json:"cumulative_gas_used"`
+	GasUsed           uint64          `json:"gas_used"`
+	Bloom             ethtypes.Bloom  `json:"bloom"`
+	Logs              []byte          `json:"logs"`
+	TxHash            ethcmn.Hash     `json:"tx_hash"`
+	ContractAddress   *ethcmn.Address `json:"contract_address"`
+	EffectiveGasPrice *big.Int        `json:"effective_gas_price"`
+	BlockHash         ethcmn.Hash     `json:"block_hash"`
+	BlockNumber       uint64          `json:"block_number"`
+	TransactionIndex  uint64          `json:"transaction_index"`
+	From              ethcmn.Address  `json:"from"`
+	To                *ethcmn.Address `json:"to"`
+}
+
+func encodeReceipt(receipt *Receipt) ([]byte, error) {
+	logs, err := types.MarshalLogs(receipt.Logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(storedReceipt{
+		Type:              receipt.Type,
+		Status:            receipt.Status,
+		CumulativeGasUsed: receipt.CumulativeGasUsed,
+		GasUsed:           receipt.GasUsed,
+		Bloom:             receipt.Bloom,
+		Logs:              logs,
+		TxHash:            receipt.TxHash,
+		ContractAddress:   receipt.ContractAddress,
+		EffectiveGasPrice: receipt.EffectiveGasPrice,
+		BlockHash:         receipt.BlockHash,
+		BlockNumber:       receipt.BlockNumber,
+		TransactionIndex:  receipt.TransactionIndex,
+		From:              receipt.From,
+		To:                receipt.To,
+	})
+}
+
+func decodeReceipt(bz []byte) (*Receipt, error) {
+	var stored storedReceipt
+	if err := json.Unmarshal(bz, &stored); err != nil {
+		return nil, err
+	}
+
+	logs, err := types.UnmarshalLogs(stored.Logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Receipt{
+		Type:              stored.Type,
+		Status:            stored.Status,
+		CumulativeGasUsed: stored.CumulativeGasUsed,
+		GasUsed:           stored.GasUsed,
+		Bloom:             stored.Bloom,
+		Logs:              logs,
+		TxHash:            stored.TxHash,
+		ContractAddress:   stored.ContractAddress,
+		EffectiveGasPrice: stored.EffectiveGasPrice,
+		BlockHash:         stored.BlockHash,
+		BlockNumber:       stored.BlockNumber,
+		TransactionIndex:  stored.TransactionIndex,
+		From:              stored.From,
+		To:                stored.To,
+	}, nil
+}
+
+// Store persists the receipts of the evm transactions per block
+type Store struct {
+	*nodestore.Store
+
+	mtx sync.Mutex
+	// state of the block being delivered, flushed on Commit
+	pendingHeight     int64
+	txIndex           uint64
+	cumulativeGasUsed uint64
+	pendingReceipts   []*Receipt
+}
+
+// NewStore creates a new receipt store over the db
+func NewStore(db dbm.DB) *Store {
+	return &Store{
+		Store: nodestore.NewStore(db),
+	}
+}
+
+// DeliverTx builds the receipt of a delivered transaction. Every transaction of the block must be passed in order
+// to keep the tx index and the cumulative gas used, while only the receipts of MsgEthereumTx are kept
+func (s *Store) DeliverTx(height int64, blockHash ethcmn.Hash, tx sdk.Tx, txHash ethcmn.Hash,
+	res abci.ResponseDeliverTx) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.pendingHeight != height {
+		// drop the state left by a block which was never committed
+		s.resetPending(height)
+	}
+
+	txIndex := s.txIndex
+	s.txIndex++
+	// NOTE: the gas used of the failed transactions is counted as well, the same as the block gas used
+	s.cumulativeGasUsed += uint64(res.GasUsed)
+
+	ethTx, ok := tx.(types.MsgEthereumTx)
+	if !ok {
+		return
+	}
+
+	receipt := &Receipt{
+		Type:              ethTx.TxType(),
+		CumulativeGasUsed: s.cumulativeGasUsed,
+		GasUsed:           uint64(res.GasUsed),
+		Logs:              []*ethtypes.Log{},
+		TxHash:            txHash,
+		EffectiveGasPrice: ethTx.Data.Price,
+		BlockHash:         blockHash,
+		BlockNumber:       uint64(height),
+		TransactionIndex:  txIndex,
+		To:                ethTx.To(),
+	}
+
+	// the sender can't be recovered from an unprotected transaction, which is rejected by the ante handler anyway
+	if from, err := ethTx.VerifySig(ethTx.ChainID()); err == nil {
+		receipt.From = from
+		if receipt.To == nil {
+			contractAddr := ethcrypto.CreateAddress(from, ethTx.Data.AccountNonce)
+			receipt.ContractAddress = &contractAddr
+		}
+	}
+
+	if res.IsOK() {
+		if data, err := types.DecodeResultData(res.Data); err == nil {
+			receipt.Status = ethtypes.ReceiptStatusSuccessful
+			receipt.Bloom = data.Bloom
+			if len(data.Logs) != 0 {
+				receipt.Logs = data.Logs
+			}
+		}
+	}
+
+	s.pendingReceipts = append(s.pendingReceipts, receipt)
+}
+
+// Commit writes the receipts of the block into the db
+func (s *Store) Commit(height int64) error {
+	s.mtx.Lock()
+	var receipts []*Receipt
+	if s.pendingHeight == height {
+		receipts = s.pendingReceipts
+	}
+	s.resetPending(0)
+	s.mtx.Unlock()
+
+	return s.WriteBlock(height, func(batch dbm.Batch) error {
+		for _, receipt := range receipts {
+			bz, err := encodeReceipt(receipt)
+			if err != nil {
+				return err
+			}
+			batch.Set(receiptKey(receipt.TxHash), bz)
+			batch.Set(blockIndexKey(height, receipt.TransactionIndex), receipt.TxHash.Bytes())
+		}
+		return nil
+	})
+}
+
+// GetReceipt returns the receipt of the transaction, or nil if it's not found
+func (s *Store) GetReceipt(txHash ethcmn.Hash) (*Receipt, error) {
+	bz, err := s.DB().Get(receiptKey(txHash))
+	if err != nil || len(bz) == 0 {
+		return nil, err
+	}
+
+	return decodeReceipt(bz)
+}
+
+// GetBlockReceipts returns the receipts of the evm transactions in the block ordered by the tx index
+func (s *Store) GetBlockReceipts(height int64) ([]*Receipt, error) {
+	prefix := blockIndexPrefix(height)
+	iter, err := dbm.IteratePrefix(s.DB(), prefix)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	receipts := []*Receipt{}
+	for ; iter.Valid(); iter.Next() {
+		receipt, err := s.GetReceipt(ethcmn.BytesToHash(iter.Value()))
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			receipts = append(receipts, receipt)
+		}
+	}
+
+	return receipts, nil
+}
+
+// Covers returns whether the receipts of the block have been stored
+func (s *Store) Covers(height int64) bool {
+	return s.Store.Covers(height, height)
+}
+
+func (s *Store) resetPending(height int64) {
+	s.pendingHeight = height
+	s.txIndex = 0
+	s.cumulativeGasUsed = 0
+	s.pendingReceipts = nil
+}
+
+func receiptKey(txHash ethcmn.Hash) []byte {
+	return append(append([]byte{}, prefixReceipt...), txHash.Bytes()...)
+}
+
+func blockIndexPrefix(height int64) []byte {
+	return append(append([]byte{}, prefixBlockIndex...), nodestore.Int64ToBytes(height)...)
+}
+
+func blockIndexKey(height int64, txIndex uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, txIndex)
+	return append(blockIndexPrefix(height), bz...)
+}
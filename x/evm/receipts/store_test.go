@@ -0,0 +1,98 @@
+package receipts
+
+import (
+	"math/big"
+	"testing"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
+	"github.com/okex/okexchain/x/evm/types"
+)
+
+func newSignedTx(t *testing.T, priv ethsecp256k1.PrivKey, nonce uint64, to *ethcmn.Address) types.MsgEthereumTx {
+	msg := types.NewMsgEthereumTx(nonce, to, big.NewInt(1), 100000, big.NewInt(2), []byte("test"))
+	require.NoError(t, msg.Sign(big.NewInt(3), priv.ToECDSA()))
+	return msg
+}
+
+func TestStore(t *testing.T) {
+	store := NewStore(dbm.NewMemDB())
+	require.False(t, store.Covers(10))
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	sender := ethcrypto.PubkeyToAddress(priv.ToECDSA().PublicKey)
+	recipient := ethcmn.BytesToAddress([]byte("recipient"))
+	blockHash := ethcmn.BytesToHash([]byte("block_hash"))
+
+	logs := []*ethtypes.Log{{Address: recipient, Topics: []ethcmn.Hash{{1}}, Data: []byte("data"), BlockNumber: 10}}
+	resData, err := types.EncodeResultData(types.ResultData{
+		Bloom: ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)),
+		Logs:  logs,
+	})
+	require.NoError(t, err)
+
+	callHash, createHash, failedHash := ethcmn.Hash{1}, ethcmn.Hash{2}, ethcmn.Hash{3}
+
+	// a receipt of the block which was never committed is dropped
+	store.DeliverTx(9, blockHash, newSignedTx(t, priv, 0, &recipient), ethcmn.Hash{9}, abci.ResponseDeliverTx{})
+
+	store.DeliverTx(10, blockHash, nil, ethcmn.Hash{}, abci.ResponseDeliverTx{GasUsed: 100})
+	store.DeliverTx(10, blockHash, newSignedTx(t, priv, 1, &recipient), callHash,
+		abci.ResponseDeliverTx{GasUsed: 200, Data: resData})
+	store.DeliverTx(10, blockHash, newSignedTx(t, priv, 2, nil), createHash,
+		abci.ResponseDeliverTx{GasUsed: 300, Data: resData})
+	store.DeliverTx(10, blockHash, newSignedTx(t, priv, 3, &recipient), failedHash,
+		abci.ResponseDeliverTx{Code: 1, GasUsed: 400})
+	require.NoError(t, store.Commit(10))
+	require.True(t, store.Covers(10))
+	require.False(t, store.Covers(9))
+
+	receipt, err := store.GetReceipt(ethcmn.Hash{9})
+	require.NoError(t, err)
+	require.Nil(t, receipt)
+
+	receipt, err = store.GetReceipt(callHash)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.ReceiptStatusSuccessful, receipt.Status)
+	require.Equal(t, uint64(300), receipt.CumulativeGasUsed)
+	require.Equal(t, uint64(200), receipt.GasUsed)
+	require.Equal(t, uint64(1), receipt.TransactionIndex)
+	require.Equal(t, uint64(10), receipt.BlockNumber)
+	require.Equal(t, blockHash, receipt.BlockHash)
+	require.Equal(t, sender, receipt.From)
+	require.Equal(t, &recipient, receipt.To)
+	require.Nil(t, receipt.ContractAddress)
+	require.Equal(t, big.NewInt(2), receipt.EffectiveGasPrice)
+	require.Equal(t, ethtypes.BytesToBloom(ethtypes.LogsBloom(logs)), receipt.Bloom)
+	require.Len(t, receipt.Logs, 1)
+	require.Equal(t, recipient, receipt.Logs[0].Address)
+
+	receipt, err = store.GetReceipt(createHash)
+	require.NoError(t, err)
+	require.Equal(t, ethcrypto.CreateAddress(sender, 2), *receipt.ContractAddress)
+	require.Equal(t, uint64(600), receipt.CumulativeGasUsed)
+
+	receipt, err = store.GetReceipt(failedHash)
+	require.NoError(t, err)
+	require.Equal(t, ethtypes.ReceiptStatusFailed, receipt.Status)
+	require.Equal(t, uint64(1000), receipt.CumulativeGasUsed)
+	require.Empty(t, receipt.Logs)
+
+	blockReceipts, err := store.GetBlockReceipts(10)
+	require.NoError(t, err)
+	require.Len(t, blockReceipts, 3)
+	for i, hash := range []ethcmn.Hash{callHash, createHash, failedHash} {
+		require.Equal(t, hash, blockReceipts[i].TxHash)
+	}
+
+	blockReceipts, err = store.GetBlockReceipts(11)
+	require.NoError(t, err)
+	require.Empty(t, blockReceipts)
+}
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"errors"
+	"math"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/rlp"
+	"golang.org/x/crypto/sha3"
+)
+
+// transaction types of the EIP-2718 envelope
+const (
+	// LegacyTxType is the type of the transactions before EIP-2718, which are not wrapped by the envelope
+	LegacyTxType = 0x00
+	// AccessListTxType is the type of the EIP-2930 access list transactions
+	AccessListTxType = 0x01
+)
+
+// intrinsic gas of the access list defined in EIP-2930
+const (
+	// AccessListAddressGas is the intrinsic gas per address in the access list
+	AccessListAddressGas uint64 = 2400
+	// AccessListStorageKeyGas is the intrinsic gas per storage key in the access list
+	AccessListStorageKeyGas uint64 = 1900
+)
+
+// AccessTuple is the element type of an access list
+type AccessTuple struct {
+	Address     ethcmn.Address `json:"address" yaml:"address"`
+	StorageKeys []ethcmn.Hash  `json:"storageKeys" yaml:"storageKeys"`
+}
+
+// AccessList is an EIP-2930 access list
+type AccessList []AccessTuple
+
+// StorageKeys returns the total number of storage keys in the access list
+func (al AccessList) StorageKeys() int {
+	sum := 0
+	for _, tuple := range al {
+		sum += len(tuple.StorageKeys)
+	}
+	return sum
+}
+
+// Gas returns the intrinsic gas of the access list
+func (al AccessList) Gas() (uint64, error) {
+	if len(al) == 0 {
+		return 0, nil
+	}
+
+	if uint64(len(al)) > math.MaxUint64/AccessListAddressGas {
+		return 0, core.ErrGasUintOverflow
+	}
+	gas := uint64(len(al)) * AccessListAddressGas
+
+	keys := uint64(al.StorageKeys())
+	if keys > (math.MaxUint64-gas)/AccessListStorageKeyGas {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + keys*AccessListStorageKeyGas, nil
+}
+
+// IntrinsicGas computes the intrinsic gas of a transaction with the given data and access list
+func IntrinsicGas(data []byte, accessList AccessList, contractCreation, isHomestead, isEIP2028 bool) (uint64, error) {
+	gas, err := core.IntrinsicGas(data, contractCreation, isHomestead, isEIP2028)
+	if err != nil {
+		return 0, err
+	}
+
+	accessListGas, err := accessList.Gas()
+	if err != nil {
+		return 0, err
+	}
+	if gas > math.MaxUint64-accessListGas {
+		return 0, core.ErrGasUintOverflow
+	}
+	return gas + accessListGas, nil
+}
+
+// accessListTxRLP is the RLP payload of the EIP-2930 transaction within the envelope
+type accessListTxRLP struct {
+	ChainID      *big.Int
+	AccountNonce uint64
+	Price        *big.Int
+	GasLimit     uint64
+	Recipient    *ethcmn.Address `rlp:"nil"`
+	Amount       *big.Int
+	Payload      []byte
+	Accesses     AccessList
+	V            *big.Int
+	R            *big.Int
+	S            *big.Int
+}
+
+// encodeTyped returns the EIP-2718 envelope of the typed transaction: type || rlp(payload)
+func (td TxData) encodeTyped() ([]byte, error) {
+	switch td.Type {
+	case AccessListTxType:
+		bz, err := rlp.EncodeToBytes(accessListTxRLP{
+			ChainID:      td.ChainID,
+			AccountNonce: td.AccountNonce,
+			Price:        td.Price,
+			GasLimit:     td.GasLimit,
+			Recipient:    td.Recipient,
+			Amount:       td.Amount,
+			Payload:      td.Payload,
+			Accesses:     td.Accesses,
+			V:            td.V,
+			R:            td.R,
+			S:            td.S,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]byte{td.Type}, bz...), nil
+	default:
+		return nil, ErrTxTypeNotSupported
+	}
+}
+
+// decodeTyped decodes the EIP-2718 envelope of a typed transaction
+func (td *TxData) decodeTyped(bz []byte) error {
+	if len(bz) <= 1 {
+		return errors.New("typed transaction too short")
+	}
+
+	switch bz[0] {
+	case AccessListTxType:
+		var payload accessListTxRLP
+		if err := rlp.DecodeBytes(bz[1:], &payload); err != nil {
+			return err
+		}
+
+		*td = TxData{
+			AccountNonce: payload.AccountNonce,
+			Price:        payload.Price,
+			GasLimit:     payload.GasLimit,
+			Recipient:    payload.Recipient,
+			Amount:       payload.Amount,
+			Payload:      payload.Payload,
+			V:            payload.V,
+			R:            payload.R,
+			S:            payload.S,
+			Type:         AccessListTxType,
+			ChainID:      payload.ChainID,
+			Accesses:     payload.Accesses,
+		}
+		return nil
+	default:
+		return ErrTxTypeNotSupported
+	}
+}
+
+// prefixedRlpHash returns the hash of the type byte followed by the RLP encoding of x
+func prefixedRlpHash(prefix byte, x interface{}) (hash ethcmn.Hash) {
+	hasher := sha3.NewLegacyKeccak256()
+	_, _ = hasher.Write([]byte{prefix})
+	_ = rlp.Encode(hasher, x)
+	_ = hasher.Sum(hash[:0])
+
+	return hash
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.StateDB = (*blockedListStateDB)(nil)
+
+// blockedListStateDB checks the contract of every call frame against the contract blocked list. The evm looks up the
+// code of the contract called by every call opcode through GetCode, so the check costs nothing on the other opcodes.
+// The lookup can't fail, so the first blocked contract looked up is recorded with no code returned and the evm is
+// cancelled, then the state transition fails with it. Copying the code of a blocked contract by EXTCODECOPY fails the
+// same way
+type blockedListStateDB struct {
+	*CommitStateDB
+	evm     *vm.EVM
+	checked map[common.Address]bool
+	blocked *common.Address
+}
+
+func newBlockedListStateDB(csdb *CommitStateDB) *blockedListStateDB {
+	return &blockedListStateDB{
+		CommitStateDB: csdb,
+		checked:       make(map[common.Address]bool),
+	}
+}
+
+// err returns the error of the blocked contract called, it's nil if no blocked contract is called
+func (s *blockedListStateDB) err() error {
+	if s.blocked == nil {
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrContractBlockedVerify, "contract %s is in the blocked list", sdk.AccAddress(s.blocked.Bytes()))
+}
+
+// GetCode implements vm.StateDB, it returns no code of a blocked contract and cancels the evm
+func (s *blockedListStateDB) GetCode(addr common.Address) []byte {
+	if s.blocked != nil {
+		return nil
+	}
+
+	blocked, ok := s.checked[addr]
+	if !ok {
+		blocked = s.IsContractInBlockedList(addr.Bytes())
+		s.checked[addr] = blocked
+	}
+	if blocked {
+		s.blocked = &addr
+		if s.evm != nil {
+			s.evm.Cancel()
+		}
+		return nil
+	}
+
+	return s.CommitStateDB.GetCode(addr)
+}
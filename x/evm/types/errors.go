@@ -21,4 +21,22 @@ var (
 
 	// ErrCallDisabled returns an error if the EnableCall parameter is false.
 	ErrCallDisabled = sdkerrors.Register(ModuleName, 6, "EVM Call operation is disabled")
+
+	// ErrUnauthorizedAccount returns an error if the deployer is not in the contract deployment whitelist
+	ErrUnauthorizedAccount = sdkerrors.Register(ModuleName, 7, "failed. unauthorized account")
+
+	// ErrContractBlockedVerify returns an error if the called contract is in the contract blocked list
+	ErrContractBlockedVerify = sdkerrors.Register(ModuleName, 8, "failed. the contract is in the blocked list")
+
+	// ErrUnexpectedProposalType returns an error when the proposal type is not supported in evm module
+	ErrUnexpectedProposalType = sdkerrors.Register(ModuleName, 9, "unexpected proposal type")
+
+	// ErrEmptyAddressList returns an error if the address list in the proposal is empty
+	ErrEmptyAddressList = sdkerrors.Register(ModuleName, 10, "failed. empty address list is not allowed")
+
+	// ErrDuplicatedAddr returns an error if the address list in the proposal contains duplicated addresses
+	ErrDuplicatedAddr = sdkerrors.Register(ModuleName, 11, "failed. duplicated address in the address list")
+
+	// ErrTxTypeNotSupported returns an error if the type of the EIP-2718 transaction envelope is not supported
+	ErrTxTypeNotSupported = sdkerrors.Register(ModuleName, 12, "transaction type not supported")
 )
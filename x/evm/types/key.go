@@ -28,6 +28,9 @@ var (
 	KeyPrefixStorage     = []byte{0x05}
 	KeyPrefixChainConfig = []byte{0x06}
 	KeyPrefixHeightHash  = []byte{0x07}
+
+	KeyPrefixContractDeploymentWhitelist = []byte{0x08}
+	KeyPrefixContractBlockedList         = []byte{0x09}
 )
 
 // HeightHashKey returns the key for the given chain epoch and height.
@@ -48,3 +51,23 @@ func BloomKey(height int64) []byte {
 func AddressStoragePrefix(address ethcmn.Address) []byte {
 	return append(KeyPrefixStorage, address.Bytes()...)
 }
+
+// GetContractDeploymentWhitelistMemberKey builds the key for the approved deployer in the contract deployment whitelist
+func GetContractDeploymentWhitelistMemberKey(deployerAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixContractDeploymentWhitelist, deployerAddr...)
+}
+
+// SplitContractDeploymentWhitelistMemberKey splits the key and returns the deployer address
+func SplitContractDeploymentWhitelistMemberKey(key []byte) sdk.AccAddress {
+	return key[1:]
+}
+
+// GetContractBlockedListMemberKey builds the key for the blocked contract address
+func GetContractBlockedListMemberKey(contractAddr sdk.AccAddress) []byte {
+	return append(KeyPrefixContractBlockedList, contractAddr...)
+}
+
+// SplitContractBlockedListMemberKey splits the key and returns the contract address
+func SplitContractBlockedListMemberKey(key []byte) sdk.AccAddress {
+	return key[1:]
+}
@@ -25,7 +25,10 @@ var (
 	_ sdk.Tx  = MsgEthereumTx{}
 )
 
-var big8 = big.NewInt(8)
+var (
+	big8  = big.NewInt(8)
+	big27 = big.NewInt(27)
+)
 
 // message type and route constants
 const (
@@ -46,6 +49,9 @@ type MsgEthermint struct {
 
 	// From address (formerly derived from signature)
 	From sdk.AccAddress `json:"from"`
+
+	// EIP-2930 access list, which is empty for a message without one
+	Accesses AccessList `json:"accessList,omitempty"`
 }
 
 // NewMsgEthermint returns a reference to a new Ethermint transaction
@@ -64,6 +70,16 @@ func NewMsgEthermint(
 	}
 }
 
+// NewMsgEthermintAccessList returns a reference to a new Ethermint transaction with the EIP-2930 access list
+func NewMsgEthermintAccessList(
+	nonce uint64, to *sdk.AccAddress, amount sdk.Int,
+	gasLimit uint64, gasPrice sdk.Int, payload []byte, from sdk.AccAddress, accessList AccessList,
+) MsgEthermint {
+	msg := NewMsgEthermint(nonce, to, amount, gasLimit, gasPrice, payload, from)
+	msg.Accesses = accessList
+	return msg
+}
+
 func (msg MsgEthermint) String() string {
 	return fmt.Sprintf("nonce=%d gasPrice=%d gasLimit=%d recipient=%s amount=%d data=0x%x from=%s",
 		msg.AccountNonce, msg.Price, msg.GasLimit, msg.Recipient, msg.Amount, msg.Payload, msg.From)
@@ -176,6 +192,22 @@ func newMsgEthereumTx(
 	return MsgEthereumTx{Data: txData}
 }
 
+// NewMsgEthereumAccessListTx returns a reference to a new EIP-2930 access list transaction message. The recipient
+// is nil for contract creation.
+func NewMsgEthereumAccessListTx(
+	chainID *big.Int, nonce uint64, to *ethcmn.Address, amount *big.Int,
+	gasLimit uint64, gasPrice *big.Int, payload []byte, accessList AccessList,
+) MsgEthereumTx {
+	msg := newMsgEthereumTx(nonce, to, amount, gasLimit, gasPrice, payload)
+	msg.Data.Type = AccessListTxType
+	msg.Data.ChainID = new(big.Int)
+	if chainID != nil {
+		msg.Data.ChainID.Set(chainID)
+	}
+	msg.Data.Accesses = accessList
+	return msg
+}
+
 func (msg MsgEthereumTx) String() string {
 	return msg.Data.String()
 }
@@ -202,6 +234,19 @@ func (msg MsgEthereumTx) ValidateBasic() error {
 		return sdkerrors.Wrapf(types.ErrInvalidValue, "amount cannot be negative %s", msg.Data.Amount)
 	}
 
+	switch msg.Data.Type {
+	case LegacyTxType:
+		if len(msg.Data.Accesses) != 0 {
+			return sdkerrors.Wrap(ErrTxTypeNotSupported, "access list is not allowed in a legacy transaction")
+		}
+	case AccessListTxType:
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Sign() <= 0 {
+			return sdkerrors.Wrapf(types.ErrInvalidChainID, "chain id of the access list transaction must be positive")
+		}
+	default:
+		return sdkerrors.Wrapf(ErrTxTypeNotSupported, "type %d", msg.Data.Type)
+	}
+
 	return nil
 }
 
@@ -211,6 +256,16 @@ func (msg MsgEthereumTx) To() *ethcmn.Address {
 	return msg.Data.Recipient
 }
 
+// TxType returns the EIP-2718 type of the transaction
+func (msg MsgEthereumTx) TxType() uint8 {
+	return msg.Data.Type
+}
+
+// AccessList returns the EIP-2930 access list of the transaction, which is always empty for a legacy transaction
+func (msg MsgEthereumTx) AccessList() AccessList {
+	return msg.Data.Accesses
+}
+
 // GetMsgs returns a single MsgEthereumTx as an sdk.Msg.
 func (msg MsgEthereumTx) GetMsgs() []sdk.Msg {
 	return []sdk.Msg{msg}
@@ -238,8 +293,21 @@ func (msg MsgEthereumTx) GetSignBytes() []byte {
 }
 
 // RLPSignBytes returns the RLP hash of an Ethereum transaction message with a
-// given chainID used for signing.
+// given chainID used for signing. The hash of a typed transaction is prefixed with its type as defined in EIP-2718.
 func (msg MsgEthereumTx) RLPSignBytes(chainID *big.Int) ethcmn.Hash {
+	if msg.Data.Type == AccessListTxType {
+		return prefixedRlpHash(msg.Data.Type, []interface{}{
+			chainID,
+			msg.Data.AccountNonce,
+			msg.Data.Price,
+			msg.Data.GasLimit,
+			msg.Data.Recipient,
+			msg.Data.Amount,
+			msg.Data.Payload,
+			msg.Data.Accesses,
+		})
+	}
+
 	return rlpHash([]interface{}{
 		msg.Data.AccountNonce,
 		msg.Data.Price,
@@ -251,24 +319,78 @@ func (msg MsgEthereumTx) RLPSignBytes(chainID *big.Int) ethcmn.Hash {
 	})
 }
 
-// EncodeRLP implements the rlp.Encoder interface.
+// EncodeRLP implements the rlp.Encoder interface. A typed transaction is encoded as an RLP string of its
+// EIP-2718 envelope.
 func (msg *MsgEthereumTx) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, &msg.Data)
+	if msg.Data.Type == LegacyTxType {
+		return rlp.Encode(w, &msg.Data)
+	}
+
+	bz, err := msg.Data.encodeTyped()
+	if err != nil {
+		return err
+	}
+	return rlp.Encode(w, bz)
 }
 
 // DecodeRLP implements the rlp.Decoder interface.
 func (msg *MsgEthereumTx) DecodeRLP(s *rlp.Stream) error {
-	_, size, err := s.Kind()
+	kind, size, err := s.Kind()
 	if err != nil {
 		// return error if stream is too large
 		return err
 	}
 
-	if err := s.Decode(&msg.Data); err != nil {
-		return err
+	switch kind {
+	case rlp.List:
+		// legacy transaction
+		var data TxData
+		if err := s.Decode(&data); err != nil {
+			return err
+		}
+		msg.Data = data
+		msg.size.Store(ethcmn.StorageSize(rlp.ListSize(size)))
+	case rlp.String:
+		// typed transaction wrapped by an RLP string
+		bz, err := s.Bytes()
+		if err != nil {
+			return err
+		}
+		if err := msg.Data.decodeTyped(bz); err != nil {
+			return err
+		}
+		msg.size.Store(ethcmn.StorageSize(len(bz)))
+	default:
+		return rlp.ErrExpectedList
+	}
+
+	return nil
+}
+
+// MarshalBinary returns the canonical encoding of the transaction, which is the RLP list for a legacy
+// transaction and the EIP-2718 envelope for a typed transaction.
+func (msg *MsgEthereumTx) MarshalBinary() ([]byte, error) {
+	if msg.Data.Type == LegacyTxType {
+		return rlp.EncodeToBytes(&msg.Data)
+	}
+
+	return msg.Data.encodeTyped()
+}
+
+// UnmarshalBinary decodes the canonical encoding of the transaction, e.g. the raw transaction bytes of
+// eth_sendRawTransaction.
+func (msg *MsgEthereumTx) UnmarshalBinary(bz []byte) error {
+	if len(bz) > 0 && bz[0] > 0x7f {
+		// the first byte of a legacy transaction is the RLP list prefix
+		return rlp.DecodeBytes(bz, msg)
 	}
 
-	msg.size.Store(ethcmn.StorageSize(rlp.ListSize(size)))
+	var data TxData
+	if err := data.decodeTyped(bz); err != nil {
+		return err
+	}
+	msg.Data = data
+	msg.size.Store(ethcmn.StorageSize(len(bz)))
 	return nil
 }
 
@@ -293,7 +415,11 @@ func (msg *MsgEthereumTx) Sign(chainID *big.Int, priv *ecdsa.PrivateKey) error {
 
 	var v *big.Int
 
-	if chainID.Sign() == 0 {
+	if msg.Data.Type != LegacyTxType {
+		// the V of a typed transaction is the y parity of the signature
+		v = big.NewInt(int64(sig[64]))
+		msg.Data.ChainID = new(big.Int).Set(chainID)
+	} else if chainID.Sign() == 0 {
 		v = new(big.Int).SetBytes([]byte{sig[64] + 27})
 	} else {
 		v = big.NewInt(int64(sig[64] + 35))
@@ -327,9 +453,20 @@ func (msg *MsgEthereumTx) VerifySig(chainID *big.Int) (ethcmn.Address, error) {
 		return ethcmn.Address{}, errors.New("chainID cannot be zero")
 	}
 
-	chainIDMul := new(big.Int).Mul(chainID, big.NewInt(2))
-	V := new(big.Int).Sub(msg.Data.V, chainIDMul)
-	V.Sub(V, big8)
+	var V *big.Int
+	switch msg.Data.Type {
+	case LegacyTxType:
+		chainIDMul := new(big.Int).Mul(chainID, big.NewInt(2))
+		V = new(big.Int).Sub(msg.Data.V, chainIDMul)
+		V.Sub(V, big8)
+	case AccessListTxType:
+		if msg.Data.ChainID == nil || msg.Data.ChainID.Cmp(chainID) != 0 {
+			return ethcmn.Address{}, fmt.Errorf("invalid chain id for signer: have %s want %s", msg.Data.ChainID, chainID)
+		}
+		V = new(big.Int).Add(msg.Data.V, big27)
+	default:
+		return ethcmn.Address{}, ErrTxTypeNotSupported
+	}
 
 	sigHash := msg.RLPSignBytes(chainID)
 	sender, err := recoverEthSig(msg.Data.R, msg.Data.S, V, sigHash)
@@ -353,6 +490,13 @@ func (msg MsgEthereumTx) Fee() *big.Int {
 
 // ChainID returns which chain id this transaction was signed for (if at all)
 func (msg *MsgEthereumTx) ChainID() *big.Int {
+	if msg.Data.Type != LegacyTxType {
+		if msg.Data.ChainID == nil {
+			return new(big.Int)
+		}
+		return new(big.Int).Set(msg.Data.ChainID)
+	}
+
 	return deriveChainID(msg.Data.V)
 }
 
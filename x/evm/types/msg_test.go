@@ -188,6 +188,128 @@ func TestMsgEthereumTxSig(t *testing.T) {
 	require.Equal(t, ethcmn.Address{}, signer)
 }
 
+func TestMsgEthereumAccessListTx(t *testing.T) {
+	chainID := big.NewInt(3)
+	priv, _ := ethsecp256k1.GenerateKey()
+	addr := ethcmn.BytesToAddress(priv.PubKey().Address().Bytes())
+	accessList := AccessList{
+		{Address: addr, StorageKeys: []ethcmn.Hash{ethcmn.BytesToHash([]byte("key1")), ethcmn.BytesToHash([]byte("key2"))}},
+		{Address: ethcmn.BytesToAddress([]byte("test_address")), StorageKeys: []ethcmn.Hash{}},
+	}
+
+	msg := NewMsgEthereumAccessListTx(chainID, 1, &addr, big.NewInt(10), 100000, big.NewInt(1), []byte("test"), accessList)
+	require.Equal(t, uint8(AccessListTxType), msg.TxType())
+	require.Equal(t, accessList, msg.AccessList())
+	require.NoError(t, msg.ValidateBasic())
+	require.NotEqual(t, NewMsgEthereumTx(1, &addr, big.NewInt(10), 100000, big.NewInt(1), []byte("test")).RLPSignBytes(chainID),
+		msg.RLPSignBytes(chainID))
+
+	// sign and verify with the chain id of the transaction
+	require.NoError(t, msg.Sign(chainID, priv.ToECDSA()))
+	require.True(t, msg.Data.V.Cmp(big.NewInt(1)) <= 0)
+	require.Equal(t, chainID, msg.ChainID())
+	signer, err := msg.VerifySig(chainID)
+	require.NoError(t, err)
+	require.Equal(t, addr, signer)
+
+	// the canonical encoding is the EIP-2718 envelope
+	raw, err := msg.MarshalBinary()
+	require.NoError(t, err)
+	require.Equal(t, byte(AccessListTxType), raw[0])
+
+	var decoded MsgEthereumTx
+	require.NoError(t, decoded.UnmarshalBinary(raw))
+	require.Equal(t, msg.Data, decoded.Data)
+	signer, err = decoded.VerifySig(chainID)
+	require.NoError(t, err)
+	require.Equal(t, addr, signer)
+
+	// the envelope is wrapped by an RLP string in the RLP encoding
+	bz, err := rlp.EncodeToBytes(&msg)
+	require.NoError(t, err)
+	decoded = MsgEthereumTx{}
+	require.NoError(t, rlp.DecodeBytes(bz, &decoded))
+	require.Equal(t, msg.Data, decoded.Data)
+
+	// amino
+	cdc := codec.New()
+	RegisterCodec(cdc)
+	bz, err = cdc.MarshalBinaryBare(msg)
+	require.NoError(t, err)
+	decoded = MsgEthereumTx{}
+	require.NoError(t, cdc.UnmarshalBinaryBare(bz, &decoded))
+	require.Equal(t, msg.Data, decoded.Data)
+
+	// require a different chain id fail verification
+	decoded = MsgEthereumTx{}
+	require.NoError(t, decoded.UnmarshalBinary(raw))
+	_, err = decoded.VerifySig(big.NewInt(4))
+	require.Error(t, err)
+}
+
+func TestMsgEthereumTxTypeValidation(t *testing.T) {
+	addr := ethcmn.BytesToAddress([]byte("test_address"))
+	accessList := AccessList{{Address: addr}}
+
+	msg := NewMsgEthereumTx(0, &addr, nil, 100000, big.NewInt(1), nil)
+	msg.Data.Accesses = accessList
+	require.Error(t, msg.ValidateBasic())
+
+	msg = NewMsgEthereumAccessListTx(nil, 0, &addr, nil, 100000, big.NewInt(1), nil, accessList)
+	require.Error(t, msg.ValidateBasic())
+
+	msg = NewMsgEthereumTx(0, &addr, nil, 100000, big.NewInt(1), nil)
+	msg.Data.Type = 0x02
+	require.Error(t, msg.ValidateBasic())
+
+	var decoded MsgEthereumTx
+	require.Error(t, decoded.UnmarshalBinary([]byte{0x02, 0xc0}))
+	require.Error(t, decoded.UnmarshalBinary([]byte{AccessListTxType}))
+}
+
+func TestLegacyTxAminoEncoding(t *testing.T) {
+	// the amino encoding of a legacy transaction must not be changed by the typed transaction fields
+	addr := ethcmn.BytesToAddress([]byte("test_address"))
+	msg := NewMsgEthereumTx(0, &addr, big.NewInt(1), 100000, big.NewInt(1), []byte("test"))
+
+	bz, err := msg.Data.MarshalAmino()
+	require.NoError(t, err)
+	legacyBz, err := ModuleCdc.MarshalBinaryBare(struct {
+		AccountNonce uint64
+		Price        string
+		GasLimit     uint64
+		Recipient    *ethcmn.Address
+		Amount       string
+		Payload      []byte
+		V            string
+		R            string
+		S            string
+		Hash         *ethcmn.Hash
+	}{0, "1", 100000, &addr, "1", []byte("test"), "0", "0", "0", nil})
+	require.NoError(t, err)
+	require.Equal(t, legacyBz, bz)
+
+	var data TxData
+	require.NoError(t, data.UnmarshalAmino(bz))
+	require.Nil(t, data.ChainID)
+	require.Equal(t, uint8(LegacyTxType), data.Type)
+}
+
+func TestIntrinsicGas(t *testing.T) {
+	accessList := AccessList{
+		{Address: ethcmn.BytesToAddress([]byte("addr1")), StorageKeys: []ethcmn.Hash{{}, {1}}},
+		{Address: ethcmn.BytesToAddress([]byte("addr2"))},
+	}
+
+	gas, err := IntrinsicGas(nil, nil, false, true, false)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), gas)
+
+	gas, err = IntrinsicGas(nil, accessList, false, true, false)
+	require.NoError(t, err)
+	require.Equal(t, 21000+2*AccessListAddressGas+2*AccessListStorageKeyGas, gas)
+}
+
 func TestMarshalAndUnmarshalLogs(t *testing.T) {
 	var cdc = codec.New()
 
@@ -23,6 +23,9 @@ var (
 	ParamStoreKeyEnableCreate = []byte("EnableCreate")
 	ParamStoreKeyEnableCall   = []byte("EnableCall")
 	ParamStoreKeyExtraEIPs    = []byte("EnableExtraEIPs")
+
+	ParamStoreKeyContractDeploymentWhitelist = []byte("EnableContractDeploymentWhitelist")
+	ParamStoreKeyContractBlockedList         = []byte("EnableContractBlockedList")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -41,25 +44,34 @@ type Params struct {
 	EnableCall bool `json:"enable_call" yaml:"enable_call"`
 	// ExtraEIPs defines the additional EIPs for the vm.Config
 	ExtraEIPs []int `json:"extra_eips" yaml:"extra_eips"`
+	// EnableContractDeploymentWhitelist restricts contract creation to the deployers in the whitelist
+	EnableContractDeploymentWhitelist bool `json:"enable_contract_deployment_whitelist" yaml:"enable_contract_deployment_whitelist"`
+	// EnableContractBlockedList forbids calls to the contracts in the blocked list
+	EnableContractBlockedList bool `json:"enable_contract_blocked_list" yaml:"enable_contract_blocked_list"`
 }
 
 // NewParams creates a new Params instance
-func NewParams(evmDenom string, enableCreate, enableCall bool, extraEIPs ...int) Params {
+func NewParams(evmDenom string, enableCreate, enableCall, enableContractDeploymentWhitelist, enableContractBlockedList bool,
+	extraEIPs ...int) Params {
 	return Params{
-		EvmDenom:     evmDenom,
-		EnableCreate: enableCreate,
-		EnableCall:   enableCall,
-		ExtraEIPs:    extraEIPs,
+		EvmDenom:                          evmDenom,
+		EnableCreate:                      enableCreate,
+		EnableCall:                        enableCall,
+		ExtraEIPs:                         extraEIPs,
+		EnableContractDeploymentWhitelist: enableContractDeploymentWhitelist,
+		EnableContractBlockedList:         enableContractBlockedList,
 	}
 }
 
 // DefaultParams returns default evm parameters
 func DefaultParams() Params {
 	return Params{
-		EvmDenom: ethermint.NativeToken,
-		EnableCreate: false,
-		EnableCall:   false,
-		ExtraEIPs:    []int(nil), // TODO: define default values
+		EvmDenom:                          ethermint.NativeToken,
+		EnableCreate:                      false,
+		EnableCall:                        false,
+		ExtraEIPs:                         []int(nil), // TODO: define default values
+		EnableContractDeploymentWhitelist: false,
+		EnableContractBlockedList:         false,
 	}
 }
 
@@ -76,6 +88,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(ParamStoreKeyEnableCreate, &p.EnableCreate, validateBool),
 		params.NewParamSetPair(ParamStoreKeyEnableCall, &p.EnableCall, validateBool),
 		params.NewParamSetPair(ParamStoreKeyExtraEIPs, &p.ExtraEIPs, validateEIPs),
+		params.NewParamSetPair(ParamStoreKeyContractDeploymentWhitelist, &p.EnableContractDeploymentWhitelist, validateBool),
+		params.NewParamSetPair(ParamStoreKeyContractBlockedList, &p.EnableContractBlockedList, validateBool),
 	}
 }
 
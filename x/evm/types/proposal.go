@@ -0,0 +1,225 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+const (
+	// proposalTypeManageContractDeploymentWhitelist defines the type for a ManageContractDeploymentWhitelistProposal
+	proposalTypeManageContractDeploymentWhitelist = "ManageContractDeploymentWhitelist"
+	// proposalTypeManageContractBlockedList defines the type for a ManageContractBlockedListProposal
+	proposalTypeManageContractBlockedList = "ManageContractBlockedList"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeManageContractDeploymentWhitelist)
+	govtypes.RegisterProposalType(proposalTypeManageContractBlockedList)
+	govtypes.RegisterProposalTypeCodec(ManageContractDeploymentWhitelistProposal{},
+		"okexchain/evm/ManageContractDeploymentWhitelistProposal")
+	govtypes.RegisterProposalTypeCodec(ManageContractBlockedListProposal{},
+		"okexchain/evm/ManageContractBlockedListProposal")
+}
+
+var (
+	_ govtypes.Content = (*ManageContractDeploymentWhitelistProposal)(nil)
+	_ govtypes.Content = (*ManageContractBlockedListProposal)(nil)
+)
+
+// AddressList is the type alias for []sdk.AccAddress
+type AddressList []sdk.AccAddress
+
+// String returns a human readable string representation of AddressList
+func (al AddressList) String() string {
+	var b strings.Builder
+	b.WriteString("Address List:\n")
+	for i, addr := range al {
+		b.WriteString(fmt.Sprintf(" %d.%s\n", i+1, addr.String()))
+	}
+
+	return strings.TrimSpace(b.String())
+}
+
+// ValidateBasic checks whether the address list is empty or contains duplicated or empty addresses
+func (al AddressList) ValidateBasic() sdk.Error {
+	if len(al) == 0 {
+		return ErrEmptyAddressList
+	}
+
+	seen := make(map[string]bool, len(al))
+	for _, addr := range al {
+		if addr.Empty() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty address in the address list")
+		}
+
+		key := addr.String()
+		if seen[key] {
+			return sdkerrors.Wrap(ErrDuplicatedAddr, key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// ManageContractDeploymentWhitelistProposal - structure for the proposal to add or delete deployer addresses from
+// the contract deployment whitelist
+type ManageContractDeploymentWhitelistProposal struct {
+	Title         string      `json:"title" yaml:"title"`
+	Description   string      `json:"description" yaml:"description"`
+	DeployerAddrs AddressList `json:"deployer_addresses" yaml:"deployer_addresses"`
+	IsAdded       bool        `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageContractDeploymentWhitelistProposal creates a new instance of ManageContractDeploymentWhitelistProposal
+func NewManageContractDeploymentWhitelistProposal(title, description string, deployerAddrs AddressList, isAdded bool,
+) ManageContractDeploymentWhitelistProposal {
+	return ManageContractDeploymentWhitelistProposal{
+		Title:         title,
+		Description:   description,
+		DeployerAddrs: deployerAddrs,
+		IsAdded:       isAdded,
+	}
+}
+
+// GetTitle returns title of a manage contract deployment whitelist proposal object
+func (mp ManageContractDeploymentWhitelistProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage contract deployment whitelist proposal object
+func (mp ManageContractDeploymentWhitelistProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage contract deployment whitelist proposal object
+func (mp ManageContractDeploymentWhitelistProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage contract deployment whitelist proposal object
+func (mp ManageContractDeploymentWhitelistProposal) ProposalType() string {
+	return proposalTypeManageContractDeploymentWhitelist
+}
+
+// ValidateBasic validates a manage contract deployment whitelist proposal
+func (mp ManageContractDeploymentWhitelistProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(mp.Title, mp.Description); err != nil {
+		return err
+	}
+
+	if mp.ProposalType() != proposalTypeManageContractDeploymentWhitelist {
+		return govtypes.ErrInvalidProposalType(ModuleName, mp.ProposalType())
+	}
+
+	return mp.DeployerAddrs.ValidateBasic()
+}
+
+// String returns a human readable string representation of a ManageContractDeploymentWhitelistProposal
+func (mp ManageContractDeploymentWhitelistProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`ManageContractDeploymentWhitelistProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ IsAdded:				%t
+`,
+			mp.Title, mp.Description, mp.ProposalType(), mp.IsAdded),
+	)
+	builder.WriteString(mp.DeployerAddrs.String())
+	return builder.String()
+}
+
+// ManageContractBlockedListProposal - structure for the proposal to add or delete contract addresses from the
+// contract blocked list
+type ManageContractBlockedListProposal struct {
+	Title         string      `json:"title" yaml:"title"`
+	Description   string      `json:"description" yaml:"description"`
+	ContractAddrs AddressList `json:"contract_addresses" yaml:"contract_addresses"`
+	IsAdded       bool        `json:"is_added" yaml:"is_added"`
+}
+
+// NewManageContractBlockedListProposal creates a new instance of ManageContractBlockedListProposal
+func NewManageContractBlockedListProposal(title, description string, contractAddrs AddressList, isAdded bool,
+) ManageContractBlockedListProposal {
+	return ManageContractBlockedListProposal{
+		Title:         title,
+		Description:   description,
+		ContractAddrs: contractAddrs,
+		IsAdded:       isAdded,
+	}
+}
+
+// GetTitle returns title of a manage contract blocked list proposal object
+func (mp ManageContractBlockedListProposal) GetTitle() string {
+	return mp.Title
+}
+
+// GetDescription returns description of a manage contract blocked list proposal object
+func (mp ManageContractBlockedListProposal) GetDescription() string {
+	return mp.Description
+}
+
+// ProposalRoute returns route key of a manage contract blocked list proposal object
+func (mp ManageContractBlockedListProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a manage contract blocked list proposal object
+func (mp ManageContractBlockedListProposal) ProposalType() string {
+	return proposalTypeManageContractBlockedList
+}
+
+// ValidateBasic validates a manage contract blocked list proposal
+func (mp ManageContractBlockedListProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(mp.Title, mp.Description); err != nil {
+		return err
+	}
+
+	if mp.ProposalType() != proposalTypeManageContractBlockedList {
+		return govtypes.ErrInvalidProposalType(ModuleName, mp.ProposalType())
+	}
+
+	return mp.ContractAddrs.ValidateBasic()
+}
+
+// String returns a human readable string representation of a ManageContractBlockedListProposal
+func (mp ManageContractBlockedListProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString(
+		fmt.Sprintf(`ManageContractBlockedListProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ IsAdded:				%t
+`,
+			mp.Title, mp.Description, mp.ProposalType(), mp.IsAdded),
+	)
+	builder.WriteString(mp.ContractAddrs.String())
+	return builder.String()
+}
+
+func validateProposalContent(title, description string) sdk.Error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return govtypes.ErrInvalidProposalContent(ModuleName, "title is required")
+	}
+	if len(title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent(ModuleName,
+			fmt.Sprintf("title is longer than max length of %d", govtypes.MaxTitleLength))
+	}
+
+	if len(description) == 0 {
+		return govtypes.ErrInvalidProposalContent(ModuleName, "description is required")
+	}
+	if len(description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent(ModuleName,
+			fmt.Sprintf("description is longer than max length of %d", govtypes.MaxDescriptionLength))
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	addr1 = sdk.AccAddress([]byte{1, 2, 3, 4, 5})
+	addr2 = sdk.AccAddress([]byte{5, 4, 3, 2, 1})
+)
+
+func TestManageContractDeploymentWhitelistProposal(t *testing.T) {
+	proposal := NewManageContractDeploymentWhitelistProposal("title", "description", AddressList{addr1, addr2}, true)
+	require.Equal(t, "title", proposal.GetTitle())
+	require.Equal(t, "description", proposal.GetDescription())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, proposalTypeManageContractDeploymentWhitelist, proposal.ProposalType())
+	require.NotPanics(t, func() {
+		_ = proposal.String()
+	})
+	require.NoError(t, proposal.ValidateBasic())
+
+	testCases := []struct {
+		name     string
+		proposal ManageContractDeploymentWhitelistProposal
+	}{
+		{"blank title", NewManageContractDeploymentWhitelistProposal(" ", "description", AddressList{addr1}, true)},
+		{"long title", NewManageContractDeploymentWhitelistProposal(
+			strings.Repeat("a", govtypes.MaxTitleLength+1), "description", AddressList{addr1}, true)},
+		{"empty description", NewManageContractDeploymentWhitelistProposal("title", "", AddressList{addr1}, true)},
+		{"long description", NewManageContractDeploymentWhitelistProposal(
+			"title", strings.Repeat("a", govtypes.MaxDescriptionLength+1), AddressList{addr1}, true)},
+		{"empty address list", NewManageContractDeploymentWhitelistProposal("title", "description", nil, true)},
+		{"empty address", NewManageContractDeploymentWhitelistProposal("title", "description",
+			AddressList{addr1, sdk.AccAddress{}}, true)},
+		{"duplicated address", NewManageContractDeploymentWhitelistProposal("title", "description",
+			AddressList{addr1, addr2, addr1}, false)},
+	}
+
+	for _, tc := range testCases {
+		require.Error(t, tc.proposal.ValidateBasic(), tc.name)
+	}
+}
+
+func TestManageContractBlockedListProposal(t *testing.T) {
+	proposal := NewManageContractBlockedListProposal("title", "description", AddressList{addr1, addr2}, false)
+	require.Equal(t, "title", proposal.GetTitle())
+	require.Equal(t, "description", proposal.GetDescription())
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, proposalTypeManageContractBlockedList, proposal.ProposalType())
+	require.NotPanics(t, func() {
+		_ = proposal.String()
+	})
+	require.NoError(t, proposal.ValidateBasic())
+
+	proposal.ContractAddrs = AddressList{addr2, addr2}
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal.ContractAddrs = nil
+	require.Error(t, proposal.ValidateBasic())
+
+	proposal.ContractAddrs = AddressList{addr1}
+	proposal.Title = ""
+	require.Error(t, proposal.ValidateBasic())
+}
@@ -20,7 +20,9 @@ const (
 	QueryAccount         = "account"
 	QueryExportAccount   = "exportAccount"
 	// QueryParameters defines 	QueryParameters = "params" query route path
-	QueryParameters = "params"
+	QueryParameters                  = "params"
+	QueryContractDeploymentWhitelist = "contract-deployment-whitelist"
+	QueryContractBlockedList         = "contract-blocked-list"
 )
 
 // QueryResBalance is response type for balance query
@@ -23,6 +23,7 @@ type StateTransition struct {
 	Recipient    *common.Address
 	Amount       *big.Int
 	Payload      []byte
+	AccessList   AccessList
 
 	ChainID  *big.Int
 	Csdb     *CommitStateDB
@@ -74,6 +75,7 @@ func GetHashFn(ctx sdk.Context, csdb *CommitStateDB) vm.GetHashFunc {
 func (st StateTransition) newEVM(
 	ctx sdk.Context,
 	csdb *CommitStateDB,
+	stateDB vm.StateDB,
 	gasLimit uint64,
 	gasPrice *big.Int,
 	config ChainConfig,
@@ -100,7 +102,7 @@ func (st StateTransition) newEVM(
 		ExtraEips: extraEIPs,
 	}
 
-	return vm.NewEVM(blockCtx, txCtx, csdb, config.EthereumConfig(st.ChainID), vmConfig)
+	return vm.NewEVM(blockCtx, txCtx, stateDB, config.EthereumConfig(st.ChainID), vmConfig)
 }
 
 // TransitionDb will transition the state by applying the current transaction and
@@ -109,11 +111,20 @@ func (st StateTransition) newEVM(
 func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*ExecutionResult, error) {
 	contractCreation := st.Recipient == nil
 
-	cost, err := core.IntrinsicGas(st.Payload, contractCreation, true, false)
+	cost, err := IntrinsicGas(st.Payload, st.AccessList, contractCreation, true, false)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "invalid intrinsic gas for transaction")
 	}
 
+	if !st.Simulate {
+		// charge the intrinsic gas of the access list, the simulation charges it with the whole intrinsic gas below
+		accessListGas, err := st.AccessList.Gas()
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid access list gas for transaction")
+		}
+		ctx.GasMeter().ConsumeGas(accessListGas, "access list intrinsic gas")
+	}
+
 	// This gas limit the the transaction gas limit with intrinsic gas subtracted
 	gasLimit := st.GasLimit - ctx.GasMeter().GasConsumed()
 
@@ -147,7 +158,24 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*Ex
 		return nil, errors.New("gas price cannot be nil")
 	}
 
-	evm := st.newEVM(ctx, csdb, gasLimit, gasPrice.Int, config, params.ExtraEIPs)
+	// the contracts called by the nested call frames are checked on their code lookups if the blocked list is enabled
+	var (
+		blockedStateDB *blockedListStateDB
+		stateDB        vm.StateDB = csdb
+	)
+	if params.EnableContractBlockedList {
+		blockedStateDB = newBlockedListStateDB(csdb)
+		stateDB = blockedStateDB
+	}
+	evm := st.newEVM(ctx, csdb, stateDB, gasLimit, gasPrice.Int, config, params.ExtraEIPs)
+	if blockedStateDB != nil {
+		blockedStateDB.evm = evm
+	}
+
+	// warm up the addresses and the storage slots for EIP-2929
+	if rules := evm.ChainConfig().Rules(evm.Context.BlockNumber); rules.IsYoloV2 {
+		csdb.PrepareAccessList(st.Sender, st.Recipient, evm.ActivePrecompiles(), st.AccessList)
+	}
 
 	var (
 		ret             []byte
@@ -169,6 +197,13 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*Ex
 			return nil, ErrCreateDisabled
 		}
 
+		// check whether the deployer address is in the whitelist if the whitelist is enabled
+		senderAccAddr := sdk.AccAddress(st.Sender.Bytes())
+		if params.EnableContractDeploymentWhitelist && !csdb.IsDeployerInWhitelist(senderAccAddr) {
+			return nil, sdkerrors.Wrapf(ErrUnauthorizedAccount, "deployer %s is not in the contract deployment whitelist",
+				senderAccAddr)
+		}
+
 		ret, contractAddress, leftOverGas, err = evm.Create(senderRef, st.Payload, gasLimit, st.Amount)
 		recipientLog = fmt.Sprintf("contract address %s", contractAddress.String())
 	default:
@@ -176,6 +211,12 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*Ex
 			return nil, ErrCallDisabled
 		}
 
+		// check whether the called contract address is in the blocked list if the blocked list is enabled
+		recipientAccAddr := sdk.AccAddress(st.Recipient.Bytes())
+		if params.EnableContractBlockedList && csdb.IsContractInBlockedList(recipientAccAddr) {
+			return nil, sdkerrors.Wrapf(ErrContractBlockedVerify, "contract %s is in the blocked list", recipientAccAddr)
+		}
+
 		// Increment the nonce for the next transaction	(just for evm state transition)
 		csdb.SetNonce(st.Sender, csdb.GetNonce(st.Sender)+1)
 		ret, leftOverGas, err = evm.Call(senderRef, *st.Recipient, st.Payload, gasLimit, st.Amount)
@@ -184,6 +225,9 @@ func (st StateTransition) TransitionDb(ctx sdk.Context, config ChainConfig) (*Ex
 
 	gasConsumed := gasLimit - leftOverGas
 
+	if blockedStateDB != nil && blockedStateDB.err() != nil {
+		err = blockedStateDB.err()
+	}
 	if err != nil {
 		// Consume gas before returning
 		ctx.GasMeter().ConsumeGas(gasConsumed, "evm execution consumption")
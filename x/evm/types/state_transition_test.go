@@ -13,6 +13,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -199,7 +200,7 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 		{
 			"call disabled",
 			func() {
-				params := types.NewParams(ethermint.NativeToken, true, false)
+				params := types.NewParams(ethermint.NativeToken, true, false, false, false)
 				suite.stateDB.SetParams(params)
 			},
 			types.StateTransition{
@@ -220,7 +221,7 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 		{
 			"create disabled",
 			func() {
-				params := types.NewParams(ethermint.NativeToken, false, true)
+				params := types.NewParams(ethermint.NativeToken, false, true, false, false)
 				suite.stateDB.SetParams(params)
 			},
 			types.StateTransition{
@@ -238,6 +239,49 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 			},
 			false,
 		},
+		{
+			"deployer not in contract deployment whitelist",
+			func() {
+				params := types.NewParams(ethermint.NativeToken, true, true, true, false)
+				suite.stateDB.SetParams(params)
+			},
+			types.StateTransition{
+				AccountNonce: 123,
+				Price:        big.NewInt(10),
+				GasLimit:     11,
+				Recipient:    nil,
+				Amount:       big.NewInt(50),
+				Payload:      []byte("data"),
+				ChainID:      big.NewInt(1),
+				Csdb:         suite.stateDB,
+				TxHash:       &ethcmn.Hash{},
+				Sender:       suite.address,
+				Simulate:     suite.ctx.IsCheckTx(),
+			},
+			false,
+		},
+		{
+			"contract in contract blocked list",
+			func() {
+				params := types.NewParams(ethermint.NativeToken, true, true, false, true)
+				suite.stateDB.SetParams(params)
+				suite.stateDB.SetContractBlockedList(types.AddressList{recipient.Bytes()})
+			},
+			types.StateTransition{
+				AccountNonce: 123,
+				Price:        big.NewInt(10),
+				GasLimit:     11,
+				Recipient:    &recipient,
+				Amount:       big.NewInt(50),
+				Payload:      []byte("data"),
+				ChainID:      big.NewInt(1),
+				Csdb:         suite.stateDB,
+				TxHash:       &ethcmn.Hash{},
+				Sender:       suite.address,
+				Simulate:     suite.ctx.IsCheckTx(),
+			},
+			false,
+		},
 		{
 			"nil gas price",
 			func() {
@@ -280,3 +324,91 @@ func (suite *StateDBTestSuite) TestTransitionDb() {
 		}
 	}
 }
+
+func (suite *StateDBTestSuite) TestTransitionDbAccessListGas() {
+	suite.stateDB.SetNonce(suite.address, 123)
+
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	recipient := ethcmn.BytesToAddress([]byte("recipient"))
+	accessList := types.AccessList{
+		{Address: recipient, StorageKeys: []ethcmn.Hash{{1}, {2}}},
+	}
+	accessListGas, err := accessList.Gas()
+	suite.Require().NoError(err)
+
+	st := types.StateTransition{
+		AccountNonce: 123,
+		Price:        big.NewInt(10),
+		GasLimit:     100000,
+		Recipient:    &recipient,
+		Amount:       big.NewInt(50),
+		AccessList:   accessList,
+		ChainID:      big.NewInt(1),
+		Csdb:         suite.stateDB,
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.address,
+	}
+
+	// the access list gas is charged before the evm execution
+	ctx := suite.ctx.WithGasMeter(sdk.NewGasMeter(st.GasLimit))
+	res, err := st.TransitionDb(ctx, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+	suite.Require().Equal(accessListGas+res.GasInfo.GasConsumed, ctx.GasMeter().GasConsumed())
+	suite.Require().Equal(st.GasLimit-accessListGas, res.GasInfo.GasLimit)
+
+	// out of gas if the gas limit can't cover the access list
+	st.GasLimit = accessListGas - 1
+	ctx = suite.ctx.WithGasMeter(sdk.NewGasMeter(st.GasLimit))
+	suite.Require().Panics(func() {
+		_, _ = st.TransitionDb(ctx, types.DefaultChainConfig())
+	})
+}
+
+func (suite *StateDBTestSuite) TestTransitionDbBlockedListNestedCall() {
+	suite.stateDB.SetNonce(suite.address, 123)
+
+	addr := sdk.AccAddress(suite.address.Bytes())
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, addr)
+	_ = acc.SetCoins(sdk.NewCoins(ethermint.NewPhotonCoin(sdk.NewInt(5000))))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	// the callee stops at once and the caller calls the callee
+	callee := ethcmn.BytesToAddress([]byte("callee"))
+	suite.stateDB.SetCode(callee, []byte{byte(vm.STOP)})
+	caller := ethcmn.BytesToAddress([]byte("caller"))
+	code := []byte{
+		byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.PUSH1), 0,
+		byte(vm.PUSH20),
+	}
+	code = append(code, callee.Bytes()...)
+	code = append(code, byte(vm.GAS), byte(vm.CALL), byte(vm.POP), byte(vm.STOP))
+	suite.stateDB.SetCode(caller, code)
+
+	st := types.StateTransition{
+		AccountNonce: 123,
+		Price:        big.NewInt(10),
+		GasLimit:     100000,
+		Recipient:    &caller,
+		Amount:       big.NewInt(0),
+		ChainID:      big.NewInt(1),
+		Csdb:         suite.stateDB,
+		TxHash:       &ethcmn.Hash{},
+		Sender:       suite.address,
+		Simulate:     true,
+	}
+
+	params := types.NewParams(ethermint.NativeToken, true, true, false, true)
+	suite.stateDB.SetParams(params)
+	_, err := st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().NoError(err)
+
+	// the callee in the blocked list can't be called by the contract either
+	suite.stateDB.SetContractBlockedList(types.AddressList{callee.Bytes()})
+	_, err = st.TransitionDb(suite.ctx, types.DefaultChainConfig())
+	suite.Require().Error(err)
+	suite.Require().True(types.ErrContractBlockedVerify.Is(err))
+}
@@ -203,6 +203,38 @@ func (csdb *CommitStateDB) DeleteLogs(hash ethcmn.Hash) {
 	store.Delete(hash.Bytes())
 }
 
+// SetContractDeploymentWhitelist sets the target address list into whitelist store
+func (csdb *CommitStateDB) SetContractDeploymentWhitelist(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Set(GetContractDeploymentWhitelistMemberKey(addrList[i]), []byte(""))
+	}
+}
+
+// DeleteContractDeploymentWhitelist deletes the target address list from whitelist store
+func (csdb *CommitStateDB) DeleteContractDeploymentWhitelist(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Delete(GetContractDeploymentWhitelistMemberKey(addrList[i]))
+	}
+}
+
+// SetContractBlockedList sets the target address list into blocked list store
+func (csdb *CommitStateDB) SetContractBlockedList(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Set(GetContractBlockedListMemberKey(addrList[i]), []byte(""))
+	}
+}
+
+// DeleteContractBlockedList deletes the target address list from blocked list store
+func (csdb *CommitStateDB) DeleteContractBlockedList(addrList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	for i := 0; i < len(addrList); i++ {
+		store.Delete(GetContractBlockedListMemberKey(addrList[i]))
+	}
+}
+
 // AddLog adds a new log to the state and sets the log metadata from the state.
 func (csdb *CommitStateDB) AddLog(log *ethtypes.Log) {
 	csdb.journal.append(addLogChange{txhash: csdb.thash})
@@ -254,6 +286,26 @@ func (csdb *CommitStateDB) SubRefund(gas uint64) {
 	csdb.refund -= gas
 }
 
+// PrepareAccessList resets the access list and fills it with the sender, the destination, the precompiles and
+// the access list of the transaction as defined in EIP-2929 and EIP-2930
+func (csdb *CommitStateDB) PrepareAccessList(sender ethcmn.Address, dst *ethcmn.Address, precompiles []ethcmn.Address,
+	list AccessList) {
+	csdb.accessList = newAccessList()
+	csdb.AddAddressToAccessList(sender)
+	if dst != nil {
+		csdb.AddAddressToAccessList(*dst)
+	}
+	for _, addr := range precompiles {
+		csdb.AddAddressToAccessList(addr)
+	}
+	for _, el := range list {
+		csdb.AddAddressToAccessList(el.Address)
+		for _, key := range el.StorageKeys {
+			csdb.AddSlotToAccessList(el.Address, key)
+		}
+	}
+}
+
 // AddAddressToAccessList adds the given address to the access list
 func (csdb *CommitStateDB) AddAddressToAccessList(addr ethcmn.Address) {
 	if csdb.accessList.AddAddress(addr) {
@@ -311,6 +363,42 @@ func (csdb *CommitStateDB) GetParams() (params Params) {
 	return params
 }
 
+// GetContractDeploymentWhitelist gets the whole contract deployment whitelist currently
+func (csdb *CommitStateDB) GetContractDeploymentWhitelist() (whitelist AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyPrefixContractDeploymentWhitelist)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		whitelist = append(whitelist, SplitContractDeploymentWhitelistMemberKey(iterator.Key()))
+	}
+
+	return
+}
+
+// IsDeployerInWhitelist checks whether the deployer is in the contract deployment whitelist
+func (csdb *CommitStateDB) IsDeployerInWhitelist(deployerAddr sdk.AccAddress) bool {
+	return csdb.ctx.KVStore(csdb.storeKey).Has(GetContractDeploymentWhitelistMemberKey(deployerAddr))
+}
+
+// GetContractBlockedList gets the whole contract blocked list currently
+func (csdb *CommitStateDB) GetContractBlockedList() (blockedList AddressList) {
+	store := csdb.ctx.KVStore(csdb.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, KeyPrefixContractBlockedList)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		blockedList = append(blockedList, SplitContractBlockedListMemberKey(iterator.Key()))
+	}
+
+	return
+}
+
+// IsContractInBlockedList checks whether the contract address is in the contract blocked list
+func (csdb *CommitStateDB) IsContractInBlockedList(contractAddr sdk.AccAddress) bool {
+	return csdb.ctx.KVStore(csdb.storeKey).Has(GetContractBlockedListMemberKey(contractAddr))
+}
+
 // GetBalance retrieves the balance from the given address or 0 if object not
 // found.
 func (csdb *CommitStateDB) GetBalance(addr ethcmn.Address) *big.Int {
@@ -26,6 +26,11 @@ type TxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// fields of the EIP-2718 typed transactions, which are left empty by the legacy transactions
+	Type     uint8      `json:"type" rlp:"-"`
+	ChainID  *big.Int   `json:"chainId" rlp:"-"`
+	Accesses AccessList `json:"accessList" rlp:"-"`
 }
 
 // encodableTxData implements the Ethereum transaction data structure. It is used
@@ -45,9 +50,21 @@ type encodableTxData struct {
 
 	// hash is only used when marshaling to JSON
 	Hash *ethcmn.Hash `json:"hash" rlp:"-"`
+
+	// NOTE: the typed transaction fields must be appended after the legacy ones to keep the amino encoding of the
+	// legacy transactions unchanged
+	Type     uint8      `json:"type"`
+	ChainID  string     `json:"chainId"`
+	Accesses AccessList `json:"accessList"`
 }
 
 func (td TxData) String() string {
+	if td.Type != LegacyTxType {
+		return fmt.Sprintf("type=%d chainID=%s nonce=%d price=%s gasLimit=%d recipient=%s amount=%s data=0x%x accessList=%d v=%s r=%s s=%s",
+			td.Type, td.ChainID, td.AccountNonce, td.Price, td.GasLimit, td.Recipient, td.Amount, td.Payload,
+			len(td.Accesses), td.V, td.R, td.S)
+	}
+
 	if td.Recipient != nil {
 		return fmt.Sprintf("nonce=%d price=%s gasLimit=%d recipient=%s amount=%s data=0x%x v=%s r=%s s=%s",
 			td.AccountNonce, td.Price, td.GasLimit, td.Recipient.Hex(), td.Amount, td.Payload, td.V, td.R, td.S)
@@ -95,6 +112,16 @@ func (td TxData) MarshalAmino() ([]byte, error) {
 		R:            r,
 		S:            s,
 		Hash:         td.Hash,
+		Type:         td.Type,
+		Accesses:     td.Accesses,
+	}
+
+	if td.ChainID != nil {
+		chainID, err := utils.MarshalBigInt(td.ChainID)
+		if err != nil {
+			return nil, err
+		}
+		e.ChainID = chainID
 	}
 
 	return ModuleCdc.MarshalBinaryBare(e)
@@ -113,6 +140,24 @@ func (td *TxData) UnmarshalAmino(data []byte) error {
 	td.Recipient = e.Recipient
 	td.Payload = e.Payload
 	td.Hash = e.Hash
+	td.Type = e.Type
+	td.Accesses = e.Accesses
+	for i := range td.Accesses {
+		// amino decodes an empty slice to nil, keep it the same as the RLP decoded one
+		if td.Accesses[i].StorageKeys == nil {
+			td.Accesses[i].StorageKeys = []ethcmn.Hash{}
+		}
+	}
+
+	if len(e.ChainID) != 0 {
+		chainID, err := utils.UnmarshalBigInt(e.ChainID)
+		if err != nil {
+			return err
+		}
+		td.ChainID = chainID
+	} else {
+		td.ChainID = nil
+	}
 
 	price, err := utils.UnmarshalBigInt(e.Price)
 	if err != nil {
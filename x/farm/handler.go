@@ -114,6 +114,7 @@ func handleMsgProvide(ctx sdk.Context, k keeper.Keeper, msg types.MsgProvide) (*
 		msg.Amount, msg.StartHeightToYield, msg.AmountYieldedPerBlock,
 	)
 	k.SetFarmPool(ctx, updatedPool)
+	k.OnFarmPoolUpdated(ctx, msg.PoolName)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeProvide,
@@ -151,6 +152,7 @@ func handleMsgClaim(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaim) (*sdk.
 	}
 	updatedPool.TotalAccumulatedRewards = updatedPool.TotalAccumulatedRewards.Sub(rewards)
 	k.SetFarmPool(ctx, updatedPool)
+	k.OnFarmClaim(ctx, msg.Address, msg.PoolName, rewards)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeClaim,
@@ -26,9 +26,11 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	updatedPool, yieldedTokens := k.CalculateAmountYieldedBetween(ctx, pool)
 
 	// 3. Lock info
+	var rewards sdk.SysCoins
 	if found {
 		// If it exists, withdraw money
-		rewards, err := k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
+		var err error
+		rewards, err = k.WithdrawRewards(ctx, pool.Name, pool.TotalValueLocked, yieldedTokens, msg.Address)
 		if err != nil {
 			return sdk.ErrInternal(err.Error()).Result()
 		}
@@ -63,6 +65,11 @@ func handleMsgLock(ctx sdk.Context, k keeper.Keeper, msg types.MsgLock) (*sdk.Re
 	updatedPool.TotalValueLocked = updatedPool.TotalValueLocked.Add(msg.Amount)
 	k.SetFarmPool(ctx, updatedPool)
 
+	k.OnFarmLock(ctx, msg.Address, msg.PoolName, msg.Amount)
+	if !rewards.IsZero() {
+		k.OnFarmClaim(ctx, msg.Address, msg.PoolName, rewards)
+	}
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeLock,
 		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
@@ -125,6 +132,11 @@ func handleMsgUnlock(ctx sdk.Context, k keeper.Keeper, msg types.MsgUnlock) (*sd
 	updatedPool.TotalAccumulatedRewards = updatedPool.TotalAccumulatedRewards.Sub(rewards)
 	k.SetFarmPool(ctx, updatedPool)
 
+	k.OnFarmUnlock(ctx, msg.Address, msg.PoolName, msg.Amount)
+	if !rewards.IsZero() {
+		k.OnFarmClaim(ctx, msg.Address, msg.PoolName, rewards)
+	}
+
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeUnlock,
 		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
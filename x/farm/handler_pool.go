@@ -54,6 +54,7 @@ func handleMsgCreatePool(ctx sdk.Context, k keeper.Keeper, msg types.MsgCreatePo
 	k.SetPoolHistoricalRewards(ctx, msg.PoolName, 0, poolHistoricalRewards)
 	poolCurrentRewards := types.NewPoolCurrentRewards(ctx.BlockHeight(), 1, sdk.SysCoins{})
 	k.SetPoolCurrentRewards(ctx, msg.PoolName, poolCurrentRewards)
+	k.OnFarmPoolUpdated(ctx, msg.PoolName)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeCreatePool,
@@ -118,6 +119,7 @@ func handleMsgDestroyPool(ctx sdk.Context, k keeper.Keeper, msg types.MsgDestroy
 		},
 	)
 	k.DeletePoolCurrentRewards(ctx, msg.PoolName)
+	k.OnFarmPoolUpdated(ctx, msg.PoolName)
 
 	ctx.EventManager().EmitEvent(sdk.NewEvent(
 		types.EventTypeDestroyPool,
@@ -5,6 +5,13 @@ import (
 	"github.com/okex/okexchain/x/farm/types"
 )
 
+// GetEarnings gets the earnings info by a given user address and a specific pool name. The pool period incremented
+// by the calculation is discarded, so that it's safe to be called out of a query, e.g. at EndBlock
+func (k Keeper) GetEarnings(ctx sdk.Context, poolName string, accAddr sdk.AccAddress) (types.Earnings, sdk.Error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.getEarnings(cacheCtx, poolName, accAddr)
+}
+
 // getEarnings gets the earnings info by a given user address and a specific pool name
 func (k Keeper) getEarnings(
 	ctx sdk.Context, poolName string, accAddr sdk.AccAddress,
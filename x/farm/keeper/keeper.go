@@ -20,6 +20,7 @@ type Keeper struct {
 	tokenKeeper      token.Keeper
 	swapKeeper       swap.Keeper
 	govKeeper        GovKeeper
+	observerKeepers  []types.ObserverKeeper
 }
 
 // NewKeeper creates a farm keeper
@@ -68,3 +69,36 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k *Keeper) SetGovKeeper(gk GovKeeper) {
 	k.govKeeper = gk
 }
+
+// SetObserverKeeper adds a keeper notified of the changes of the pools and the locks
+func (k *Keeper) SetObserverKeeper(ok types.ObserverKeeper) {
+	k.observerKeepers = append(k.observerKeepers, ok)
+}
+
+// OnFarmPoolUpdated notifies the observers that the pool is updated
+func (k Keeper) OnFarmPoolUpdated(ctx sdk.Context, poolName string) {
+	for _, observer := range k.observerKeepers {
+		observer.OnFarmPoolUpdated(ctx, poolName)
+	}
+}
+
+// OnFarmLock notifies the observers that the address locks the amount in the pool
+func (k Keeper) OnFarmLock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin) {
+	for _, observer := range k.observerKeepers {
+		observer.OnFarmLock(ctx, address, poolName, amount)
+	}
+}
+
+// OnFarmUnlock notifies the observers that the address unlocks the amount from the pool
+func (k Keeper) OnFarmUnlock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin) {
+	for _, observer := range k.observerKeepers {
+		observer.OnFarmUnlock(ctx, address, poolName, amount)
+	}
+}
+
+// OnFarmClaim notifies the observers that the address withdraws the rewards from the pool
+func (k Keeper) OnFarmClaim(ctx sdk.Context, address sdk.AccAddress, poolName string, rewards sdk.SysCoins) {
+	for _, observer := range k.observerKeepers {
+		observer.OnFarmClaim(ctx, address, poolName, rewards)
+	}
+}
@@ -12,3 +12,13 @@ type ParamSubspace interface {
 	GetParamSet(ctx sdk.Context, ps params.ParamSet)
 	SetParamSet(ctx sdk.Context, ps params.ParamSet)
 }
+
+// ObserverKeeper defines the keeper notified of the changes of the farm pools and the locks
+type ObserverKeeper interface {
+	// OnFarmPoolUpdated is called after a pool is created, provided or destroyed
+	OnFarmPoolUpdated(ctx sdk.Context, poolName string)
+	OnFarmLock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin)
+	OnFarmUnlock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin)
+	// OnFarmClaim is called after the rewards are withdrawn, by a claim or a lock change
+	OnFarmClaim(ctx sdk.Context, address sdk.AccAddress, poolName string, rewards sdk.SysCoins)
+}
@@ -44,6 +44,7 @@ var (
 	NewMsgSubmitProposal       = types.NewMsgSubmitProposal
 	NewMsgDeposit              = types.NewMsgDeposit
 	NewMsgVote                 = types.NewMsgVote
+	NewMsgVoteWeighted         = types.NewMsgVoteWeighted
 	ParamKeyTable              = types.ParamKeyTable
 	NewDepositParams           = types.NewDepositParams
 	NewTallyParams             = types.NewTallyParams
@@ -86,6 +87,7 @@ type (
 	MsgSubmitProposal = types.MsgSubmitProposal
 	MsgDeposit        = types.MsgDeposit
 	MsgVote           = types.MsgVote
+	MsgVoteWeighted   = types.MsgVoteWeighted
 	DepositParams     = types.DepositParams
 	TallyParams       = types.TallyParams
 	VotingParams      = types.VotingParams
@@ -97,4 +99,7 @@ type (
 	Vote              = types.Vote
 	Votes             = types.Votes
 	Keeper            = keeper.Keeper
+
+	WeightedVoteOption  = types.WeightedVoteOption
+	WeightedVoteOptions = types.WeightedVoteOptions
 )
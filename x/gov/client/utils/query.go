@@ -2,11 +2,16 @@ package utils
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/okex/okexchain/x/gov/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 const (
@@ -80,33 +85,15 @@ func QueryDepositsByTxQuery(cliCtx context.CLIContext, params types.QueryProposa
 // support configurable pagination.
 func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 	}
 
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+	txs, err := searchVoteTxs(cliCtx, events)
 	if err != nil {
 		return nil, err
 	}
 
-	var votes []types.Vote
-
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
-
-				votes = append(votes, types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				})
-			}
-		}
-	}
-
+	votes := votesFromTxs(txs, params.ProposalID)
 	if cliCtx.Indent {
 		return cliCtx.Codec.MarshalJSONIndent(votes, "", "  ")
 	}
@@ -117,40 +104,111 @@ func QueryVotesByTxQuery(cliCtx context.CLIContext, params types.QueryProposalPa
 // QueryVoteByTxQuery will query for a single vote via a direct txs tags query.
 func QueryVoteByTxQuery(cliCtx context.CLIContext, params types.QueryVoteParams) ([]byte, error) {
 	events := []string{
-		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, types.TypeMsgVote),
 		fmt.Sprintf("%s.%s='%s'", types.EventTypeProposalVote, types.AttributeKeyProposalID, []byte(fmt.Sprintf("%d", params.ProposalID))),
 		fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, []byte(params.Voter.String())),
 	}
 
-	// NOTE: SearchTxs is used to facilitate the txs query which does not currently
-	// support configurable pagination.
-	searchResult, err := utils.QueryTxsByEvents(cliCtx, events, defaultPage, defaultLimit)
+	txs, err := searchVoteTxs(cliCtx, events)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, info := range searchResult.Txs {
-		for _, msg := range info.Tx.GetMsgs() {
-			// there should only be a single vote under the given conditions
-			if msg.Type() == types.TypeMsgVote {
-				voteMsg := msg.(types.MsgVote)
+	for _, vote := range votesFromTxs(txs, params.ProposalID) {
+		if vote.Voter.Equals(params.Voter) {
+			if cliCtx.Indent {
+				return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
+			}
+			return cliCtx.Codec.MarshalJSON(vote)
+		}
+	}
 
-				vote := types.Vote{
-					Voter:      voteMsg.Voter,
-					ProposalID: params.ProposalID,
-					Option:     voteMsg.Option,
-				}
+	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
+}
 
-				if cliCtx.Indent {
-					return cliCtx.Codec.MarshalJSONIndent(vote, "", "  ")
-				}
+// searchVoteTxs searches the txs of the vote msgs and the weighted vote msgs with the events. The txs of both
+// actions are merged in the order they're executed on the chain
+func searchVoteTxs(cliCtx context.CLIContext, events []string) ([]sdk.Tx, error) {
+	node, err := cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	var resTxs []*ctypes.ResultTx
+	for _, action := range []string{types.TypeMsgVote, types.TypeMsgVoteWeighted} {
+		query := strings.Join(append([]string{
+			fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, action),
+		}, events...), " AND ")
 
-				return cliCtx.Codec.MarshalJSON(vote)
+		// NOTE: the txs query does not currently support configurable pagination.
+		res, err := node.TxSearch(query, !cliCtx.TrustNode, defaultPage, defaultLimit, "")
+		if err != nil {
+			return nil, err
+		}
+		for _, resTx := range res.Txs {
+			if err := utils.ValidateTxResult(cliCtx, resTx); err != nil {
+				return nil, err
 			}
 		}
+		resTxs = append(resTxs, res.Txs...)
 	}
 
-	return nil, fmt.Errorf("address '%s' did not vote on proposalID %d", params.Voter, params.ProposalID)
+	return parseVoteTxs(cliCtx.Codec, resTxs)
+}
+
+// parseVoteTxs sorts the indexed txs by the height and the index in the block, and parses them
+func parseVoteTxs(cdc *codec.Codec, resTxs []*ctypes.ResultTx) ([]sdk.Tx, error) {
+	sort.SliceStable(resTxs, func(i, j int) bool {
+		if resTxs[i].Height != resTxs[j].Height {
+			return resTxs[i].Height < resTxs[j].Height
+		}
+		return resTxs[i].Index < resTxs[j].Index
+	})
+
+	txs := make([]sdk.Tx, len(resTxs))
+	for i, resTx := range resTxs {
+		var tx auth.StdTx
+		if err := cdc.UnmarshalBinaryLengthPrefixed(resTx.Tx, &tx); err != nil {
+			return nil, err
+		}
+		txs[i] = tx
+	}
+	return txs, nil
+}
+
+// votesFromTxs builds the votes on the proposal from the txs in the executed order, a later vote of a voter replaces
+// the earlier one
+func votesFromTxs(txs []sdk.Tx, proposalID uint64) []types.Vote {
+	var votes []types.Vote
+	voteIndexes := make(map[string]int)
+
+	for _, tx := range txs {
+		for _, msg := range tx.GetMsgs() {
+			if vote, ok := voteFromMsg(msg, proposalID); ok {
+				if i, found := voteIndexes[vote.Voter.String()]; found {
+					votes[i] = vote
+					continue
+				}
+				voteIndexes[vote.Voter.String()] = len(votes)
+				votes = append(votes, vote)
+			}
+		}
+	}
+	return votes
+}
+
+// voteFromMsg builds the vote from a vote msg or a weighted vote msg on the proposal
+func voteFromMsg(msg sdk.Msg, proposalID uint64) (types.Vote, bool) {
+	switch msg := msg.(type) {
+	case types.MsgVote:
+		if msg.ProposalID == proposalID {
+			return types.NewVote(proposalID, msg.Voter, msg.Option), true
+		}
+	case types.MsgVoteWeighted:
+		if msg.ProposalID == proposalID {
+			return types.NewWeightedVote(proposalID, msg.Voter, msg.Options), true
+		}
+	}
+	return types.Vote{}, false
 }
 
 // QueryDepositByTxQuery will query for a single deposit via a direct txs tags
@@ -0,0 +1,59 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
+
+func newVoteTxResult(t *testing.T, cdc *codec.Codec, height int64, index uint32, msg sdk.Msg) *ctypes.ResultTx {
+	tx := auth.NewStdTx([]sdk.Msg{msg}, auth.StdFee{}, nil, "")
+	bz, err := cdc.MarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	return &ctypes.ResultTx{Height: height, Index: index, Tx: bz}
+}
+
+func TestVotesFromTxResults(t *testing.T) {
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+	sdk.RegisterCodec(cdc)
+	auth.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+
+	voter1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	voter2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	weightedOptions := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+
+	// the results of the vote search and the weighted vote search
+	resTxs := []*ctypes.ResultTx{
+		newVoteTxResult(t, cdc, 10, 0, types.NewMsgVote(voter1, 1, types.OptionNo)),
+		newVoteTxResult(t, cdc, 12, 1, types.NewMsgVote(voter1, 1, types.OptionAbstain)),
+		newVoteTxResult(t, cdc, 12, 3, types.NewMsgVote(voter2, 1, types.OptionYes)),
+		newVoteTxResult(t, cdc, 11, 0, types.NewMsgVote(voter2, 2, types.OptionYes)),
+		newVoteTxResult(t, cdc, 11, 2, types.NewMsgVoteWeighted(voter2, 1, weightedOptions)),
+		newVoteTxResult(t, cdc, 12, 2, types.NewMsgVoteWeighted(voter1, 1, weightedOptions)),
+	}
+	txs, err := parseVoteTxs(cdc, resTxs)
+	require.NoError(t, err)
+	require.Len(t, txs, len(resTxs))
+
+	// the later vote replaces the earlier one whatever search it's found by
+	votes := votesFromTxs(txs, 1)
+	require.Equal(t, []types.Vote{
+		types.NewWeightedVote(1, voter1, weightedOptions),
+		types.NewVote(1, voter2, types.OptionYes),
+	}, votes)
+
+	votes = votesFromTxs(txs, 2)
+	require.Equal(t, []types.Vote{types.NewVote(2, voter2, types.OptionYes)}, votes)
+}
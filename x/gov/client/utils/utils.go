@@ -1,6 +1,10 @@
 package utils
 
-import "github.com/okex/okexchain/x/gov/types"
+import (
+	"strings"
+
+	"github.com/okex/okexchain/x/gov/types"
+)
 
 // NormalizeVoteOption - normalize user specified vote option
 func NormalizeVoteOption(option string) string {
@@ -22,6 +26,17 @@ func NormalizeVoteOption(option string) string {
 	}
 }
 
+// NormalizeWeightedVoteOptions - normalize user specified vote options with weights, e.g. "yes=0.7,abstain=0.3"
+func NormalizeWeightedVoteOptions(options string) string {
+	fields := strings.Split(options, ",")
+	for i, field := range fields {
+		optionWeight := strings.SplitN(strings.TrimSpace(field), "=", 2)
+		optionWeight[0] = NormalizeVoteOption(optionWeight[0])
+		fields[i] = strings.Join(optionWeight, "=")
+	}
+	return strings.Join(fields, ",")
+}
+
 //NormalizeProposalType - normalize user specified proposal type
 func NormalizeProposalType(proposalType string) string {
 	switch proposalType {
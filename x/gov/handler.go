@@ -26,6 +26,9 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
 
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
+
 		default:
 			errMsg := fmt.Sprintf("unrecognized gov message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -104,12 +107,25 @@ func handleMsgDeposit(ctx sdk.Context, keeper keeper.Keeper, msg MsgDeposit) (*s
 }
 
 func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result, error) {
-	proposal, ok := k.GetProposal(ctx, msg.ProposalID)
+	if !types.ValidVoteOption(msg.Option) {
+		return sdk.EnvelopedErr{types.ErrInvalidVote(types.DefaultCodespace, msg.Option)}.Result()
+	}
+	return handleVote(ctx, k, msg.ProposalID, msg.Voter, types.NewNonSplitVoteOption(msg.Option))
+}
+
+func handleMsgVoteWeighted(ctx sdk.Context, k keeper.Keeper, msg MsgVoteWeighted) (*sdk.Result, error) {
+	return handleVote(ctx, k, msg.ProposalID, msg.Voter, msg.Options)
+}
+
+// handleVote adds or changes the vote of the voter, and tallies the proposal with the new vote
+func handleVote(ctx sdk.Context, k keeper.Keeper, proposalID uint64, voter sdk.AccAddress,
+	options types.WeightedVoteOptions) (*sdk.Result, error) {
+	proposal, ok := k.GetProposal(ctx, proposalID)
 	if !ok {
-		return sdk.EnvelopedErr{types.ErrUnknownProposal(types.DefaultCodespace, msg.ProposalID)}.Result()
+		return sdk.EnvelopedErr{types.ErrUnknownProposal(types.DefaultCodespace, proposalID)}.Result()
 	}
 
-	err, _ := k.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option)
+	err, _ := k.AddWeightedVote(ctx, proposalID, voter, options)
 	if err != nil {
 		return sdk.EnvelopedErr{err}.Result()
 	}
@@ -131,7 +147,7 @@ func handleMsgVote(ctx sdk.Context, k keeper.Keeper, msg MsgVote) (*sdk.Result,
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
-			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, voter.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalStatus, proposal.Status.String()),
 		),
 	)
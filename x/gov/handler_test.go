@@ -135,6 +135,36 @@ func TestHandleMsgVote3(t *testing.T) {
 	require.Equal(t, sdk.Coins(nil), gk.SupplyKeeper().GetModuleAccount(ctx, types.ModuleName).GetCoins())
 }
 
+func TestHandleMsgVoteWeighted(t *testing.T) {
+	ctx, _, gk, _, _ := keeper.CreateTestInput(t, false, 1000)
+	govHandler := NewHandler(gk)
+
+	proposalCoins := sdk.SysCoins{sdk.NewInt64DecCoin(sdk.DefaultBondDenom, 500)}
+	content := types.NewTextProposal("Test", "description")
+	newProposalMsg := NewMsgSubmitProposal(content, proposalCoins, keeper.Addrs[0])
+	res, err := govHandler(ctx, newProposalMsg)
+	require.Nil(t, err)
+	var proposalID uint64
+	gk.Cdc().MustUnmarshalBinaryLengthPrefixed(res.Data, &proposalID)
+
+	options := types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(6, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(4, 1)),
+	}
+	// the weights don't sum to 1
+	newVoteMsg := NewMsgVoteWeighted(keeper.Addrs[4], proposalID, options[:1])
+	_, err = govHandler(ctx, newVoteMsg)
+	require.NotNil(t, err)
+
+	newVoteMsg = NewMsgVoteWeighted(keeper.Addrs[4], 0, options)
+	_, err = govHandler(ctx, newVoteMsg)
+	require.NotNil(t, err)
+
+	newVoteMsg = NewMsgVoteWeighted(keeper.Addrs[4], proposalID, options)
+	_, err = govHandler(ctx, newVoteMsg)
+	require.Nil(t, err)
+}
+
 func TestHandleMsgSubmitProposal(t *testing.T) {
 	ctx, _, gk, _, _ := keeper.CreateTestInput(t, false, 1000)
 	log, err := flags.ParseLogLevel("*:error", ctx.Logger(), "error")
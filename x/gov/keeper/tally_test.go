@@ -11,6 +11,7 @@ import (
 
 	"github.com/okex/okexchain/x/gov/types"
 	"github.com/okex/okexchain/x/staking"
+	"github.com/okex/okexchain/x/staking/exported"
 )
 
 func newTallyResult(t *testing.T, totalVoted, yes, abstain, no, veto, totalVoting string) types.TallyResult {
@@ -313,3 +314,92 @@ func TestTallyDelegatorOverride(t *testing.T) {
 	require.Equal(t, types.StatusPassed, status)
 	require.Equal(t, expectedTallyResult, tallyResults)
 }
+
+func TestTallyWeightedVote(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx = ctx.WithBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	coin, err := sdk.ParseDecCoin("1.0" + common.NativeToken)
+	require.Nil(t, err)
+	delegator1Msg := staking.NewMsgDeposit(Addrs[3], coin)
+	stakingHandler(ctx, delegator1Msg)
+
+	addSharesMsg := staking.NewMsgAddShares(Addrs[3], []sdk.ValAddress{sdk.ValAddress(Addrs[2])})
+	stakingHandler(ctx, addSharesMsg)
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+	proposalID := proposal.ProposalID
+
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[0], types.OptionYes)
+	require.Nil(t, err)
+	err, _ = keeper.AddVote(ctx, proposalID, Addrs[1], types.OptionNo)
+	require.Nil(t, err)
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[2], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(5, 1)),
+		types.NewWeightedVoteOption(types.OptionNo, sdk.NewDecWithPrec(5, 1)),
+	})
+	require.Nil(t, err)
+	err, _ = keeper.AddWeightedVote(ctx, proposalID, Addrs[3], types.WeightedVoteOptions{
+		types.NewWeightedVoteOption(types.OptionYes, sdk.NewDecWithPrec(7, 1)),
+		types.NewWeightedVoteOption(types.OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	})
+	require.Nil(t, err)
+
+	// the power of the delegator is deducted from the validator, and every power is split by the weights
+	expectedTallyResult := newTallyResult(t, "4", "2.2", "0.3", "1.5", "0.0", "4")
+	status, dist, tallyResults := Tally(ctx, keeper, proposal, true)
+	require.False(t, dist)
+	require.Equal(t, types.StatusPassed, status)
+	require.Equal(t, expectedTallyResult, tallyResults)
+}
+
+func TestPreTallyVoterPowerRate(t *testing.T) {
+	ctx, _, keeper, sk, _ := CreateTestInput(t, false, 100000)
+	ctx = ctx.WithBlockHeight(int64(sk.GetEpoch(ctx)))
+	ctx = ctx.WithBlockTime(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC))
+	stakingHandler := staking.NewHandler(sk)
+	valAddrs := make([]sdk.ValAddress, len(Addrs[:3]))
+	for i, addr := range Addrs[:3] {
+		valAddrs[i] = sdk.ValAddress(addr)
+	}
+	CreateValidators(t, stakingHandler, ctx, valAddrs, []int64{5, 5, 5})
+	staking.EndBlocker(ctx, sk)
+
+	coin, err := sdk.ParseDecCoin("1.0" + common.NativeToken)
+	require.Nil(t, err)
+	stakingHandler(ctx, staking.NewMsgDeposit(Addrs[3], coin))
+	stakingHandler(ctx, staking.NewMsgAddShares(Addrs[3], []sdk.ValAddress{sdk.ValAddress(Addrs[2])}))
+
+	content := types.NewTextProposal("Test", "description")
+	proposal, err := keeper.SubmitProposal(ctx, content)
+	require.Nil(t, err)
+	proposal.Status = types.StatusVotingPeriod
+	keeper.SetProposal(ctx, proposal)
+
+	// the vote to be added by the delegator
+	vote := types.NewVote(proposal.ProposalID, Addrs[3], types.OptionNo)
+
+	totalShares := sdk.ZeroDec()
+	sk.IterateBondedValidatorsByPower(ctx, func(_ int64, validator exported.ValidatorI) bool {
+		totalShares = totalShares.Add(validator.GetDelegatorShares())
+		return false
+	})
+
+	// the power of the delegator overriding the vote of its validator counts in its voter power rate
+	_, _, voterPowerRate := preTally(ctx, keeper, proposal, &vote)
+	shares := sk.Delegator(ctx, Addrs[3]).GetLastAddedShares()
+	require.True(t, shares.IsPositive())
+	require.Equal(t, shares.Quo(totalShares), voterPowerRate)
+}
@@ -9,6 +9,17 @@ import (
 // AddVote adds a vote on a specific proposal
 func (keeper Keeper) AddVote(
 	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, option types.VoteOption,
+) (sdk.Error, string) {
+	if !types.ValidVoteOption(option) {
+		return types.ErrInvalidVote(keeper.codespace, option), ""
+	}
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, types.NewNonSplitVoteOption(option))
+}
+
+// AddWeightedVote adds a vote splitting the voting power among the options on a specific proposal, the previous vote
+// of the voter is replaced
+func (keeper Keeper) AddWeightedVote(
+	ctx sdk.Context, proposalID uint64, voterAddr sdk.AccAddress, options types.WeightedVoteOptions,
 ) (sdk.Error, string) {
 	proposal, ok := keeper.GetProposal(ctx, proposalID)
 	if !ok {
@@ -20,14 +31,12 @@ func (keeper Keeper) AddVote(
 				proposal.ProposalID, proposal.Status)), ""
 	}
 
-	if !types.ValidVoteOption(option) {
-		return types.ErrInvalidVote(keeper.codespace, option), ""
+	if err := types.ValidWeightedVoteOptions(options); err != nil {
+		return types.ErrInvalidWeightedVote(keeper.codespace, err.Error()), ""
 	}
 
 	voteFeeStr := ""
-	vote := types.Vote{
-		ProposalID: proposalID, Voter: voterAddr, Option: option,
-	}
+	vote := types.NewWeightedVote(proposalID, voterAddr, options)
 	if keeper.ProposalHandlerRouter().HasRoute(proposal.ProposalRoute()) {
 		var err sdk.Error
 		voteFeeStr, err = keeper.ProposalHandlerRouter().GetRoute(proposal.ProposalRoute()).VoteHandler(ctx, proposal, vote)
@@ -41,7 +50,7 @@ func (keeper Keeper) AddVote(
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyOption, option.String()),
+			sdk.NewAttribute(types.AttributeKeyOption, options.String()),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
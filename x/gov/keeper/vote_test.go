@@ -48,7 +48,7 @@ func TestKeeper_AddVote(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "", votefee)
 	vote, ok := keeper.GetVote(ctx, proposalID, Addrs[0])
-	expectedVote := types.Vote{ProposalID: proposalID, Voter: Addrs[0], Option: types.OptionYes}
+	expectedVote := types.NewVote(proposalID, Addrs[0], types.OptionYes)
 	require.True(t, ok)
 	require.Equal(t, expectedVote, vote)
 
@@ -56,7 +56,7 @@ func TestKeeper_AddVote(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "", votefee)
 	vote, ok = keeper.GetVote(ctx, proposalID, Addrs[0])
-	expectedVote = types.Vote{ProposalID: proposalID, Voter: Addrs[0], Option: types.OptionNo}
+	expectedVote = types.NewVote(proposalID, Addrs[0], types.OptionNo)
 	require.True(t, ok)
 	require.Equal(t, expectedVote, vote)
 }
@@ -113,16 +113,8 @@ func TestKeeper_GetVotes(t *testing.T) {
 	require.Equal(t, "", voteFee)
 
 	expectedVotes := types.Votes{
-		{
-			ProposalID: proposalID,
-			Voter:      Addrs[1],
-			Option:     types.OptionYes,
-		},
-		{
-			ProposalID: proposalID,
-			Voter:      Addrs[2],
-			Option:     types.OptionNo,
-		},
+		types.NewVote(proposalID, Addrs[1], types.OptionYes),
+		types.NewVote(proposalID, Addrs[2], types.OptionNo),
 	}
 	votes := keeper.GetVotes(ctx, proposalID)
 	require.Equal(t, expectedVotes, votes)
@@ -55,6 +55,10 @@ func ErrInvalidVote(codespace string, voteOption VoteOption) sdk.Error {
 	return sdkerrors.New(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption.String()))
 }
 
+func ErrInvalidWeightedVote(codespace string, msg string) sdk.Error {
+	return sdkerrors.New(codespace, CodeInvalidVote, fmt.Sprintf("invalid weighted vote: %s", msg))
+}
+
 func ErrInvalidGenesis(codespace string, msg string) sdk.Error {
 	return sdkerrors.New(codespace, CodeInvalidVote, msg)
 }
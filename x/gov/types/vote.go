@@ -3,24 +3,44 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Vote
 type Vote struct {
-	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
-	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`             //  address of the voter
-	Option     VoteOption     `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter
+	ProposalID uint64              `json:"proposal_id" yaml:"proposal_id"` //  proposalID of the proposal
+	Voter      sdk.AccAddress      `json:"voter" yaml:"voter"`             //  address of the voter
+	Option     VoteOption          `json:"option" yaml:"option"`           //  option from OptionSet chosen by the voter, it's empty for split votes
+	Options    WeightedVoteOptions `json:"options" yaml:"options"`         //  options chosen by the voter with the shares of the voting power
 }
 
 // NewVote creates a new Vote instance
 func NewVote(proposalID uint64, voter sdk.AccAddress, option VoteOption) Vote {
-	return Vote{proposalID, voter, option}
+	return Vote{proposalID, voter, option, NewNonSplitVoteOption(option)}
+}
+
+// NewWeightedVote creates a new Vote instance with the voting power split among the options
+func NewWeightedVote(proposalID uint64, voter sdk.AccAddress, options WeightedVoteOptions) Vote {
+	option := OptionEmpty
+	if len(options) == 1 {
+		option = options[0].Option
+	}
+	return Vote{proposalID, voter, option, options}
+}
+
+// GetOptions returns the weighted options of the vote, a vote stored before the split votes puts all the voting
+// power on its option
+func (v Vote) GetOptions() WeightedVoteOptions {
+	if len(v.Options) == 0 && v.Option != OptionEmpty {
+		return NewNonSplitVoteOption(v.Option)
+	}
+	return v.Options
 }
 
 func (v Vote) String() string {
-	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+	return fmt.Sprintf("voter %s voted with option %s on proposal %d", v.Voter, v.GetOptions(), v.ProposalID)
 }
 
 // Votes is a collection of Vote objects
@@ -32,7 +52,7 @@ func (v Votes) String() string {
 	}
 	out := fmt.Sprintf("Votes for Proposal %d:", v[0].ProposalID)
 	for _, vot := range v {
-		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.Option)
+		out += fmt.Sprintf("\n  %s: %s", vot.Voter, vot.GetOptions())
 	}
 	return out
 }
@@ -41,7 +61,8 @@ func (v Votes) String() string {
 func (v Vote) Equals(comp Vote) bool {
 	return v.Voter.Equals(comp.Voter) &&
 		v.ProposalID == comp.ProposalID &&
-		v.Option == comp.Option
+		v.Option == comp.Option &&
+		v.GetOptions().Equals(comp.GetOptions())
 }
 
 // Empty returns whether a vote is empty.
@@ -93,6 +114,108 @@ func ValidVoteOption(option VoteOption) bool {
 	return false
 }
 
+// WeightedVoteOption defines a vote option with the share of the voting power put on it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option" yaml:"option"`
+	Weight sdk.Dec    `json:"weight" yaml:"weight"`
+}
+
+// NewWeightedVoteOption creates a new WeightedVoteOption instance
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{option, weight}
+}
+
+// String implements the Stringer interface.
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions defines the options of a split vote
+type WeightedVoteOptions []WeightedVoteOption
+
+// NewNonSplitVoteOption creates the options of a vote putting all the voting power on one option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{{option, sdk.OneDec()}}
+}
+
+// String implements the Stringer interface. The option of a non-split vote is shown alone
+func (o WeightedVoteOptions) String() string {
+	if len(o) == 1 && o[0].Weight.Equal(sdk.OneDec()) {
+		return o[0].Option.String()
+	}
+	out := make([]string, len(o))
+	for i, option := range o {
+		out[i] = option.String()
+	}
+	return strings.Join(out, ",")
+}
+
+// Equals returns whether two weighted options are equal.
+func (o WeightedVoteOptions) Equals(comp WeightedVoteOptions) bool {
+	if len(o) != len(comp) {
+		return false
+	}
+	for i := range o {
+		if o[i].Option != comp[i].Option || !o[i].Weight.Equal(comp[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+// WeightedVoteOptionsFromString returns the weighted options from a string like "Yes=0.7,Abstain=0.3". An option
+// without the weight puts all the voting power on it. It returns an error if the string is invalid.
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, field := range strings.Split(str, ",") {
+		optionWeight := strings.Split(strings.TrimSpace(field), "=")
+		option, err := VoteOptionFromString(optionWeight[0])
+		if err != nil {
+			return nil, err
+		}
+
+		weight := sdk.OneDec()
+		if len(optionWeight) > 2 {
+			return nil, fmt.Errorf("'%s' is not a valid weighted vote option", field)
+		} else if len(optionWeight) == 2 {
+			if weight, err = sdk.NewDecFromStr(optionWeight[1]); err != nil {
+				return nil, fmt.Errorf("'%s' is not a valid weight of %s", optionWeight[1], option)
+			}
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, nil
+}
+
+// ValidWeightedVoteOptions returns an error if the options are empty or duplicated, any weight isn't in (0, 1], or the
+// weights don't sum to 1
+func ValidWeightedVoteOptions(options WeightedVoteOptions) error {
+	if len(options) == 0 {
+		return fmt.Errorf("empty vote options")
+	}
+
+	usedOptions := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, option := range options {
+		if !ValidVoteOption(option.Option) {
+			return fmt.Errorf("'%v' is not a valid voting option", option.Option)
+		}
+		if usedOptions[option.Option] {
+			return fmt.Errorf("duplicated voting option %s", option.Option)
+		}
+		if option.Weight.IsNil() || !option.Weight.IsPositive() || option.Weight.GT(sdk.OneDec()) {
+			return fmt.Errorf("weight of %s must be in (0, 1]", option.Option)
+		}
+		usedOptions[option.Option] = true
+		totalWeight = totalWeight.Add(option.Weight)
+	}
+
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("total weight %s of the voting options must be 1", totalWeight)
+	}
+	return nil
+}
+
 // Marshal needed for protobuf compatibility.
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
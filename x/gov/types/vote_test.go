@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.7, Abstain=0.3")
+	require.Nil(t, err)
+	require.True(t, options.Equals(WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(7, 1)),
+		NewWeightedVoteOption(OptionAbstain, sdk.NewDecWithPrec(3, 1)),
+	}))
+	require.Equal(t, "Yes=0.700000000000000000,Abstain=0.300000000000000000", options.String())
+
+	// the weight is 1 if it's omitted
+	options, err = WeightedVoteOptionsFromString("NoWithVeto")
+	require.Nil(t, err)
+	require.True(t, options.Equals(NewNonSplitVoteOption(OptionNoWithVeto)))
+	require.Equal(t, "NoWithVeto", options.String())
+
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.NotNil(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=abc")
+	require.NotNil(t, err)
+}
+
+func TestValidWeightedVoteOptions(t *testing.T) {
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		options WeightedVoteOptions
+		valid   bool
+	}{
+		{NewNonSplitVoteOption(OptionYes), true},
+		{WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{nil, false},
+		{NewNonSplitVoteOption(OptionEmpty), false},
+		{WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)),
+			NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+	}
+
+	for i, test := range tests {
+		err := ValidWeightedVoteOptions(test.options)
+		require.Equal(t, test.valid, err == nil, "test case %d", i)
+	}
+}
+
+func TestVoteGetOptions(t *testing.T) {
+	voter := sdk.AccAddress("voter")
+	// the votes stored before the weighted votes only have the option
+	vote := Vote{ProposalID: 1, Voter: voter, Option: OptionNo}
+	require.True(t, vote.GetOptions().Equals(NewNonSplitVoteOption(OptionNo)))
+	require.True(t, vote.Equals(NewVote(1, voter, OptionNo)))
+
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	vote = NewWeightedVote(1, voter, options)
+	require.Equal(t, OptionEmpty, vote.Option)
+	require.True(t, vote.GetOptions().Equals(options))
+	require.False(t, vote.Equals(NewVote(1, voter, OptionNo)))
+}
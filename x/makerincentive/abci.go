@@ -0,0 +1,54 @@
+package makerincentive
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// EndBlocker samples the depth books of the rewarded products once every SampleInterval blocks on average, and
+// distributes the budgets of the products to their makers at the end of the epoch. It's called after the matching of
+// order module
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	// the state of the module is initialized by the upgrade to version 1 on the chain started without it
+	if !k.IsInitialized(ctx) {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	blockHeight := ctx.BlockHeight()
+	budgets := k.GetProductBudgets(ctx)
+
+	if isSampleBlock(ctx.BlockHeader().LastBlockId.Hash, blockHeight, params.SampleInterval) {
+		for _, budget := range budgets {
+			k.SampleProduct(ctx, budget.Product, params.MaxSpread)
+		}
+	}
+
+	epoch := k.GetEpoch(ctx)
+	if blockHeight < epoch.EndHeight(params.EpochBlocks) {
+		return
+	}
+
+	for _, budget := range budgets {
+		k.DistributeProductRewards(ctx, budget.Product, epoch)
+	}
+	k.SetEpoch(ctx, types.Epoch{Number: epoch.Number + 1, StartHeight: blockHeight})
+	k.Logger(ctx).Info(fmt.Sprintf("BlockHeight<%d> epoch %d of the maker incentive ends", blockHeight,
+		epoch.Number))
+}
+
+// isSampleBlock tells whether the depth books are sampled at the height. The blocks sampled are picked by the hash of
+// the last block, so the makers can't foresee them and only quote around them
+func isSampleBlock(lastBlockHash []byte, height, sampleInterval int64) bool {
+	bz := make([]byte, len(lastBlockHash)+8)
+	copy(bz, lastBlockHash)
+	binary.BigEndian.PutUint64(bz[len(lastBlockHash):], uint64(height))
+	hash := sha256.Sum256(bz)
+	return binary.BigEndian.Uint64(hash[:8])%uint64(sampleInterval) == 0
+}
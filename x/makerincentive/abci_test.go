@@ -0,0 +1,126 @@
+package makerincentive
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+// testBlockContext returns the context of the block at the height, whose last block hash is faked from the height
+func testBlockContext(ctx sdk.Context, height int64) sdk.Context {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height-1))
+	hash := sha256.Sum256(bz)
+	header := ctx.BlockHeader()
+	header.Height = height
+	header.LastBlockId.Hash = hash[:]
+	return ctx.WithBlockHeader(header)
+}
+
+func TestEndBlocker(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	k := input.Keeper
+	makerA, makerB := input.TestAddrs[0], input.TestAddrs[1]
+	params := types.DefaultParams()
+	params.SampleInterval = 2
+	params.EpochBlocks = 10
+	k.SetParams(input.Ctx, params)
+	budget := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(100))
+	k.SetProductBudget(input.Ctx, ordertypes.TestTokenPair, budget)
+	input.DistrKeeper.CommunityPool = budget
+	require.Nil(t, k.FundRewardPool(input.Ctx, budget))
+
+	keeper.PlaceTestOrder(t, input, makerA, ordertypes.BuyOrder, "9.9", "1")
+	keeper.PlaceTestOrder(t, input, makerA, ordertypes.SellOrder, "10.1", "1")
+	var samples, samplesB int64
+	for height := int64(1); height < 10; height++ {
+		// makerB only quotes in the second half of the epoch
+		if height == 5 {
+			keeper.PlaceTestOrder(t, input, makerB, ordertypes.BuyOrder, "9.9", "1")
+		}
+		ctx := testBlockContext(input.Ctx, height)
+		if isSampleBlock(ctx.BlockHeader().LastBlockId.Hash, height, params.SampleInterval) {
+			samples++
+			if height >= 5 {
+				samplesB++
+			}
+		}
+		EndBlocker(ctx, k)
+	}
+	require.True(t, samplesB > 0 && samplesB < samples)
+	require.Equal(t, samples, k.GetProductSamples(input.Ctx, ordertypes.TestTokenPair))
+	require.Equal(t, samplesB, k.GetMakerScore(input.Ctx, ordertypes.TestTokenPair, makerB).Samples)
+
+	// the epoch ends at height 10, makerA quotes closer and longer than makerB
+	ctx := testBlockContext(input.Ctx, 10)
+	EndBlocker(ctx, k)
+	require.Equal(t, types.Epoch{Number: 2, StartHeight: 10}, k.GetEpoch(ctx))
+	require.EqualValues(t, 0, k.GetProductSamples(ctx, ordertypes.TestTokenPair))
+	rewardsA := k.GetClaimableRewards(ctx, makerA)
+	rewardsB := k.GetClaimableRewards(ctx, makerB)
+	require.True(t, rewardsA.AmountOf(common.NativeToken).GT(rewardsB.AmountOf(common.NativeToken)))
+	require.Equal(t, k.GetOutstandingRewards(ctx), rewardsA.Add2(rewardsB))
+	require.True(t, budget.IsAllGTE(rewardsA.Add2(rewardsB)))
+}
+
+func TestIsSampleBlock(t *testing.T) {
+	hash := sha256.Sum256([]byte("block"))
+	// every block is sampled with the interval 1
+	for height := int64(1); height <= 10; height++ {
+		require.True(t, isSampleBlock(hash[:], height, 1))
+	}
+
+	// the blocks sampled depend on the last block hash, about one in every interval blocks is sampled
+	var sampled, differs int
+	for height := int64(1); height <= 10000; height++ {
+		lastBlockHash := sha256.Sum256([]byte{byte(height), byte(height >> 8)})
+		isSample := isSampleBlock(lastBlockHash[:], height, 10)
+		if isSample {
+			sampled++
+		}
+		if isSample != isSampleBlock(hash[:], height, 10) {
+			differs++
+		}
+	}
+	require.InDelta(t, 1000, sampled, 150)
+	require.True(t, differs > 0)
+}
+
+func TestEndBlockerBeforeInitStore(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	k := input.Keeper
+	ctx := testBlockContext(input.Ctx, 100)
+
+	// the store of a chain started without the module
+	ctx.KVStore(input.StoreKey).Delete(types.EpochKey)
+	paramsStore := prefix.NewStore(ctx.KVStore(input.ParamsKey), []byte(types.DefaultParamspace+"/"))
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		paramsStore.Delete(pair.Key)
+	}
+	require.Panics(t, func() { k.GetParams(ctx) })
+	require.False(t, k.IsInitialized(ctx))
+	require.NotPanics(t, func() { EndBlocker(ctx, k) })
+
+	// the upgrade initializes the store, the first epoch starts from the upgrade height
+	k.InitStore(ctx)
+	require.True(t, k.IsInitialized(ctx))
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+	require.Equal(t, types.Epoch{Number: 1, StartHeight: 100}, k.GetEpoch(ctx))
+	require.NotPanics(t, func() { EndBlocker(testBlockContext(ctx, 101), k) })
+	require.True(t, k.GetRewardPoolBalance(ctx).IsZero())
+
+	// the state initialized already is kept
+	k.SetEpoch(ctx, types.Epoch{Number: 3, StartHeight: 90})
+	k.InitStore(ctx)
+	require.Equal(t, types.Epoch{Number: 3, StartHeight: 90}, k.GetEpoch(ctx))
+}
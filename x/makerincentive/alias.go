@@ -0,0 +1,22 @@
+package makerincentive
+
+import (
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+const (
+	StoreKey          = types.StoreKey
+	DefaultParamspace = types.DefaultParamspace
+	DefaultCodespace  = types.DefaultCodespace
+	ModuleName        = types.ModuleName
+	RouterKey         = types.RouterKey
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper = keeper.Keeper
+)
@@ -0,0 +1,207 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	client "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group makerincentive queries under a subcommand
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryScores(queryRoute, cdc),
+			GetCmdQueryRewards(queryRoute, cdc),
+			GetCmdQueryBudgets(queryRoute, cdc),
+			GetCmdQueryRewardPool(queryRoute, cdc),
+			GetCmdQueryParams(queryRoute, cdc),
+		)...,
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryScores gets the maker scores query command.
+func GetCmdQueryScores(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "scores [product]",
+		Short: "query the scores of the makers in a product in the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the scores of the makers in a product in the current epoch, and the rewards that they are
+expected to earn if the epoch ends now.
+
+Example:
+$ %s query makerincentive scores xxb_%s
+`,
+				version.ClientName, sdk.DefaultBondDenom,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryProductParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryScores)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var infos []types.MakerScoreInfo
+			cdc.MustUnmarshalJSON(resp, &infos)
+			return cliCtx.PrintOutput(infos)
+		},
+	}
+}
+
+// GetCmdQueryRewards gets the claimable rewards query command.
+func GetCmdQueryRewards(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "rewards [address]",
+		Short: "query the claimable rewards of an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the maker rewards that an account is able to claim.
+
+Example:
+$ %s query makerincentive rewards okexchain1hw4r48aww06ldrfeuq2v438ujnl6alszzzqpph
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bytes, err := cdc.MarshalJSON(types.NewQueryAddressParams(addr))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryRewards)
+			resp, _, err := cliCtx.QueryWithData(route, bytes)
+			if err != nil {
+				return err
+			}
+
+			var rewards sdk.SysCoins
+			cdc.MustUnmarshalJSON(resp, &rewards)
+			return cliCtx.PrintOutput(rewards)
+		},
+	}
+}
+
+// GetCmdQueryBudgets gets the product budgets query command.
+func GetCmdQueryBudgets(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "budgets",
+		Short: "query the reward budgets of all the rewarded products",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the rewards distributed to the makers of each product every epoch.
+
+Example:
+$ %s query makerincentive budgets
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryBudgets)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var budgets types.ProductBudgets
+			cdc.MustUnmarshalJSON(bz, &budgets)
+			return cliCtx.PrintOutput(budgets)
+		},
+	}
+}
+
+// GetCmdQueryRewardPool gets the reward pool query command.
+func GetCmdQueryRewardPool(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reward-pool",
+		Short: "query the reward pool and the current epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balance of the reward pool, the rewards distributed but not claimed yet, and the
+current epoch.
+
+Example:
+$ %s query makerincentive reward-pool
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryRewardPool)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var rewardPool types.RewardPool
+			cdc.MustUnmarshalJSON(bz, &rewardPool)
+			return cliCtx.PrintOutput(rewardPool)
+		},
+	}
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "query the current makerincentive parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as makerincentive parameters.
+
+Example:
+$ %s query makerincentive params
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryParameters)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(bz, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
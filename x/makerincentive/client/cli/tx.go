@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	client "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/okexchain/x/gov"
+	"github.com/spf13/cobra"
+
+	incentiveutils "github.com/okex/okexchain/x/makerincentive/client/utils"
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		GetCmdClaimRewards(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdClaimRewards implements the claim rewards command
+func GetCmdClaimRewards(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim",
+		Short: "claim the maker rewards",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim all the maker rewards distributed to the account.
+
+Example:
+$ %s tx makerincentive claim --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgClaimRewards(cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdFundRewardPoolProposal implements a command handler for submitting a fund reward pool proposal transaction
+func GetCmdFundRewardPoolProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fund-maker-reward-pool [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to fund the maker reward pool from the community pool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a fund maker reward pool proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal fund-maker-reward-pool <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "fund the maker reward pool",
+ "description": "move coins from the community pool to the maker reward pool",
+ "amount": [
+   {
+     "denom": "%s",
+     "amount": "10000"
+   }
+ ],
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := incentiveutils.ParseFundRewardPoolProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewFundRewardPoolProposal(proposal.Title, proposal.Description, proposal.Amount)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetProductBudgetProposal implements a command handler for submitting a set product budget proposal
+// transaction
+func GetCmdSetProductBudgetProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-maker-budget [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set the maker rewards of a product every epoch",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a set maker budget proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. The product stops being rewarded if the budget is empty.
+
+Example:
+$ %s tx gov submit-proposal set-maker-budget <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "set the maker budget of xxb_%s",
+ "description": "reward the makers of xxb_%s every epoch",
+ "product": "xxb_%s",
+ "budget": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ],
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom, sdk.DefaultBondDenom, sdk.DefaultBondDenom, sdk.DefaultBondDenom,
+				sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := incentiveutils.ParseSetProductBudgetProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewSetProductBudgetProposal(proposal.Title, proposal.Description, proposal.Product,
+				proposal.Budget)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,17 @@
+package client
+
+import (
+	govcli "github.com/okex/okexchain/x/gov/client"
+
+	"github.com/okex/okexchain/x/makerincentive/client/cli"
+	"github.com/okex/okexchain/x/makerincentive/client/rest"
+)
+
+var (
+	// FundRewardPoolProposalHandler alias gov NewProposalHandler
+	FundRewardPoolProposalHandler = govcli.NewProposalHandler(cli.GetCmdFundRewardPoolProposal,
+		rest.FundRewardPoolProposalRESTHandler)
+	// SetProductBudgetProposalHandler alias gov NewProposalHandler
+	SetProductBudgetProposalHandler = govcli.NewProposalHandler(cli.GetCmdSetProductBudgetProposal,
+		rest.SetProductBudgetProposalRESTHandler)
+)
@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get the scores of the makers in a product in the current epoch
+	r.HandleFunc(
+		"/makerincentive/scores/{product}",
+		queryScoresHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the claimable rewards of an account
+	r.HandleFunc(
+		"/makerincentive/rewards/{accAddr}",
+		queryRewardsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	// get the reward budgets of all the rewarded products
+	r.HandleFunc(
+		"/makerincentive/budgets",
+		queryWithoutParamsHandlerFn(cliCtx, types.QueryBudgets),
+	).Methods("GET")
+
+	// get the reward pool and the current epoch
+	r.HandleFunc(
+		"/makerincentive/reward_pool",
+		queryWithoutParamsHandlerFn(cliCtx, types.QueryRewardPool),
+	).Methods("GET")
+
+	// get the current makerincentive parameter values
+	r.HandleFunc(
+		"/makerincentive/parameters",
+		queryWithoutParamsHandlerFn(cliCtx, types.QueryParameters),
+	).Methods("GET")
+}
+
+func queryScoresHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(types.NewQueryProductParams(mux.Vars(r)["product"]))
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryScores)
+		res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		accAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["accAddr"])
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorInvalidAccountAddress)
+			return
+		}
+
+		jsonBytes, err := cliCtx.Codec.MarshalJSON(types.NewQueryAddressParams(accAddr))
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusBadRequest, common.ErrorCodecFails)
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryRewards)
+		res, height, err := cliCtx.QueryWithData(route, jsonBytes)
+		if err != nil {
+			common.HandleErrorResponseV2(w, http.StatusInternalServerError, common.ErrorABCIQueryFails)
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+func queryWithoutParamsHandlerFn(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -0,0 +1,22 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	govRest "github.com/okex/okexchain/x/gov/client/rest"
+)
+
+// RegisterRoutes registers makerincentive-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
+
+// FundRewardPoolProposalRESTHandler defines makerincentive fund reward pool proposal handler
+func FundRewardPoolProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
+
+// SetProductBudgetProposalRESTHandler defines makerincentive set product budget proposal handler
+func SetProductBudgetProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
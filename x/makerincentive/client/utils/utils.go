@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FundRewardPoolProposalJSON defines a FundRewardPoolProposal with a deposit used to parse fund reward pool
+// proposals from a JSON file.
+type FundRewardPoolProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Amount      sdk.SysCoins `json:"amount" yaml:"amount"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// SetProductBudgetProposalJSON defines a SetProductBudgetProposal with a deposit used to parse set product budget
+// proposals from a JSON file.
+type SetProductBudgetProposalJSON struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Product     string       `json:"product" yaml:"product"`
+	Budget      sdk.SysCoins `json:"budget" yaml:"budget"`
+	Deposit     sdk.SysCoins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseFundRewardPoolProposalJSON parses json from proposal file to FundRewardPoolProposalJSON struct
+func ParseFundRewardPoolProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal FundRewardPoolProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
+
+// ParseSetProductBudgetProposalJSON parses json from proposal file to SetProductBudgetProposalJSON struct
+func ParseSetProductBudgetProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal SetProductBudgetProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
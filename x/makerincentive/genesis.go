@@ -0,0 +1,64 @@
+package makerincentive
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// InitGenesis initializes the params, the budgets, the scores of the current epoch and the claimable rewards
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetEpoch(ctx, data.Epoch)
+
+	for _, budget := range data.Budgets {
+		k.SetProductBudget(ctx, budget.Product, budget.Budget)
+	}
+	for _, record := range data.MakerScores {
+		k.SetMakerScore(ctx, record.Product, record.Maker, record.Score)
+	}
+	for _, record := range data.ProductSamples {
+		k.SetProductSamples(ctx, record.Product, record.Samples)
+	}
+
+	var outstanding sdk.SysCoins
+	for _, record := range data.ClaimableRewards {
+		k.SetClaimableRewards(ctx, record.Address, record.Rewards)
+		outstanding = outstanding.Add2(record.Rewards)
+	}
+	k.SetOutstandingRewards(ctx, outstanding)
+
+	// the module account is created if it doesn't exist
+	if balance := k.GetRewardPoolBalance(ctx); !balance.IsAllGTE(outstanding) {
+		panic(fmt.Sprintf("the reward pool %s is insufficient for the claimable rewards %s", balance, outstanding))
+	}
+}
+
+// ExportGenesis writes the current store values to a genesis file, which can be imported again with InitGenesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	budgets := k.GetProductBudgets(ctx)
+
+	var scores []types.MakerScoreRecord
+	var samples []types.ProductSamplesRecord
+	for _, budget := range budgets {
+		product := budget.Product
+		k.IterateProductMakerScores(ctx, product, func(maker sdk.AccAddress, score types.MakerScore) bool {
+			scores = append(scores, types.MakerScoreRecord{Product: product, Maker: maker, Score: score})
+			return false
+		})
+		if productSamples := k.GetProductSamples(ctx, product); productSamples > 0 {
+			samples = append(samples, types.ProductSamplesRecord{Product: product, Samples: productSamples})
+		}
+	}
+
+	var rewards []types.ClaimableRewardsRecord
+	k.IterateClaimableRewards(ctx, func(addr sdk.AccAddress, claimable sdk.SysCoins) bool {
+		rewards = append(rewards, types.ClaimableRewardsRecord{Address: addr, Rewards: claimable})
+		return false
+	})
+
+	return types.NewGenesisState(k.GetParams(ctx), k.GetEpoch(ctx), budgets, scores, samples, rewards)
+}
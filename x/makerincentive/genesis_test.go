@@ -0,0 +1,40 @@
+package makerincentive
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+func TestInitAndExportGenesis(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	ctx, k := input.Ctx, input.Keeper
+	coins := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(100))
+	input.DistrKeeper.CommunityPool = coins
+	require.Nil(t, k.FundRewardPool(ctx, coins))
+
+	genesisState := types.NewGenesisState(
+		types.DefaultParams(),
+		types.Epoch{Number: 3, StartHeight: 100},
+		types.ProductBudgets{types.NewProductBudget(ordertypes.TestTokenPair, coins)},
+		[]types.MakerScoreRecord{{Product: ordertypes.TestTokenPair, Maker: input.TestAddrs[0],
+			Score: types.MakerScore{Score: sdk.NewDec(10), Samples: 1}}},
+		[]types.ProductSamplesRecord{{Product: ordertypes.TestTokenPair, Samples: 2}},
+		[]types.ClaimableRewardsRecord{{Address: input.TestAddrs[1], Rewards: coins}},
+	)
+	require.Nil(t, types.ValidateGenesis(genesisState))
+	InitGenesis(ctx, k, genesisState)
+	require.Equal(t, coins, k.GetOutstandingRewards(ctx))
+	require.Equal(t, genesisState, ExportGenesis(ctx, k))
+
+	// the reward pool can't afford the claimable rewards
+	genesisState.ClaimableRewards = append(genesisState.ClaimableRewards,
+		types.ClaimableRewardsRecord{Address: input.TestAddrs[0], Rewards: coins})
+	require.Panics(t, func() { InitGenesis(ctx, k, genesisState) })
+}
@@ -0,0 +1,52 @@
+package makerincentive
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/common/perf"
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// NewHandler creates an sdk.Handler for all the makerincentive type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		var handlerFun func() (*sdk.Result, error)
+		var name string
+		switch msg := msg.(type) {
+		case types.MsgClaimRewards:
+			name = "handleMsgClaimRewards"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgClaimRewards(ctx, k, msg)
+			}
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+
+		seq := perf.GetPerf().OnDeliverTxEnter(ctx, types.ModuleName, name)
+		defer perf.GetPerf().OnDeliverTxExit(ctx, types.ModuleName, name, seq)
+
+		res, err := handlerFun()
+		common.SanityCheckHandler(res, err)
+		return res, err
+	}
+}
+
+func handleMsgClaimRewards(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaimRewards) (*sdk.Result, error) {
+	rewards, err := k.ClaimRewards(ctx, msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaimRewards,
+		sdk.NewAttribute(types.AttributeKeyAddress, msg.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyRewards, rewards.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
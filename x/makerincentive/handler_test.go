@@ -0,0 +1,36 @@
+package makerincentive
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+func TestHandleMsgClaimRewards(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	ctx, k := input.Ctx, input.Keeper
+	handler := NewHandler(k)
+	addr := input.TestAddrs[0]
+	rewards := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(10))
+
+	_, err := handler(ctx, types.NewMsgClaimRewards(addr))
+	require.NotNil(t, err)
+
+	input.DistrKeeper.CommunityPool = rewards
+	require.Nil(t, k.FundRewardPool(ctx, rewards))
+	k.SetClaimableRewards(ctx, addr, rewards)
+	k.SetOutstandingRewards(ctx, rewards)
+	res, err := handler(ctx, types.NewMsgClaimRewards(addr))
+	require.Nil(t, err)
+	require.Equal(t, types.EventTypeClaimRewards, res.Events[len(res.Events)-1].Type)
+	require.True(t, k.GetClaimableRewards(ctx, addr).IsZero())
+	require.True(t, k.GetRewardPoolBalance(ctx).IsZero())
+
+	_, err = handler(ctx, sdk.NewTestMsg())
+	require.NotNil(t, err)
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/makerincentive/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+// SampleProduct scores the resting orders in the depth book of a product by their notional and their distance to
+// the mid price, and adds the scores to their makers. It returns false if the book is one-sided and no sample is taken
+func (k Keeper) SampleProduct(ctx sdk.Context, product string, maxSpread sdk.Dec) bool {
+	book := k.orderKeeper.GetDepthBookCopy(product)
+	midPrice, ok := getMidPrice(book)
+	if !ok {
+		return false
+	}
+
+	var makers []sdk.AccAddress
+	makerScores := make(map[string]sdk.Dec)
+	for _, item := range book.Items {
+		// the orders at the same price are all too far from the mid price
+		if types.OrderScore(item.Price, sdk.OneDec(), midPrice, maxSpread).IsZero() {
+			continue
+		}
+		for _, side := range []string{ordertypes.BuyOrder, ordertypes.SellOrder} {
+			key := ordertypes.FormatOrderIDsKey(product, item.Price, side)
+			for _, orderID := range k.orderKeeper.GetProductPriceOrderIDs(key) {
+				order := k.orderKeeper.GetOrder(ctx, orderID)
+				if order == nil {
+					continue
+				}
+				score := types.OrderScore(order.Price, order.RemainQuantity, midPrice, maxSpread)
+				if !score.IsPositive() {
+					continue
+				}
+				maker := order.Sender.String()
+				if _, ok := makerScores[maker]; !ok {
+					makers = append(makers, order.Sender)
+					makerScores[maker] = sdk.ZeroDec()
+				}
+				makerScores[maker] = makerScores[maker].Add(score)
+			}
+		}
+	}
+
+	k.SetProductSamples(ctx, product, k.GetProductSamples(ctx, product)+1)
+	for _, maker := range makers {
+		makerScore := k.GetMakerScore(ctx, product, maker)
+		makerScore.Score = makerScore.Score.Add(makerScores[maker.String()])
+		makerScore.Samples++
+		k.SetMakerScore(ctx, product, maker, makerScore)
+	}
+	return true
+}
+
+// getMidPrice returns the mid of the best bid and the best ask in the depth book, which is sorted by price desc
+func getMidPrice(book *ordertypes.DepthBook) (sdk.Dec, bool) {
+	bestBid, bestAsk := sdk.ZeroDec(), sdk.ZeroDec()
+	for _, item := range book.Items {
+		if item.BuyQuantity.IsPositive() {
+			bestBid = item.Price
+			break
+		}
+	}
+	for i := len(book.Items) - 1; i >= 0; i-- {
+		if book.Items[i].SellQuantity.IsPositive() {
+			bestAsk = book.Items[i].Price
+			break
+		}
+	}
+	if !bestBid.IsPositive() || !bestAsk.IsPositive() {
+		return sdk.ZeroDec(), false
+	}
+	return bestBid.Add(bestAsk).QuoInt64(2), true
+}
+
+// GetMakerScoreInfos returns the scores of the makers in a product in the current epoch, and the rewards that they
+// are expected to earn if the epoch ends now
+func (k Keeper) GetMakerScoreInfos(ctx sdk.Context, product string) (infos []types.MakerScoreInfo) {
+	productSamples := k.GetProductSamples(ctx, product)
+	totalScore := sdk.ZeroDec()
+	k.IterateProductMakerScores(ctx, product, func(maker sdk.AccAddress, score types.MakerScore) bool {
+		info := types.MakerScoreInfo{
+			Maker:          maker,
+			Score:          score.Score,
+			Samples:        score.Samples,
+			EffectiveScore: score.EffectiveScore(productSamples),
+			Share:          sdk.ZeroDec(),
+		}
+		totalScore = totalScore.Add(info.EffectiveScore)
+		infos = append(infos, info)
+		return false
+	})
+	if !totalScore.IsPositive() {
+		return infos
+	}
+
+	budget := k.GetProductBudget(ctx, product)
+	for i := range infos {
+		infos[i].Share = infos[i].EffectiveScore.Quo(totalScore)
+		infos[i].EstimatedReward = budget.MulDecTruncate(infos[i].Share)
+	}
+	return infos
+}
+
+// DistributeProductRewards distributes the budget of a product to its makers by their shares of the scores at the
+// end of an epoch, then the scores are cleared for the next epoch. Nothing is distributed if the reward pool can't
+// afford the budget
+func (k Keeper) DistributeProductRewards(ctx sdk.Context, product string, epoch types.Epoch) {
+	defer k.ClearProductScores(ctx, product)
+
+	budget := k.GetProductBudget(ctx, product)
+	infos := k.GetMakerScoreInfos(ctx, product)
+	if budget.IsZero() || len(infos) == 0 {
+		return
+	}
+
+	outstanding := k.GetOutstandingRewards(ctx)
+	available, negative := k.GetRewardPoolBalance(ctx).SafeSub(outstanding)
+	if negative || !available.IsAllGTE(budget) {
+		k.Logger(ctx).Info(fmt.Sprintf("the reward pool %s is insufficient for the budget %s of %s",
+			available, budget, product))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeInsufficientRewardPool,
+			sdk.NewAttribute(types.AttributeKeyProduct, product),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch.Number, 10)),
+			sdk.NewAttribute(types.AttributeKeyBudget, budget.String()),
+		))
+		return
+	}
+
+	var distributed sdk.SysCoins
+	for _, info := range infos {
+		if info.EstimatedReward.IsZero() {
+			continue
+		}
+		k.SetClaimableRewards(ctx, info.Maker, k.GetClaimableRewards(ctx, info.Maker).Add2(info.EstimatedReward))
+		distributed = distributed.Add2(info.EstimatedReward)
+	}
+	k.SetOutstandingRewards(ctx, outstanding.Add2(distributed))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDistributeRewards,
+		sdk.NewAttribute(types.AttributeKeyProduct, product),
+		sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(epoch.Number, 10)),
+		sdk.NewAttribute(types.AttributeKeyRewards, distributed.String()),
+	))
+}
+
+// ClaimRewards withdraws the claimable rewards of an address from the reward pool
+func (k Keeper) ClaimRewards(ctx sdk.Context, addr sdk.AccAddress) (sdk.SysCoins, sdk.Error) {
+	rewards := k.GetClaimableRewards(ctx, addr)
+	if rewards.IsZero() {
+		return nil, types.ErrNoClaimableRewards(types.DefaultCodespace, addr.String())
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, rewards); err != nil {
+		return nil, sdk.ErrInsufficientCoins(err.Error())
+	}
+	k.SetClaimableRewards(ctx, addr, nil)
+	k.SetOutstandingRewards(ctx, k.GetOutstandingRewards(ctx).Sub(rewards))
+	return rewards, nil
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+func TestSampleProduct(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.Keeper
+	makerA, makerB := input.TestAddrs[0], input.TestAddrs[1]
+	maxSpread := sdk.MustNewDecFromStr("0.02")
+
+	// one-sided book
+	PlaceTestOrder(t, input, makerA, ordertypes.BuyOrder, "9.9", "1")
+	require.False(t, keeper.SampleProduct(ctx, ordertypes.TestTokenPair, maxSpread))
+	require.EqualValues(t, 0, keeper.GetProductSamples(ctx, ordertypes.TestTokenPair))
+
+	// the mid price is 10, and the order at 9.8 is too far from it
+	PlaceTestOrder(t, input, makerA, ordertypes.SellOrder, "10.1", "1")
+	PlaceTestOrder(t, input, makerB, ordertypes.BuyOrder, "9.85", "2")
+	PlaceTestOrder(t, input, makerB, ordertypes.BuyOrder, "9.8", "10")
+	require.True(t, keeper.SampleProduct(ctx, ordertypes.TestTokenPair, maxSpread))
+	require.EqualValues(t, 1, keeper.GetProductSamples(ctx, ordertypes.TestTokenPair))
+	require.EqualValues(t, types.MakerScore{Score: sdk.MustNewDecFromStr("5"), Samples: 1},
+		keeper.GetMakerScore(ctx, ordertypes.TestTokenPair, makerA))
+	require.EqualValues(t, types.MakerScore{Score: sdk.MustNewDecFromStr("1.23125"), Samples: 1},
+		keeper.GetMakerScore(ctx, ordertypes.TestTokenPair, makerB))
+
+	require.True(t, keeper.SampleProduct(ctx, ordertypes.TestTokenPair, maxSpread))
+	require.EqualValues(t, 2, keeper.GetProductSamples(ctx, ordertypes.TestTokenPair))
+	require.EqualValues(t, types.MakerScore{Score: sdk.MustNewDecFromStr("10"), Samples: 2},
+		keeper.GetMakerScore(ctx, ordertypes.TestTokenPair, makerA))
+
+	keeper.ClearProductScores(ctx, ordertypes.TestTokenPair)
+	require.EqualValues(t, 0, keeper.GetProductSamples(ctx, ordertypes.TestTokenPair))
+	require.Empty(t, keeper.GetMakerScoreInfos(ctx, ordertypes.TestTokenPair))
+}
+
+func setTestScores(input TestInput) {
+	ctx, keeper := input.Ctx, input.Keeper
+	keeper.SetProductSamples(ctx, ordertypes.TestTokenPair, 2)
+	keeper.SetMakerScore(ctx, ordertypes.TestTokenPair, input.TestAddrs[0],
+		types.MakerScore{Score: sdk.NewDec(30), Samples: 2})
+	// the score of the maker only present in half of the samples is averaged over all the samples: 10 / 2 = 5
+	keeper.SetMakerScore(ctx, ordertypes.TestTokenPair, input.TestAddrs[1],
+		types.MakerScore{Score: sdk.NewDec(10), Samples: 1})
+}
+
+func TestDistributeProductRewards(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx.WithEventManager(sdk.NewEventManager()), input.Keeper
+	makerA, makerB := input.TestAddrs[0], input.TestAddrs[1]
+	budget := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(100))
+	keeper.SetProductBudget(ctx, ordertypes.TestTokenPair, budget)
+	epoch := types.Epoch{Number: 1}
+
+	// the reward pool is empty
+	setTestScores(input)
+	keeper.DistributeProductRewards(ctx, ordertypes.TestTokenPair, epoch)
+	require.True(t, keeper.GetClaimableRewards(ctx, makerA).IsZero())
+	require.Empty(t, keeper.GetMakerScoreInfos(ctx, ordertypes.TestTokenPair))
+	require.Equal(t, types.EventTypeInsufficientRewardPool, ctx.EventManager().Events()[0].Type)
+
+	input.DistrKeeper.CommunityPool = sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(150))
+	require.Nil(t, keeper.FundRewardPool(ctx, input.DistrKeeper.CommunityPool))
+	setTestScores(input)
+	infos := keeper.GetMakerScoreInfos(ctx, ordertypes.TestTokenPair)
+	require.Equal(t, 2, len(infos))
+	keeper.DistributeProductRewards(ctx, ordertypes.TestTokenPair, epoch)
+	require.Equal(t, sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(75)), keeper.GetClaimableRewards(ctx, makerA))
+	require.Equal(t, sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(25)), keeper.GetClaimableRewards(ctx, makerB))
+	require.Equal(t, budget, keeper.GetOutstandingRewards(ctx))
+
+	// only 50 is left for the next epoch
+	setTestScores(input)
+	keeper.DistributeProductRewards(ctx, ordertypes.TestTokenPair, epoch)
+	require.Equal(t, budget, keeper.GetOutstandingRewards(ctx))
+
+	// claim
+	balance := input.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+	rewards, err := keeper.ClaimRewards(ctx, makerA)
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(75)), rewards)
+	require.Equal(t, sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(25)), keeper.GetOutstandingRewards(ctx))
+	require.Equal(t, balance.Sub(rewards), keeper.GetRewardPoolBalance(ctx))
+	_, err = keeper.ClaimRewards(ctx, makerA)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// Keeper of the makerincentive store
+type Keeper struct {
+	storeKey      sdk.StoreKey
+	cdc           *codec.Codec
+	paramSubspace types.ParamSubspace
+	orderKeeper   types.OrderKeeper
+	dexKeeper     types.DexKeeper
+	supplyKeeper  types.SupplyKeeper
+	distrKeeper   types.DistrKeeper
+	govKeeper     types.GovKeeper
+}
+
+// NewKeeper creates a makerincentive keeper
+func NewKeeper(orderKeeper types.OrderKeeper, dexKeeper types.DexKeeper, supplyKeeper types.SupplyKeeper,
+	distrKeeper types.DistrKeeper, paramSubspace types.ParamSubspace, key sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSubspace: paramSubspace.WithKeyTable(types.ParamKeyTable()),
+		orderKeeper:   orderKeeper,
+		dexKeeper:     dexKeeper,
+		supplyKeeper:  supplyKeeper,
+		distrKeeper:   distrKeeper,
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
+
+// GetRewardPoolBalance returns the coins held by the reward pool, including the outstanding rewards
+func (k Keeper) GetRewardPoolBalance(ctx sdk.Context) sdk.SysCoins {
+	return k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+}
+
+// IsInitialized returns whether the state of the module has been initialized, by the genesis or by InitStore
+func (k Keeper) IsInitialized(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(types.EpochKey)
+}
+
+// InitStore initializes the state of the module on a chain started without it, with the default params, the first
+// epoch starting from the current block and the module account of the reward pool. The state initialized already
+// is kept
+func (k Keeper) InitStore(ctx sdk.Context) {
+	if k.IsInitialized(ctx) {
+		return
+	}
+	k.SetParams(ctx, types.DefaultParams())
+	k.SetEpoch(ctx, types.Epoch{Number: 1, StartHeight: ctx.BlockHeight()})
+	// the module account is created if it doesn't exist
+	k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkGov "github.com/okex/okexchain/x/gov"
+	govKeeper "github.com/okex/okexchain/x/gov/keeper"
+	govTypes "github.com/okex/okexchain/x/gov/types"
+
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+var _ govKeeper.ProposalHandler = (*Keeper)(nil)
+
+// GetMinDeposit returns min deposit
+func (k Keeper) GetMinDeposit(ctx sdk.Context, content sdkGov.Content) (minDeposit sdk.SysCoins) {
+	switch content.(type) {
+	case types.FundRewardPoolProposal, types.SetProductBudgetProposal:
+		minDeposit = k.govKeeper.GetDepositParams(ctx).MinDeposit
+	}
+
+	return
+}
+
+// GetMaxDepositPeriod returns max deposit period
+func (k Keeper) GetMaxDepositPeriod(ctx sdk.Context, content sdkGov.Content) (maxDepositPeriod time.Duration) {
+	switch content.(type) {
+	case types.FundRewardPoolProposal, types.SetProductBudgetProposal:
+		maxDepositPeriod = k.govKeeper.GetDepositParams(ctx).MaxDepositPeriod
+	}
+
+	return
+}
+
+// GetVotingPeriod returns voting period
+func (k Keeper) GetVotingPeriod(ctx sdk.Context, content sdkGov.Content) (votingPeriod time.Duration) {
+	switch content.(type) {
+	case types.FundRewardPoolProposal, types.SetProductBudgetProposal:
+		votingPeriod = k.govKeeper.GetVotingParams(ctx).VotingPeriod
+	}
+
+	return
+}
+
+// CheckMsgSubmitProposal validates MsgSubmitProposal
+func (k Keeper) CheckMsgSubmitProposal(ctx sdk.Context, msg govTypes.MsgSubmitProposal) sdk.Error {
+	switch content := msg.Content.(type) {
+	case types.FundRewardPoolProposal:
+		return k.CheckFundRewardPoolProposal(ctx, content)
+	case types.SetProductBudgetProposal:
+		return k.CheckSetProductBudgetProposal(ctx, content)
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized makerincentive proposal content type: %T", content))
+	}
+}
+
+// nolint
+func (k Keeper) AfterSubmitProposalHandler(_ sdk.Context, _ govTypes.Proposal) {}
+func (k Keeper) AfterDepositPeriodPassed(_ sdk.Context, _ govTypes.Proposal)   {}
+func (k Keeper) RejectedHandler(_ sdk.Context, _ govTypes.Content)             {}
+func (k Keeper) VoteHandler(_ sdk.Context, _ govTypes.Proposal, _ govTypes.Vote) (string, sdk.Error) {
+	return "", nil
+}
+
+// CheckFundRewardPoolProposal checks that the community pool is able to fund the reward pool
+func (k Keeper) CheckFundRewardPoolProposal(ctx sdk.Context, proposal types.FundRewardPoolProposal) sdk.Error {
+	communityPool := k.distrKeeper.GetFeePoolCommunityCoins(ctx)
+	if !communityPool.IsAllGTE(proposal.Amount) {
+		return types.ErrInsufficientCommunityPool(types.DefaultCodespace, proposal.Amount.String(),
+			communityPool.String())
+	}
+	return nil
+}
+
+// CheckSetProductBudgetProposal checks that the product to be rewarded exists
+func (k Keeper) CheckSetProductBudgetProposal(ctx sdk.Context, proposal types.SetProductBudgetProposal) sdk.Error {
+	// a product that's delisted can still be removed
+	if proposal.Budget.IsZero() {
+		return nil
+	}
+	if k.dexKeeper.GetTokenPair(ctx, proposal.Product) == nil {
+		return types.ErrTokenPairNotExist(types.DefaultCodespace, proposal.Product)
+	}
+	return nil
+}
+
+// FundRewardPool moves the coins from the community pool to the reward pool
+func (k Keeper) FundRewardPool(ctx sdk.Context, amount sdk.SysCoins) sdk.Error {
+	if err := k.distrKeeper.DistributeFromFeePoolToModule(ctx, amount, types.ModuleName); err != nil {
+		return types.ErrInsufficientCommunityPool(types.DefaultCodespace, amount.String(), err.Error())
+	}
+	k.Logger(ctx).Info(fmt.Sprintf("transferred %s from the community pool to the reward pool", amount))
+	return nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+func TestCheckFundRewardPoolProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.Keeper
+	amount := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(100))
+	proposal := types.NewFundRewardPoolProposal("title", "description", amount)
+
+	require.NotNil(t, keeper.CheckFundRewardPoolProposal(ctx, proposal))
+	require.NotNil(t, keeper.FundRewardPool(ctx, amount))
+
+	input.DistrKeeper.CommunityPool = amount
+	require.Nil(t, keeper.CheckFundRewardPoolProposal(ctx, proposal))
+	require.Nil(t, keeper.FundRewardPool(ctx, amount))
+	require.Equal(t, amount, keeper.GetRewardPoolBalance(ctx))
+	require.True(t, input.DistrKeeper.CommunityPool.IsZero())
+}
+
+func TestCheckSetProductBudgetProposal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.Keeper
+	budget := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(100))
+
+	proposal := types.NewSetProductBudgetProposal("title", "description", ordertypes.TestTokenPair, budget)
+	require.Nil(t, keeper.CheckSetProductBudgetProposal(ctx, proposal))
+
+	proposal.Product = "nonexistent_" + common.NativeToken
+	require.NotNil(t, keeper.CheckSetProductBudgetProposal(ctx, proposal))
+
+	// a product that doesn't exist can be removed
+	proposal.Budget = nil
+	require.Nil(t, keeper.CheckSetProductBudgetProposal(ctx, proposal))
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// NewQuerier creates a new querier for makerincentive clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParameters:
+			return queryParams(ctx, k)
+		case types.QueryBudgets:
+			return queryBudgets(ctx, k)
+		case types.QueryScores:
+			return queryScores(ctx, req, k)
+		case types.QueryRewards:
+			return queryRewards(ctx, req, k)
+		case types.QueryRewardPool:
+			return queryRewardPool(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("failed. unknown makerincentive query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	return marshalJSONIndent(k.GetParams(ctx))
+}
+
+func queryBudgets(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	budgets := k.GetProductBudgets(ctx)
+	if budgets == nil {
+		budgets = types.ProductBudgets{}
+	}
+	return marshalJSONIndent(budgets)
+}
+
+func queryScores(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProductParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, defaultQueryErrParseParams(err)
+	}
+
+	infos := k.GetMakerScoreInfos(ctx, params.Product)
+	if infos == nil {
+		infos = []types.MakerScoreInfo{}
+	}
+	return marshalJSONIndent(infos)
+}
+
+func queryRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAddressParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, defaultQueryErrParseParams(err)
+	}
+
+	rewards := k.GetClaimableRewards(ctx, params.Address)
+	if rewards == nil {
+		rewards = sdk.SysCoins{}
+	}
+	return marshalJSONIndent(rewards)
+}
+
+func queryRewardPool(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	rewardPool := types.RewardPool{
+		Epoch:       k.GetEpoch(ctx),
+		Balance:     k.GetRewardPoolBalance(ctx),
+		Outstanding: k.GetOutstandingRewards(ctx),
+	}
+	return marshalJSONIndent(rewardPool)
+}
+
+func marshalJSONIndent(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, defaultQueryErrJSONMarshal(err)
+	}
+	return res, nil
+}
+
+func defaultQueryErrJSONMarshal(err error) sdk.Error {
+	return sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", err.Error()))
+}
+
+func defaultQueryErrParseParams(err error) sdk.Error {
+	return sdk.ErrInternal(fmt.Sprintf("failed to parse params. %s", err))
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+func TestQuerier(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.Keeper
+	querier := NewQuerier(keeper)
+	budget := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(100))
+	keeper.SetProductBudget(ctx, ordertypes.TestTokenPair, budget)
+	setTestScores(input)
+	keeper.SetClaimableRewards(ctx, input.TestAddrs[0], budget)
+
+	// params
+	bz, err := querier(ctx, []string{types.QueryParameters}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var params types.Params
+	types.ModuleCdc.MustUnmarshalJSON(bz, &params)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// budgets
+	bz, err = querier(ctx, []string{types.QueryBudgets}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var budgets types.ProductBudgets
+	types.ModuleCdc.MustUnmarshalJSON(bz, &budgets)
+	require.Equal(t, types.ProductBudgets{types.NewProductBudget(ordertypes.TestTokenPair, budget)}, budgets)
+
+	// scores
+	bz, err = querier(ctx, []string{types.QueryScores}, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryProductParams(ordertypes.TestTokenPair)),
+	})
+	require.Nil(t, err)
+	var infos []types.MakerScoreInfo
+	types.ModuleCdc.MustUnmarshalJSON(bz, &infos)
+	require.Equal(t, 2, len(infos))
+	for _, info := range infos {
+		if info.Maker.Equals(input.TestAddrs[0]) {
+			require.Equal(t, sdk.MustNewDecFromStr("0.75"), info.Share)
+		} else {
+			require.Equal(t, sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(25)), info.EstimatedReward)
+		}
+	}
+
+	// rewards
+	bz, err = querier(ctx, []string{types.QueryRewards}, abci.RequestQuery{
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAddressParams(input.TestAddrs[0])),
+	})
+	require.Nil(t, err)
+	var rewards sdk.SysCoins
+	types.ModuleCdc.MustUnmarshalJSON(bz, &rewards)
+	require.Equal(t, budget, rewards)
+
+	// reward pool
+	bz, err = querier(ctx, []string{types.QueryRewardPool}, abci.RequestQuery{})
+	require.Nil(t, err)
+	var rewardPool types.RewardPool
+	types.ModuleCdc.MustUnmarshalJSON(bz, &rewardPool)
+	require.EqualValues(t, 1, rewardPool.Epoch.Number)
+
+	_, err = querier(ctx, []string{"unknown"}, abci.RequestQuery{})
+	require.NotNil(t, err)
+	_, err = querier(ctx, []string{types.QueryScores}, abci.RequestQuery{Data: []byte("invalid")})
+	require.NotNil(t, err)
+}
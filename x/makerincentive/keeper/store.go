@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// GetProductBudget returns the reward budget of a product, or nil if the product isn't rewarded
+func (k Keeper) GetProductBudget(ctx sdk.Context, product string) sdk.SysCoins {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProductBudgetKey(product))
+	if bz == nil {
+		return nil
+	}
+	var budget sdk.SysCoins
+	k.cdc.MustUnmarshalBinaryBare(bz, &budget)
+	return budget
+}
+
+// SetProductBudget sets the reward budget of a product, the product stops being rewarded if the budget is empty
+func (k Keeper) SetProductBudget(ctx sdk.Context, product string, budget sdk.SysCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if budget.IsZero() {
+		store.Delete(types.GetProductBudgetKey(product))
+		return
+	}
+	store.Set(types.GetProductBudgetKey(product), k.cdc.MustMarshalBinaryBare(budget))
+}
+
+// GetProductBudgets returns the reward budgets of all the rewarded products
+func (k Keeper) GetProductBudgets(ctx sdk.Context) (budgets types.ProductBudgets) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ProductBudgetPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var budget sdk.SysCoins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &budget)
+		budgets = append(budgets, types.NewProductBudget(types.SplitProductBudgetKey(iterator.Key()), budget))
+	}
+	return budgets
+}
+
+// GetMakerScore returns the score of a maker in a product in the current epoch
+func (k Keeper) GetMakerScore(ctx sdk.Context, product string, maker sdk.AccAddress) types.MakerScore {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetMakerScoreKey(product, maker))
+	if bz == nil {
+		return types.MakerScore{Score: sdk.ZeroDec()}
+	}
+	var score types.MakerScore
+	k.cdc.MustUnmarshalBinaryBare(bz, &score)
+	return score
+}
+
+// SetMakerScore sets the score of a maker in a product in the current epoch
+func (k Keeper) SetMakerScore(ctx sdk.Context, product string, maker sdk.AccAddress, score types.MakerScore) {
+	ctx.KVStore(k.storeKey).Set(types.GetMakerScoreKey(product, maker), k.cdc.MustMarshalBinaryBare(score))
+}
+
+// IterateProductMakerScores iterates over the scores of all the makers in a product
+func (k Keeper) IterateProductMakerScores(ctx sdk.Context, product string,
+	handler func(maker sdk.AccAddress, score types.MakerScore) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.GetProductMakerScoresPrefix(product))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var score types.MakerScore
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &score)
+		_, maker := types.SplitMakerScoreKey(iterator.Key())
+		if handler(maker, score) {
+			break
+		}
+	}
+}
+
+// GetProductSamples returns the number of the samples taken of a product in the current epoch
+func (k Keeper) GetProductSamples(ctx sdk.Context, product string) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetProductSamplesKey(product))
+	if bz == nil {
+		return 0
+	}
+	var samples int64
+	k.cdc.MustUnmarshalBinaryBare(bz, &samples)
+	return samples
+}
+
+// SetProductSamples sets the number of the samples taken of a product in the current epoch
+func (k Keeper) SetProductSamples(ctx sdk.Context, product string, samples int64) {
+	ctx.KVStore(k.storeKey).Set(types.GetProductSamplesKey(product), k.cdc.MustMarshalBinaryBare(samples))
+}
+
+// ClearProductScores drops the samples and the maker scores of a product
+func (k Keeper) ClearProductScores(ctx sdk.Context, product string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetProductMakerScoresPrefix(product))
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.GetProductSamplesKey(product))
+}
+
+// GetClaimableRewards returns the rewards that an address is able to claim
+func (k Keeper) GetClaimableRewards(ctx sdk.Context, addr sdk.AccAddress) sdk.SysCoins {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetClaimableRewardsKey(addr))
+	if bz == nil {
+		return nil
+	}
+	var rewards sdk.SysCoins
+	k.cdc.MustUnmarshalBinaryBare(bz, &rewards)
+	return rewards
+}
+
+// SetClaimableRewards sets the rewards that an address is able to claim
+func (k Keeper) SetClaimableRewards(ctx sdk.Context, addr sdk.AccAddress, rewards sdk.SysCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if rewards.IsZero() {
+		store.Delete(types.GetClaimableRewardsKey(addr))
+		return
+	}
+	store.Set(types.GetClaimableRewardsKey(addr), k.cdc.MustMarshalBinaryBare(rewards))
+}
+
+// IterateClaimableRewards iterates over the claimable rewards of all the addresses
+func (k Keeper) IterateClaimableRewards(ctx sdk.Context,
+	handler func(addr sdk.AccAddress, rewards sdk.SysCoins) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ClaimableRewardsPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var rewards sdk.SysCoins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &rewards)
+		if handler(types.SplitClaimableRewardsKey(iterator.Key()), rewards) {
+			break
+		}
+	}
+}
+
+// GetEpoch returns the current epoch
+func (k Keeper) GetEpoch(ctx sdk.Context) (epoch types.Epoch) {
+	bz := ctx.KVStore(k.storeKey).Get(types.EpochKey)
+	if bz == nil {
+		return
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &epoch)
+	return
+}
+
+// SetEpoch sets the current epoch
+func (k Keeper) SetEpoch(ctx sdk.Context, epoch types.Epoch) {
+	ctx.KVStore(k.storeKey).Set(types.EpochKey, k.cdc.MustMarshalBinaryBare(epoch))
+}
+
+// GetOutstandingRewards returns the total rewards distributed but not claimed yet
+func (k Keeper) GetOutstandingRewards(ctx sdk.Context) sdk.SysCoins {
+	bz := ctx.KVStore(k.storeKey).Get(types.OutstandingRewardsKey)
+	if bz == nil {
+		return nil
+	}
+	var rewards sdk.SysCoins
+	k.cdc.MustUnmarshalBinaryBare(bz, &rewards)
+	return rewards
+}
+
+// SetOutstandingRewards sets the total rewards distributed but not claimed yet
+func (k Keeper) SetOutstandingRewards(ctx sdk.Context, rewards sdk.SysCoins) {
+	store := ctx.KVStore(k.storeKey)
+	if rewards.IsZero() {
+		store.Delete(types.OutstandingRewardsKey)
+		return
+	}
+	store.Set(types.OutstandingRewardsKey, k.cdc.MustMarshalBinaryBare(rewards))
+}
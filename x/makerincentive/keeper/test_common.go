@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/common/monitor"
+	"github.com/okex/okexchain/x/dex"
+	"github.com/okex/okexchain/x/makerincentive/types"
+	orderkeeper "github.com/okex/okexchain/x/order/keeper"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+	"github.com/okex/okexchain/x/params"
+	"github.com/okex/okexchain/x/token"
+)
+
+// TestInput stores some variables for testing
+type TestInput struct {
+	Ctx       sdk.Context
+	Cdc       *codec.Codec
+	TestAddrs []sdk.AccAddress
+
+	Keeper       Keeper
+	OrderKeeper  orderkeeper.Keeper
+	DexKeeper    dex.Keeper
+	SupplyKeeper supply.Keeper
+	DistrKeeper  *MockDistrKeeper
+
+	StoreKey  sdk.StoreKey
+	ParamsKey sdk.StoreKey
+}
+
+// MockDistrKeeper mocks the community pool of distribution module, the coins are minted when they're distributed
+type MockDistrKeeper struct {
+	CommunityPool sdk.SysCoins
+	supplyKeeper  supply.Keeper
+}
+
+// GetFeePoolCommunityCoins returns the coins in the mocked community pool
+func (dk *MockDistrKeeper) GetFeePoolCommunityCoins(_ sdk.Context) sdk.SysCoins {
+	return dk.CommunityPool
+}
+
+// DistributeFromFeePoolToModule distributes the coins in the mocked community pool to a module account
+func (dk *MockDistrKeeper) DistributeFromFeePoolToModule(ctx sdk.Context, amount sdk.SysCoins,
+	recipientModule string) error {
+	newPool, negative := dk.CommunityPool.SafeSub(amount)
+	if negative {
+		return fmt.Errorf("insufficient community pool %s", dk.CommunityPool)
+	}
+	dk.CommunityPool = newPool
+	if err := dk.supplyKeeper.MintCoins(ctx, token.ModuleName, amount); err != nil {
+		return err
+	}
+	return dk.supplyKeeper.SendCoinsFromModuleToModule(ctx, token.ModuleName, recipientModule, amount)
+}
+
+// CreateTestInput creates TestInput with two accounts, and the built-in token pair listed
+func CreateTestInput(t *testing.T) TestInput {
+	common.InitConfig()
+	db := dbm.NewMemDB()
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyOrder := sdk.NewKVStoreKey(ordertypes.OrderStoreKey)
+	keyToken := sdk.NewKVStoreKey(token.StoreKey)
+	keyLock := sdk.NewKVStoreKey(token.KeyLock)
+	keyDex := sdk.NewKVStoreKey(dex.StoreKey)
+	keyTokenPair := sdk.NewKVStoreKey(dex.TokenPairStoreKey)
+	keyIncentive := sdk.NewKVStoreKey(types.StoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	for _, key := range []sdk.StoreKey{keyAcc, keySupply, keyParams, keyOrder, keyToken, keyLock, keyDex, keyTokenPair,
+		keyIncentive} {
+		ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	}
+	require.Nil(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, log.NewTMLogger(os.Stdout))
+	cdc := orderkeeper.MakeTestCodec()
+	types.RegisterCodec(cdc)
+
+	feeCollectorAcc := supply.NewEmptyModuleAccount(auth.FeeCollectorName)
+	blacklistedAddrs := map[string]bool{feeCollectorAcc.String(): true}
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc,
+		paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace),
+		blacklistedAddrs)
+	maccPerms := map[string][]string{
+		auth.FeeCollectorName: nil,
+		token.ModuleName:      {supply.Minter, supply.Burner},
+		types.ModuleName:      nil,
+	}
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	supplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.Coins{}))
+	supplyKeeper.SetModuleAccount(ctx, feeCollectorAcc)
+
+	tokenKeeper := token.NewKeeper(bankKeeper, paramsKeeper.Subspace(token.DefaultParamspace),
+		auth.FeeCollectorName, supplyKeeper, keyToken, keyLock, cdc, true)
+
+	dexKeeper := dex.NewKeeper(auth.FeeCollectorName, supplyKeeper, paramsKeeper.Subspace(dex.DefaultParamspace),
+		tokenKeeper, nil, bankKeeper, keyDex, keyTokenPair, cdc)
+	dexKeeper.SetParams(ctx, *dex.DefaultParams())
+	require.Nil(t, dexKeeper.SaveTokenPair(ctx, dex.GetBuiltInTokenPair()))
+
+	orderKeeper := orderkeeper.NewKeeper(tokenKeeper, supplyKeeper, dexKeeper,
+		paramsKeeper.Subspace(ordertypes.DefaultParamspace), auth.FeeCollectorName, keyOrder,
+		cdc, true, monitor.NopOrderMetrics())
+	orderParams := ordertypes.DefaultTestParams()
+	orderKeeper.SetParams(ctx, &orderParams)
+
+	distrKeeper := &MockDistrKeeper{supplyKeeper: supplyKeeper}
+	keeper := NewKeeper(orderKeeper, dexKeeper, supplyKeeper, distrKeeper,
+		paramsKeeper.Subspace(types.DefaultParamspace), keyIncentive, cdc)
+	keeper.SetParams(ctx, types.DefaultParams())
+	keeper.SetEpoch(ctx, types.Epoch{Number: 1})
+
+	initCoins, err := sdk.ParseDecCoins(fmt.Sprintf("1000%s,1000%s", common.NativeToken, common.TestToken))
+	require.Nil(t, err)
+	var testAddrs []sdk.AccAddress
+	for i := 0; i < 2; i++ {
+		addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+		testAddrs = append(testAddrs, addr)
+		require.Nil(t, supplyKeeper.MintCoins(ctx, token.ModuleName, initCoins))
+		require.Nil(t, supplyKeeper.SendCoinsFromModuleToAccount(ctx, token.ModuleName, addr, initCoins))
+	}
+
+	return TestInput{ctx, cdc, testAddrs, keeper, orderKeeper, dexKeeper, supplyKeeper, distrKeeper, keyIncentive,
+		keyParams}
+}
+
+// PlaceTestOrder places an open order of the built-in token pair
+func PlaceTestOrder(t *testing.T, input TestInput, sender sdk.AccAddress, side, price, quantity string) {
+	order := ordertypes.MockOrder("", ordertypes.TestTokenPair, side, price, quantity)
+	order.Sender = sender
+	require.Nil(t, input.OrderKeeper.PlaceOrder(input.Ctx, order))
+}
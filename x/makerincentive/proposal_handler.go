@@ -0,0 +1,42 @@
+package makerincentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/okex/okexchain/x/gov/types"
+
+	"github.com/okex/okexchain/x/makerincentive/types"
+)
+
+// NewProposalHandler handles "gov" type message in "makerincentive"
+func NewProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.FundRewardPoolProposal:
+			return handleFundRewardPoolProposal(ctx, k, content)
+		case types.SetProductBudgetProposal:
+			return handleSetProductBudgetProposal(ctx, k, content)
+		default:
+			return types.ErrUnexpectedProposalType(DefaultCodespace, content.ProposalType())
+		}
+	}
+}
+
+func handleFundRewardPoolProposal(ctx sdk.Context, k *Keeper, proposal types.FundRewardPoolProposal) sdk.Error {
+	if sdkErr := k.CheckFundRewardPoolProposal(ctx, proposal); sdkErr != nil {
+		return sdkErr
+	}
+	return k.FundRewardPool(ctx, proposal.Amount)
+}
+
+func handleSetProductBudgetProposal(ctx sdk.Context, k *Keeper, proposal types.SetProductBudgetProposal) sdk.Error {
+	if sdkErr := k.CheckSetProductBudgetProposal(ctx, proposal); sdkErr != nil {
+		return sdkErr
+	}
+
+	// the scores of the removed product are dropped without being rewarded
+	if proposal.Budget.IsZero() {
+		k.ClearProductScores(ctx, proposal.Product)
+	}
+	k.SetProductBudget(ctx, proposal.Product, proposal.Budget)
+	return nil
+}
@@ -0,0 +1,43 @@
+package makerincentive
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/makerincentive/keeper"
+	"github.com/okex/okexchain/x/makerincentive/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+)
+
+func TestProposalHandler(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	ctx, k := input.Ctx, input.Keeper
+	handler := NewProposalHandler(&k)
+	amount := sdk.NewDecCoinsFromDec(common.NativeToken, sdk.NewDec(100))
+
+	// fund the reward pool
+	proposal := &govtypes.Proposal{Content: types.NewFundRewardPoolProposal("title", "description", amount)}
+	require.NotNil(t, handler(ctx, proposal))
+	input.DistrKeeper.CommunityPool = amount
+	require.Nil(t, handler(ctx, proposal))
+	require.Equal(t, amount, k.GetRewardPoolBalance(ctx))
+
+	// set the budget of a product
+	proposal.Content = types.NewSetProductBudgetProposal("title", "description", ordertypes.TestTokenPair, amount)
+	require.Nil(t, handler(ctx, proposal))
+	require.Equal(t, amount, k.GetProductBudget(ctx, ordertypes.TestTokenPair))
+
+	// remove the product and drop its scores
+	k.SetProductSamples(ctx, ordertypes.TestTokenPair, 1)
+	proposal.Content = types.NewSetProductBudgetProposal("title", "description", ordertypes.TestTokenPair, nil)
+	require.Nil(t, handler(ctx, proposal))
+	require.Nil(t, k.GetProductBudget(ctx, ordertypes.TestTokenPair))
+	require.EqualValues(t, 0, k.GetProductSamples(ctx, ordertypes.TestTokenPair))
+
+	proposal.Content = govtypes.NewTextProposal("title", "description")
+	require.NotNil(t, handler(ctx, proposal))
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// RegisterCodec registers concrete types on codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgClaimRewards{}, "okexchain/makerincentive/MsgClaimRewards", nil)
+	cdc.RegisterConcrete(FundRewardPoolProposal{}, "okexchain/makerincentive/FundRewardPoolProposal", nil)
+	cdc.RegisterConcrete(SetProductBudgetProposal{}, "okexchain/makerincentive/SetProductBudgetProposal", nil)
+}
+
+// ModuleCdc defines the module codec
+var ModuleCdc *codec.Codec
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
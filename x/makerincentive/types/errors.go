@@ -0,0 +1,60 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type CodeType = uint32
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidInput           CodeType = 101
+	CodeInvalidAddress         CodeType = 102
+	CodeTokenPairNotExist      CodeType = 103
+	CodeNoClaimableRewards     CodeType = 104
+	CodeInsufficientFunds      CodeType = 105
+	CodeUnexpectedProposalType CodeType = 106
+)
+
+var (
+	errInvalidInput           = sdkerrors.Register(DefaultCodespace, CodeInvalidInput, "invalid input")
+	errInvalidAddress         = sdkerrors.Register(DefaultCodespace, CodeInvalidAddress, "invalid address")
+	errTokenPairNotExist      = sdkerrors.Register(DefaultCodespace, CodeTokenPairNotExist, "token pair not exist")
+	errNoClaimableRewards     = sdkerrors.Register(DefaultCodespace, CodeNoClaimableRewards, "no claimable rewards")
+	errInsufficientFunds      = sdkerrors.Register(DefaultCodespace, CodeInsufficientFunds, "insufficient funds")
+	errUnexpectedProposalType = sdkerrors.Register(DefaultCodespace, CodeUnexpectedProposalType, "unexpected proposal type")
+)
+
+// ErrInvalidInput returns an error when an input parameter is invalid
+func ErrInvalidInput(codespace string, msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInvalidInput, "failed. invalid input: %s", msg)}
+}
+
+// ErrNilAddress returns an error when an empty address appears
+func ErrNilAddress(codespace string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInvalidAddress, "failed. address is nil")}
+}
+
+// ErrTokenPairNotExist returns an error when the token pair of a product doesn't exist
+func ErrTokenPairNotExist(codespace string, product string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errTokenPairNotExist, "failed. token pair %s does not exist", product)}
+}
+
+// ErrNoClaimableRewards returns an error when an address has no rewards to claim
+func ErrNoClaimableRewards(codespace string, addr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errNoClaimableRewards, "failed. %s has no rewards to claim", addr)}
+}
+
+// ErrInsufficientCommunityPool returns an error when the community pool can't afford to fund the reward pool
+func ErrInsufficientCommunityPool(codespace string, amount, communityPool string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInsufficientFunds,
+		"failed. the community pool %s is insufficient to fund %s", communityPool, amount)}
+}
+
+// ErrUnexpectedProposalType returns an error when the proposal type is not supported in makerincentive module
+func ErrUnexpectedProposalType(codespace string, proposalType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errUnexpectedProposalType,
+		"failed. the proposal type %s is not supported in makerincentive module", proposalType)}
+}
@@ -0,0 +1,16 @@
+package types
+
+// makerincentive module event types
+const (
+	EventTypeDistributeRewards      = "distribute_rewards"
+	EventTypeInsufficientRewardPool = "insufficient_reward_pool"
+	EventTypeClaimRewards           = "claim_rewards"
+
+	AttributeKeyAddress = "address"
+	AttributeKeyProduct = "product"
+	AttributeKeyEpoch   = "epoch"
+	AttributeKeyBudget  = "budget"
+	AttributeKeyRewards = "rewards"
+
+	AttributeValueCategory = ModuleName
+)
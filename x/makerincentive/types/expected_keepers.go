@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply/exported"
+	dextypes "github.com/okex/okexchain/x/dex/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	ordertypes "github.com/okex/okexchain/x/order/types"
+	"github.com/okex/okexchain/x/params"
+)
+
+// ParamSubspace defines the expected Subspace interfacace
+type ParamSubspace interface {
+	WithKeyTable(table params.KeyTable) params.Subspace
+	Get(ctx sdk.Context, key []byte, ptr interface{})
+	GetParamSet(ctx sdk.Context, ps params.ParamSet)
+	SetParamSet(ctx sdk.Context, ps params.ParamSet)
+}
+
+// OrderKeeper defines the expected order keeper to sample the depth books
+type OrderKeeper interface {
+	GetDepthBookCopy(product string) *ordertypes.DepthBook
+	GetProductPriceOrderIDs(key string) []string
+	GetOrder(ctx sdk.Context, orderID string) *ordertypes.Order
+}
+
+// DexKeeper defines the expected dex keeper
+type DexKeeper interface {
+	GetTokenPair(ctx sdk.Context, product string) *dextypes.TokenPair
+}
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) exported.ModuleAccountI
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress,
+		amt sdk.Coins) error
+}
+
+// DistrKeeper defines the expected distribution keeper to fund the reward pool from the community pool
+type DistrKeeper interface {
+	GetFeePoolCommunityCoins(ctx sdk.Context) sdk.SysCoins
+	DistributeFromFeePoolToModule(ctx sdk.Context, amount sdk.SysCoins, recipientModule string) error
+}
+
+// GovKeeper defines the expected gov Keeper
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// used for import / export via genesis json
+type MakerScoreRecord struct {
+	Product string         `json:"product" yaml:"product"`
+	Maker   sdk.AccAddress `json:"maker" yaml:"maker"`
+	Score   MakerScore     `json:"score" yaml:"score"`
+}
+
+// used for import / export via genesis json
+type ProductSamplesRecord struct {
+	Product string `json:"product" yaml:"product"`
+	Samples int64  `json:"samples" yaml:"samples"`
+}
+
+// used for import / export via genesis json
+type ClaimableRewardsRecord struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Rewards sdk.SysCoins   `json:"rewards" yaml:"rewards"`
+}
+
+// GenesisState - all makerincentive state that must be provided at genesis
+type GenesisState struct {
+	Params           Params                   `json:"params" yaml:"params"`
+	Epoch            Epoch                    `json:"epoch" yaml:"epoch"`
+	Budgets          ProductBudgets           `json:"budgets" yaml:"budgets"`
+	MakerScores      []MakerScoreRecord       `json:"maker_scores" yaml:"maker_scores"`
+	ProductSamples   []ProductSamplesRecord   `json:"product_samples" yaml:"product_samples"`
+	ClaimableRewards []ClaimableRewardsRecord `json:"claimable_rewards" yaml:"claimable_rewards"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epoch Epoch, budgets ProductBudgets, scores []MakerScoreRecord,
+	samples []ProductSamplesRecord, rewards []ClaimableRewardsRecord,
+) GenesisState {
+	return GenesisState{
+		Params:           params,
+		Epoch:            epoch,
+		Budgets:          budgets,
+		MakerScores:      scores,
+		ProductSamples:   samples,
+		ClaimableRewards: rewards,
+	}
+}
+
+// DefaultGenesisState - default GenesisState used by Cosmos Hub
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:           DefaultParams(),
+		Epoch:            Epoch{Number: 1},
+		Budgets:          ProductBudgets{},
+		MakerScores:      []MakerScoreRecord{},
+		ProductSamples:   []ProductSamplesRecord{},
+		ClaimableRewards: []ClaimableRewardsRecord{},
+	}
+}
+
+// ValidateGenesis validates the makerincentive genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.Epoch.Number == 0 {
+		return fmt.Errorf("epoch number must be positive")
+	}
+
+	for _, budget := range data.Budgets {
+		if len(budget.Product) == 0 || !budget.Budget.IsValid() || budget.Budget.IsZero() {
+			return fmt.Errorf("invalid budget: %s", budget)
+		}
+	}
+
+	for _, record := range data.MakerScores {
+		if record.Maker.Empty() || record.Score.Score.IsNegative() || record.Score.Samples < 0 {
+			return fmt.Errorf("invalid score of maker %s in product %s", record.Maker, record.Product)
+		}
+	}
+
+	for _, record := range data.ClaimableRewards {
+		if record.Address.Empty() || !record.Rewards.IsValid() {
+			return fmt.Errorf("invalid claimable rewards %s of %s", record.Rewards, record.Address)
+		}
+	}
+	return nil
+}
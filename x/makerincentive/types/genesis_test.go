@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+
+	genesisState := DefaultGenesisState()
+	genesisState.Params.EpochBlocks = 0
+	require.NotNil(t, ValidateGenesis(genesisState))
+
+	genesisState = DefaultGenesisState()
+	genesisState.Params.MaxSpread = sdk.NewDec(-1)
+	require.NotNil(t, ValidateGenesis(genesisState))
+
+	genesisState = DefaultGenesisState()
+	genesisState.Epoch.Number = 0
+	require.NotNil(t, ValidateGenesis(genesisState))
+
+	genesisState = DefaultGenesisState()
+	genesisState.Budgets = ProductBudgets{NewProductBudget("xxb_okt", sdk.SysCoins{})}
+	require.NotNil(t, ValidateGenesis(genesisState))
+
+	genesisState = DefaultGenesisState()
+	genesisState.MakerScores = []MakerScoreRecord{{Product: "xxb_okt",
+		Maker: sdk.AccAddress([]byte("maker_______________")), Score: MakerScore{Score: sdk.NewDec(-1)}}}
+	require.NotNil(t, ValidateGenesis(genesisState))
+
+	genesisState = DefaultGenesisState()
+	genesisState.ClaimableRewards = []ClaimableRewardsRecord{{
+		Rewards: sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDec(1))}}
+	require.NotNil(t, ValidateGenesis(genesisState))
+}
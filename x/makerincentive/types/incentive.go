@@ -0,0 +1,113 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProductBudget is the rewards distributed to the makers of a product every epoch
+type ProductBudget struct {
+	Product string       `json:"product" yaml:"product"`
+	Budget  sdk.SysCoins `json:"budget" yaml:"budget"`
+}
+
+// NewProductBudget creates a new instance of ProductBudget
+func NewProductBudget(product string, budget sdk.SysCoins) ProductBudget {
+	return ProductBudget{
+		Product: product,
+		Budget:  budget,
+	}
+}
+
+// String implements the stringer interface
+func (pb ProductBudget) String() string {
+	return fmt.Sprintf("product: %s, budget: %s", pb.Product, pb.Budget)
+}
+
+// ProductBudgets is a collection of ProductBudget
+type ProductBudgets []ProductBudget
+
+// String implements the stringer interface
+func (pbs ProductBudgets) String() string {
+	var b strings.Builder
+	for _, pb := range pbs {
+		b.WriteString(pb.String())
+		b.WriteString("\n")
+	}
+	return strings.TrimSpace(b.String())
+}
+
+// MakerScore is the score accumulated by a maker of a product in the current epoch, Samples is the number of the
+// samples that the maker has resting orders scored in
+type MakerScore struct {
+	Score   sdk.Dec `json:"score" yaml:"score"`
+	Samples int64   `json:"samples" yaml:"samples"`
+}
+
+// EffectiveScore returns the average score of the maker over all the samples of the product. The score is only
+// accumulated in the samples that the maker is present in, so the average is already weighted by the uptime
+func (ms MakerScore) EffectiveScore(productSamples int64) sdk.Dec {
+	if productSamples <= 0 {
+		return sdk.ZeroDec()
+	}
+	return ms.Score.QuoInt64(productSamples)
+}
+
+// Epoch is the period that the maker scores are accumulated in, the rewards are distributed at the end of it. The
+// epochs are numbered from 1
+type Epoch struct {
+	Number      uint64 `json:"number" yaml:"number"`
+	StartHeight int64  `json:"start_height" yaml:"start_height"`
+}
+
+// EndHeight returns the block height that the epoch ends at
+func (e Epoch) EndHeight(epochBlocks int64) int64 {
+	return e.StartHeight + epochBlocks
+}
+
+// String implements the stringer interface
+func (e Epoch) String() string {
+	return fmt.Sprintf("number: %d, start height: %d", e.Number, e.StartHeight)
+}
+
+// MakerScoreInfo is the score of a maker in the current epoch and the rewards that it's expected to earn
+type MakerScoreInfo struct {
+	Maker           sdk.AccAddress `json:"maker" yaml:"maker"`
+	Score           sdk.Dec        `json:"score" yaml:"score"`
+	Samples         int64          `json:"samples" yaml:"samples"`
+	EffectiveScore  sdk.Dec        `json:"effective_score" yaml:"effective_score"`
+	Share           sdk.Dec        `json:"share" yaml:"share"`
+	EstimatedReward sdk.SysCoins   `json:"estimated_reward" yaml:"estimated_reward"`
+}
+
+// RewardPool is the status of the reward pool, Outstanding is the rewards distributed but not claimed yet
+type RewardPool struct {
+	Epoch       Epoch        `json:"epoch" yaml:"epoch"`
+	Balance     sdk.SysCoins `json:"balance" yaml:"balance"`
+	Outstanding sdk.SysCoins `json:"outstanding" yaml:"outstanding"`
+}
+
+// String implements the stringer interface
+func (rp RewardPool) String() string {
+	return fmt.Sprintf(`RewardPool:
+  Epoch:		%s
+  Balance:		%s
+  Outstanding:		%s`,
+		rp.Epoch, rp.Balance, rp.Outstanding)
+}
+
+// OrderScore returns the score of a resting order, which is its notional scaled down quadratically by its distance to
+// the mid price. It's zero if the order is farther than maxSpread from the mid price
+func OrderScore(price, quantity, midPrice, maxSpread sdk.Dec) sdk.Dec {
+	if !midPrice.IsPositive() || !maxSpread.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	spread := price.Sub(midPrice).Abs().Quo(midPrice)
+	if spread.GTE(maxSpread) {
+		return sdk.ZeroDec()
+	}
+	proximity := sdk.OneDec().Sub(spread.Quo(maxSpread))
+	return price.Mul(quantity).Mul(proximity).Mul(proximity)
+}
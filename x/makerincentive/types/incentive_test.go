@@ -0,0 +1,50 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderScore(t *testing.T) {
+	mid, maxSpread := sdk.NewDec(10), sdk.MustNewDecFromStr("0.02")
+	qty := sdk.NewDec(2)
+
+	// at the mid price, the score is the notional
+	require.Equal(t, sdk.NewDec(20), OrderScore(sdk.NewDec(10), qty, mid, maxSpread))
+	// the spread 0.01 is half of the max spread: 9.9 * 2 * 0.5^2
+	require.Equal(t, sdk.MustNewDecFromStr("4.95"), OrderScore(sdk.MustNewDecFromStr("9.9"), qty, mid, maxSpread))
+	require.Equal(t, sdk.MustNewDecFromStr("5.05"), OrderScore(sdk.MustNewDecFromStr("10.1"), qty, mid, maxSpread))
+	// out of the max spread
+	require.True(t, OrderScore(sdk.MustNewDecFromStr("9.8"), qty, mid, maxSpread).IsZero())
+	require.True(t, OrderScore(sdk.NewDec(11), qty, mid, maxSpread).IsZero())
+	// disabled
+	require.True(t, OrderScore(sdk.NewDec(10), qty, mid, sdk.ZeroDec()).IsZero())
+	require.True(t, OrderScore(sdk.NewDec(10), qty, sdk.ZeroDec(), maxSpread).IsZero())
+}
+
+func TestEffectiveScore(t *testing.T) {
+	score := MakerScore{Score: sdk.NewDec(30), Samples: 2}
+	// the uptime is only counted once, the score accumulated in 2 of 4 samples is averaged over the 4 samples
+	require.Equal(t, sdk.MustNewDecFromStr("7.5"), score.EffectiveScore(4))
+	require.Equal(t, sdk.NewDec(15), score.EffectiveScore(2))
+	require.True(t, score.EffectiveScore(0).IsZero())
+
+	require.EqualValues(t, 110, Epoch{Number: 2, StartHeight: 100}.EndHeight(10))
+}
+
+func TestKeys(t *testing.T) {
+	maker := sdk.AccAddress([]byte("maker_______________"))
+	product, splitMaker := SplitMakerScoreKey(GetMakerScoreKey("xxb_okt", maker))
+	require.Equal(t, "xxb_okt", product)
+	require.Equal(t, maker, splitMaker)
+
+	require.Equal(t, "xxb_okt", SplitProductBudgetKey(GetProductBudgetKey("xxb_okt")))
+	require.Equal(t, "xxb_okt", SplitProductSamplesKey(GetProductSamplesKey("xxb_okt")))
+	require.Equal(t, maker, SplitClaimableRewardsKey(GetClaimableRewardsKey(maker)))
+
+	// a product mustn't match the scores of another product that it's a prefix of
+	require.NotEqual(t, GetProductMakerScoresPrefix("xxb_okt"),
+		GetMakerScoreKey("xxb_okt2", maker)[:len(GetProductMakerScoresPrefix("xxb_okt"))])
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module, it's also the name of the module account holding the reward pool
+	ModuleName = "makerincentive"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+)
+
+var (
+	ProductBudgetPrefix    = []byte{0x01}
+	MakerScorePrefix       = []byte{0x02}
+	ProductSamplesPrefix   = []byte{0x03}
+	ClaimableRewardsPrefix = []byte{0x04}
+	EpochKey               = []byte{0x05}
+	OutstandingRewardsKey  = []byte{0x06}
+)
+
+// GetProductBudgetKey gets the key for the reward budget of a product
+func GetProductBudgetKey(product string) []byte {
+	return append(ProductBudgetPrefix, []byte(product)...)
+}
+
+// SplitProductBudgetKey splits the product out from a ProductBudgetKey
+func SplitProductBudgetKey(key []byte) string {
+	return string(key[len(ProductBudgetPrefix):])
+}
+
+// GetProductMakerScoresPrefix gets the prefix key of the maker scores of a product, the product is prefixed with its
+// length so that one product isn't the prefix of another
+func GetProductMakerScoresPrefix(product string) []byte {
+	return append(append(MakerScorePrefix, byte(len(product))), []byte(product)...)
+}
+
+// GetMakerScoreKey gets the key for the score of a maker in a product
+func GetMakerScoreKey(product string, maker sdk.AccAddress) []byte {
+	return append(GetProductMakerScoresPrefix(product), maker.Bytes()...)
+}
+
+// SplitMakerScoreKey splits the product and the maker out from a MakerScoreKey
+func SplitMakerScoreKey(key []byte) (product string, maker sdk.AccAddress) {
+	productLen := int(key[len(MakerScorePrefix)])
+	productStart := len(MakerScorePrefix) + 1
+	return string(key[productStart : productStart+productLen]), key[productStart+productLen:]
+}
+
+// GetProductSamplesKey gets the key for the number of the samples taken of a product in the current epoch
+func GetProductSamplesKey(product string) []byte {
+	return append(ProductSamplesPrefix, []byte(product)...)
+}
+
+// SplitProductSamplesKey splits the product out from a ProductSamplesKey
+func SplitProductSamplesKey(key []byte) string {
+	return string(key[len(ProductSamplesPrefix):])
+}
+
+// GetClaimableRewardsKey gets the key for the claimable rewards of an address
+func GetClaimableRewardsKey(addr sdk.AccAddress) []byte {
+	return append(ClaimableRewardsPrefix, addr.Bytes()...)
+}
+
+// SplitClaimableRewardsKey splits the address out from a ClaimableRewardsKey
+func SplitClaimableRewardsKey(key []byte) sdk.AccAddress {
+	return key[len(ClaimableRewardsPrefix):]
+}
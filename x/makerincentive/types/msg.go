@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	claimRewardsMsgType = "claim_rewards"
+)
+
+// MsgClaimRewards is the msg to withdraw the rewards distributed to a maker
+type MsgClaimRewards struct {
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+var _ sdk.Msg = MsgClaimRewards{}
+
+// NewMsgClaimRewards creates a new instance of MsgClaimRewards
+func NewMsgClaimRewards(address sdk.AccAddress) MsgClaimRewards {
+	return MsgClaimRewards{
+		Address: address,
+	}
+}
+
+func (m MsgClaimRewards) Route() string {
+	return RouterKey
+}
+
+func (m MsgClaimRewards) Type() string {
+	return claimRewardsMsgType
+}
+
+func (m MsgClaimRewards) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return ErrNilAddress(DefaultCodespace)
+	}
+	return nil
+}
+
+func (m MsgClaimRewards) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgClaimRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Address}
+}
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgClaimRewards(t *testing.T) {
+	addr := sdk.AccAddress([]byte("address_____________"))
+	msg := NewMsgClaimRewards(addr)
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, claimRewardsMsgType, msg.Type())
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr}, msg.GetSigners())
+	require.NotEmpty(t, msg.GetSignBytes())
+
+	require.NotNil(t, NewMsgClaimRewards(nil).ValidateBasic())
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/params"
+)
+
+// Default parameter namespace
+const (
+	DefaultParamspace     = ModuleName
+	defaultSampleInterval = 10    // blocks
+	defaultEpochBlocks    = 28800 // blocks
+	defaultMaxSpread      = "0.02"
+)
+
+// Parameter store keys
+var (
+	KeySampleInterval = []byte("SampleInterval")
+	KeyEpochBlocks    = []byte("EpochBlocks")
+	KeyMaxSpread      = []byte("MaxSpread")
+)
+
+// ParamKeyTable for makerincentive module
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Params - used for initializing default parameter for makerincentive at genesis
+type Params struct {
+	// the depth books are sampled once every SampleInterval blocks on average
+	SampleInterval int64 `json:"sample_interval"`
+	// the rewards are distributed every EpochBlocks blocks
+	EpochBlocks int64 `json:"epoch_blocks"`
+	// orders farther than MaxSpread from the mid price aren't scored
+	MaxSpread sdk.Dec `json:"max_spread"`
+}
+
+// String implements the stringer interface for Params
+func (p Params) String() string {
+	return fmt.Sprintf(`Params:
+  Sample Interval:		%d
+  Epoch Blocks:			%d
+  Max Spread:			%s`,
+		p.SampleInterval, p.EpochBlocks, p.MaxSpread)
+}
+
+// ParamSetPairs - Implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeySampleInterval, Value: &p.SampleInterval, ValidatorFn: common.ValidateInt64Positive("sample interval")},
+		{Key: KeyEpochBlocks, Value: &p.EpochBlocks, ValidatorFn: common.ValidateInt64Positive("epoch blocks")},
+		{Key: KeyMaxSpread, Value: &p.MaxSpread, ValidatorFn: common.ValidateRateNotNeg("max spread")},
+	}
+}
+
+// Validate gives a quick validity check for a set of params
+func (p Params) Validate() error {
+	if err := common.ValidateInt64Positive("sample interval")(p.SampleInterval); err != nil {
+		return err
+	}
+	if err := common.ValidateInt64Positive("epoch blocks")(p.EpochBlocks); err != nil {
+		return err
+	}
+	return common.ValidateRateNotNeg("max spread")(p.MaxSpread)
+}
+
+// DefaultParams defines the parameters for this module
+func DefaultParams() Params {
+	return Params{
+		SampleInterval: defaultSampleInterval,
+		EpochBlocks:    defaultEpochBlocks,
+		MaxSpread:      sdk.MustNewDecFromStr(defaultMaxSpread),
+	}
+}
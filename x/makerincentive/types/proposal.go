@@ -0,0 +1,198 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+const (
+	// proposalTypeFundRewardPool defines the type for a FundRewardPoolProposal
+	proposalTypeFundRewardPool = "FundRewardPool"
+	// proposalTypeSetProductBudget defines the type for a SetProductBudgetProposal
+	proposalTypeSetProductBudget = "SetProductBudget"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeFundRewardPool)
+	govtypes.RegisterProposalType(proposalTypeSetProductBudget)
+	govtypes.RegisterProposalTypeCodec(FundRewardPoolProposal{}, "okexchain/makerincentive/FundRewardPoolProposal")
+	govtypes.RegisterProposalTypeCodec(SetProductBudgetProposal{}, "okexchain/makerincentive/SetProductBudgetProposal")
+}
+
+var (
+	_ govtypes.Content = (*FundRewardPoolProposal)(nil)
+	_ govtypes.Content = (*SetProductBudgetProposal)(nil)
+)
+
+// FundRewardPoolProposal - structure for the proposal to move coins from the community pool to the reward pool
+type FundRewardPoolProposal struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Amount      sdk.SysCoins `json:"amount" yaml:"amount"`
+}
+
+// NewFundRewardPoolProposal creates a new instance of FundRewardPoolProposal
+func NewFundRewardPoolProposal(title, description string, amount sdk.SysCoins) FundRewardPoolProposal {
+	return FundRewardPoolProposal{
+		Title:       title,
+		Description: description,
+		Amount:      amount,
+	}
+}
+
+// GetTitle returns title of a fund reward pool proposal object
+func (fp FundRewardPoolProposal) GetTitle() string {
+	return fp.Title
+}
+
+// GetDescription returns description of a fund reward pool proposal object
+func (fp FundRewardPoolProposal) GetDescription() string {
+	return fp.Description
+}
+
+// ProposalRoute returns route key of a fund reward pool proposal object
+func (fp FundRewardPoolProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a fund reward pool proposal object
+func (fp FundRewardPoolProposal) ProposalType() string {
+	return proposalTypeFundRewardPool
+}
+
+// ValidateBasic validates a fund reward pool proposal
+func (fp FundRewardPoolProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(fp.Title, fp.Description, "fund reward pool"); err != nil {
+		return err
+	}
+
+	if fp.ProposalType() != proposalTypeFundRewardPool {
+		return govtypes.ErrInvalidProposalType(DefaultCodespace, fp.ProposalType())
+	}
+
+	if !fp.Amount.IsValid() || fp.Amount.IsZero() {
+		return govtypes.ErrInvalidProposalContent(
+			DefaultCodespace,
+			fmt.Sprintf("failed to submit the fund reward pool proposal because of the invalid amount %s", fp.Amount),
+		)
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a FundRewardPoolProposal
+func (fp FundRewardPoolProposal) String() string {
+	return fmt.Sprintf(`FundRewardPoolProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Amount:				%s`,
+		fp.Title, fp.Description, fp.ProposalType(), fp.Amount)
+}
+
+// SetProductBudgetProposal - structure for the proposal to set the rewards distributed to the makers of a product
+// every epoch, the product stops being rewarded if the budget is empty
+type SetProductBudgetProposal struct {
+	Title       string       `json:"title" yaml:"title"`
+	Description string       `json:"description" yaml:"description"`
+	Product     string       `json:"product" yaml:"product"`
+	Budget      sdk.SysCoins `json:"budget" yaml:"budget"`
+}
+
+// NewSetProductBudgetProposal creates a new instance of SetProductBudgetProposal
+func NewSetProductBudgetProposal(title, description, product string, budget sdk.SysCoins) SetProductBudgetProposal {
+	return SetProductBudgetProposal{
+		Title:       title,
+		Description: description,
+		Product:     product,
+		Budget:      budget,
+	}
+}
+
+// GetTitle returns title of a set product budget proposal object
+func (sp SetProductBudgetProposal) GetTitle() string {
+	return sp.Title
+}
+
+// GetDescription returns description of a set product budget proposal object
+func (sp SetProductBudgetProposal) GetDescription() string {
+	return sp.Description
+}
+
+// ProposalRoute returns route key of a set product budget proposal object
+func (sp SetProductBudgetProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of a set product budget proposal object
+func (sp SetProductBudgetProposal) ProposalType() string {
+	return proposalTypeSetProductBudget
+}
+
+// ValidateBasic validates a set product budget proposal
+func (sp SetProductBudgetProposal) ValidateBasic() sdk.Error {
+	if err := validateProposalContent(sp.Title, sp.Description, "set product budget"); err != nil {
+		return err
+	}
+
+	if sp.ProposalType() != proposalTypeSetProductBudget {
+		return govtypes.ErrInvalidProposalType(DefaultCodespace, sp.ProposalType())
+	}
+
+	if len(sp.Product) == 0 {
+		return govtypes.ErrInvalidProposalContent(
+			DefaultCodespace,
+			"failed to submit the set product budget proposal because of the empty product",
+		)
+	}
+
+	if !sp.Budget.IsValid() {
+		return govtypes.ErrInvalidProposalContent(
+			DefaultCodespace,
+			fmt.Sprintf("failed to submit the set product budget proposal because of the invalid budget %s", sp.Budget),
+		)
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of a SetProductBudgetProposal
+func (sp SetProductBudgetProposal) String() string {
+	return fmt.Sprintf(`SetProductBudgetProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Product:				%s
+ Budget:				%s`,
+		sp.Title, sp.Description, sp.ProposalType(), sp.Product, sp.Budget)
+}
+
+func validateProposalContent(title, description, proposalName string) sdk.Error {
+	if len(strings.TrimSpace(title)) == 0 {
+		return govtypes.ErrInvalidProposalContent(
+			DefaultCodespace,
+			fmt.Sprintf("failed to submit the %s proposal because the title is blank", proposalName))
+	}
+	if len(title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent(
+			DefaultCodespace,
+			fmt.Sprintf("failed to submit the %s proposal because the title is longer than max length of %d",
+				proposalName, govtypes.MaxTitleLength))
+	}
+
+	if len(description) == 0 {
+		return govtypes.ErrInvalidProposalContent(
+			DefaultCodespace,
+			fmt.Sprintf("failed to submit the %s proposal because the description is blank", proposalName))
+	}
+	if len(description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent(
+			DefaultCodespace,
+			fmt.Sprintf("failed to submit the %s proposal because the description is longer than max length of %d",
+				proposalName, govtypes.MaxDescriptionLength))
+	}
+	return nil
+}
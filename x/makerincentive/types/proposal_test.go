@@ -0,0 +1,44 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundRewardPoolProposal(t *testing.T) {
+	amount := sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDec(100))
+	proposal := NewFundRewardPoolProposal("title", "description", amount)
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, proposalTypeFundRewardPool, proposal.ProposalType())
+	require.Nil(t, proposal.ValidateBasic())
+
+	proposal.Amount = sdk.SysCoins{}
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Amount = amount
+	proposal.Title = " "
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Title = strings.Repeat("t", govtypes.MaxTitleLength+1)
+	require.NotNil(t, proposal.ValidateBasic())
+	proposal.Title = "title"
+	proposal.Description = ""
+	require.NotNil(t, proposal.ValidateBasic())
+}
+
+func TestSetProductBudgetProposal(t *testing.T) {
+	budget := sdk.NewDecCoinsFromDec(sdk.DefaultBondDenom, sdk.NewDec(100))
+	proposal := NewSetProductBudgetProposal("title", "description", "xxb_okt", budget)
+	require.Equal(t, RouterKey, proposal.ProposalRoute())
+	require.Equal(t, proposalTypeSetProductBudget, proposal.ProposalType())
+	require.Nil(t, proposal.ValidateBasic())
+
+	// an empty budget removes the product
+	proposal.Budget = nil
+	require.Nil(t, proposal.ValidateBasic())
+
+	proposal.Product = ""
+	require.NotNil(t, proposal.ValidateBasic())
+}
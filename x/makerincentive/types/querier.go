@@ -0,0 +1,37 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+const (
+	QueryParameters = "parameters"
+	QueryBudgets    = "budgets"
+	QueryScores     = "scores"
+	QueryRewards    = "rewards"
+	QueryRewardPool = "reward-pool"
+)
+
+// QueryProductParams defines the params for the following queries:
+// - 'custom/makerincentive/scores'
+type QueryProductParams struct {
+	Product string
+}
+
+// NewQueryProductParams creates a new instance of QueryProductParams
+func NewQueryProductParams(product string) QueryProductParams {
+	return QueryProductParams{
+		Product: product,
+	}
+}
+
+// QueryAddressParams defines the params for the following queries:
+// - 'custom/makerincentive/rewards'
+type QueryAddressParams struct {
+	Address sdk.AccAddress
+}
+
+// NewQueryAddressParams creates a new instance of QueryAddressParams
+func NewQueryAddressParams(address sdk.AccAddress) QueryAddressParams {
+	return QueryAddressParams{
+		Address: address,
+	}
+}
@@ -19,27 +19,36 @@ const (
 // nolint
 // types aliases
 type (
-	Keeper           = keeper.Keeper
-	Order            = types.Order
-	DepthBook        = types.DepthBook
-	MatchResult      = types.MatchResult
-	Deal             = types.Deal
-	Params           = types.Params
-	MsgNewOrder      = types.MsgNewOrder
-	MsgCancelOrder   = types.MsgCancelOrder
-	MsgNewOrders     = types.MsgNewOrders
-	MsgCancelOrders  = types.MsgCancelOrders
-	BlockMatchResult = types.BlockMatchResult
+	Keeper             = keeper.Keeper
+	Order              = types.Order
+	DepthBook          = types.DepthBook
+	MatchResult        = types.MatchResult
+	Deal               = types.Deal
+	Params             = types.Params
+	MsgNewOrder        = types.MsgNewOrder
+	MsgCancelOrder     = types.MsgCancelOrder
+	MsgNewOrders       = types.MsgNewOrders
+	MsgCancelOrders    = types.MsgCancelOrders
+	MsgCancelAllOrders = types.MsgCancelAllOrders
+	MsgAmendOrder      = types.MsgAmendOrder
+	BlockMatchResult   = types.BlockMatchResult
+	AccountFeeTier     = types.AccountFeeTier
+	MsgGrantTrading    = types.MsgGrantTrading
+	MsgRevokeTrading   = types.MsgRevokeTrading
+	TradingGrant       = types.TradingGrant
+	ProductHalt        = types.ProductHalt
 )
 
 // nolint
 // functions aliases
 var (
-	RegisterCodec     = types.RegisterCodec
-	DefaultParams     = types.DefaultParams
-	NewMsgNewOrder    = types.NewMsgNewOrder
-	NewMsgCancelOrder = types.NewMsgCancelOrder
-	NewKeeper         = keeper.NewKeeper
-	NewQuerier        = keeper.NewQuerier
-	FormatOrderIDsKey = types.FormatOrderIDsKey
+	RegisterCodec       = types.RegisterCodec
+	DefaultParams       = types.DefaultParams
+	NewMsgNewOrder      = types.NewMsgNewOrder
+	NewMsgCancelOrder   = types.NewMsgCancelOrder
+	NewMsgGrantTrading  = types.NewMsgGrantTrading
+	NewMsgRevokeTrading = types.NewMsgRevokeTrading
+	NewKeeper           = keeper.NewKeeper
+	NewQuerier          = keeper.NewQuerier
+	FormatOrderIDsKey   = types.FormatOrderIDsKey
 )
@@ -26,6 +26,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdDepthBook(queryRoute, cdc),
 		GetCmdQueryStore(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryFeeTier(queryRoute, cdc),
+		GetCmdQueryGrants(queryRoute, cdc),
+		GetCmdQueryHalts(queryRoute, cdc),
 	)...)
 
 	queryCmd.Flags().StringP(client.FlagNode, "n", "tcp://localhost:26657", "Node to connect to")
@@ -169,3 +172,79 @@ $ okexchaincli query order params
 		},
 	}
 }
+
+// GetCmdQueryFeeTier queries the fee tier of an account
+func GetCmdQueryFeeTier(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-tier [address]",
+		Short: "Query the fee tier of an account",
+		Long: strings.TrimSpace(`Query the fee tier of an account by its trade volume in the last 30 days, and its maker
+and taker fee rates after the discount of the tier:
+
+$ okexchaincli query order fee-tier okexchain1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryFeeTier, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var feeTier types.AccountFeeTier
+			cdc.MustUnmarshalJSON(bz, &feeTier)
+			return cliCtx.PrintOutput(feeTier)
+		},
+	}
+}
+
+// GetCmdQueryGrants queries the trading grants given by an account
+func GetCmdQueryGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "grants [granter]",
+		Short: "Query the trading grants given by an account",
+		Long: strings.TrimSpace(`Query the trading grants given by an account, with the allowed products, the expiration
+and the spent notional of each grantee:
+
+$ okexchaincli query order grants okexchain1...
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s/%s", queryRoute, types.QueryGrants, args[0])
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var grants types.TradingGrants
+			cdc.MustUnmarshalJSON(bz, &grants)
+			return cliCtx.PrintOutput(grants)
+		},
+	}
+}
+
+// GetCmdQueryHalts queries the products whose matching is paused by the circuit breaker
+func GetCmdQueryHalts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "halts",
+		Short: "Query the products whose matching is paused by the circuit breaker",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryHalts)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var halts []types.ProductHalt
+			cdc.MustUnmarshalJSON(bz, &halts)
+			return cliCtx.PrintOutput(halts)
+		},
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	client "github.com/cosmos/cosmos-sdk/client/flags"
@@ -16,6 +17,19 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// nolint
+const (
+	FlagGranter     = "granter"
+	FlagProducts    = "products"
+	FlagExpiration  = "expiration"
+	FlagMaxNotional = "max-notional"
+	FlagProduct     = "product"
+	FlagSide        = "side"
+	FlagPrice       = "price"
+	FlagQuantity    = "quantity"
+	FlagSTPMode     = "stp-mode"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd := &cobra.Command{
@@ -26,6 +40,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	txCmd.AddCommand(client.PostCommands(
 		getCmdNewOrder(cdc),
 		getCmdCancelOrder(cdc),
+		getCmdCancelAllOrders(cdc),
+		getCmdAmendOrder(cdc),
+		getCmdGrantTrading(cdc),
+		getCmdRevokeTrading(cdc),
 	)...)
 
 	return txCmd
@@ -37,6 +55,7 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 	var side string
 	var price string
 	var quantity string
+	var granter string
 	cmd := &cobra.Command{
 		Use:   "new",
 		Short: "place a new order",
@@ -53,7 +72,7 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 				return errors.New("invalid param counts")
 			}
 
-			err := handleNewOrder(cmd, cdc, product, side, price, quantity)
+			err := handleNewOrder(cmd, cdc, product, side, price, quantity, granter)
 			return err
 
 		},
@@ -63,10 +82,14 @@ func getCmdNewOrder(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().StringVarP(&side, "side", "s", "", "BUY or SELL (default \"SELL\")")
 	cmd.Flags().StringVarP(&price, "price", "p", "", "The price of the order")
 	cmd.Flags().StringVarP(&quantity, "quantity", "q", "", "The quantity of the order")
+	cmd.Flags().StringVarP(&granter, FlagGranter, "", "", "The account the orders are placed for under its trading grant")
+	cmd.Flags().String(FlagSTPMode, "", "The self-trade prevention mode of the orders: none, cancel_newest, "+
+		"cancel_oldest, cancel_both or decrement, the mode in the order params if empty")
 	return cmd
 }
 
-func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side string, price string, quantity string) error {
+func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side string, price string, quantity string,
+	granter string) error {
 	var items []types.OrderItem
 	productArr := strings.Split(product, ",")
 	sideArr := strings.Split(side, ",")
@@ -84,6 +107,7 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 		return errors.New("invalid param quantity counts")
 	}
 
+	stpMode, _ := cmd.Flags().GetString(FlagSTPMode)
 	for i := 0; i < len(productArr); i++ {
 		product := productArr[i]
 		side := sideArr[i]
@@ -100,6 +124,7 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 			Side:     side,
 			Price:    price,
 			Quantity: quantity,
+			STPMode:  stpMode,
 		})
 	}
 	inBuf := bufio.NewReader(cmd.InOrStdin())
@@ -107,12 +132,19 @@ func handleNewOrder(cmd *cobra.Command, cdc *codec.Codec, product string, side s
 	cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 	msg := types.NewMsgNewOrders(cliCtx.GetFromAddress(), items)
+	if granter != "" {
+		granterAddr, err := sdk.AccAddressFromBech32(granter)
+		if err != nil {
+			return fmt.Errorf("invalid granter:%s", granter)
+		}
+		msg = msg.WithGranter(granterAddr)
+	}
 	err := utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 	return err
 }
 
 func getCmdCancelOrder(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "cancel [order-id]",
 		Short: "cancel order",
 		Args:  cobra.ExactArgs(1),
@@ -123,6 +155,13 @@ func getCmdCancelOrder(cdc *codec.Codec) *cobra.Command {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			msg := types.NewMsgCancelOrders(cliCtx.GetFromAddress(), orderIDs)
+			if granter, _ := cmd.Flags().GetString(FlagGranter); granter != "" {
+				granterAddr, err := sdk.AccAddressFromBech32(granter)
+				if err != nil {
+					return fmt.Errorf("invalid granter:%s", granter)
+				}
+				msg = msg.WithGranter(granterAddr)
+			}
 			err := utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 			if err != nil {
 				fmt.Println(err)
@@ -130,4 +169,155 @@ func getCmdCancelOrder(cdc *codec.Codec) *cobra.Command {
 			return err
 		},
 	}
+	cmd.Flags().String(FlagGranter, "", "The account the orders are canceled for under its trading grant")
+	return cmd
+}
+
+func getCmdCancelAllOrders(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-all",
+		Short: "cancel all the open orders",
+		Long: strings.TrimSpace(`Cancel all your open orders, or only the open orders of the product and the side:
+
+$ okexchaincli tx order cancel-all --product mycoin_okt --side BUY --from mykey
+`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			flags := cmd.Flags()
+			product, _ := flags.GetString(FlagProduct)
+			side, _ := flags.GetString(FlagSide)
+			msg := types.NewMsgCancelAllOrders(cliCtx.GetFromAddress(), product, side)
+			if granter, _ := flags.GetString(FlagGranter); granter != "" {
+				granterAddr, err := sdk.AccAddressFromBech32(granter)
+				if err != nil {
+					return fmt.Errorf("invalid granter:%s", granter)
+				}
+				msg = msg.WithGranter(granterAddr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagProduct, "", "Cancel the orders of the product only")
+	cmd.Flags().String(FlagSide, "", "Cancel the orders of the side only, BUY or SELL")
+	cmd.Flags().String(FlagGranter, "", "The account the orders are canceled for under its trading grant")
+	return cmd
+}
+
+func getCmdAmendOrder(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend [order-id]",
+		Short: "amend the price and the quantity of an open order",
+		Long: strings.TrimSpace(`Amend the price and/or the quantity of an open order in place. The quantity is the new
+total quantity of the order including the filled part. The order keeps its place in the queue if its price is
+unchanged and its quantity isn't increased:
+
+$ okexchaincli tx order amend ID0000000010-1 --price 1.2 --quantity 5 --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			flags := cmd.Flags()
+			price, quantity := sdk.ZeroDec(), sdk.ZeroDec()
+			if priceStr, _ := flags.GetString(FlagPrice); priceStr != "" {
+				var err error
+				if price, err = sdk.NewDecFromStr(priceStr); err != nil {
+					return fmt.Errorf("invalid price:%s", priceStr)
+				}
+			}
+			if quantityStr, _ := flags.GetString(FlagQuantity); quantityStr != "" {
+				var err error
+				if quantity, err = sdk.NewDecFromStr(quantityStr); err != nil {
+					return fmt.Errorf("invalid quantity:%s", quantityStr)
+				}
+			}
+
+			msg := types.NewMsgAmendOrder(cliCtx.GetFromAddress(), args[0], price, quantity)
+			if granter, _ := flags.GetString(FlagGranter); granter != "" {
+				granterAddr, err := sdk.AccAddressFromBech32(granter)
+				if err != nil {
+					return fmt.Errorf("invalid granter:%s", granter)
+				}
+				msg = msg.WithGranter(granterAddr)
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagPrice, "", "The new price of the order, unchanged if empty")
+	cmd.Flags().String(FlagQuantity, "", "The new total quantity of the order, unchanged if empty")
+	cmd.Flags().String(FlagGranter, "", "The account the order is amended for under its trading grant")
+	return cmd
+}
+
+func getCmdGrantTrading(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee]",
+		Short: "authorize an account to place and cancel orders on your behalf",
+		Long: strings.TrimSpace(`Authorize an account to place and cancel orders on your behalf, the grantee can't transfer
+or withdraw your coins. The grant expires at the expiration, and the value of all the orders placed under the grant
+is limited by the max notional in the native token:
+
+$ okexchaincli tx order grant okexchain1xxx --products mycoin_okt --expiration 2021-12-31T00:00:00Z --max-notional 10000 --from mykey
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee:%s", args[0])
+			}
+			flags := cmd.Flags()
+			var products []string
+			if productsStr, _ := flags.GetString(FlagProducts); productsStr != "" {
+				products = strings.Split(productsStr, ",")
+			}
+			expirationStr, _ := flags.GetString(FlagExpiration)
+			expiration, err := time.Parse(time.RFC3339, expirationStr)
+			if err != nil {
+				return fmt.Errorf("invalid expiration:%s", expirationStr)
+			}
+			maxNotional := sdk.ZeroDec()
+			if maxNotionalStr, _ := flags.GetString(FlagMaxNotional); maxNotionalStr != "" {
+				if maxNotional, err = sdk.NewDecFromStr(maxNotionalStr); err != nil {
+					return fmt.Errorf("invalid max notional:%s", maxNotionalStr)
+				}
+			}
+
+			msg := types.NewMsgGrantTrading(cliCtx.GetFromAddress(), grantee, products, expiration, maxNotional)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(FlagProducts, "", "The products the grantee is allowed to trade separated by commas, all the products if empty")
+	cmd.Flags().String(FlagExpiration, "", "The expiration of the grant in RFC3339, e.g. 2021-12-31T00:00:00Z")
+	cmd.Flags().String(FlagMaxNotional, "", "The max value of all the orders placed under the grant in the native token, unlimited if empty")
+	return cmd
+}
+
+func getCmdRevokeTrading(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke [grantee]",
+		Short: "revoke the trading grant to an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := authtxb.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee:%s", args[0])
+			}
+			msg := types.NewMsgRevokeTrading(cliCtx.GetFromAddress(), grantee)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
 }
@@ -19,6 +19,9 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/order/depthbook", orderBookHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/order/feetier/{address}", feeTierHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/order/grants/{granter}", grantsHandler(cliCtx)).Methods("GET")
+	r.HandleFunc("/order/halts", haltsHandler(cliCtx)).Methods("GET")
 	r.HandleFunc("/order/{orderID}", orderDetailHandler(cliCtx)).Methods("GET")
 }
 
@@ -45,6 +48,67 @@ func orderDetailHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func feeTierHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		address := mux.Vars(r)["address"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/order/%s/%s", types.QueryFeeTier, address), nil)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		var feeTier types.AccountFeeTier
+		codec.Cdc.MustUnmarshalJSON(res, &feeTier)
+		resBytes, err := json.Marshal(common.GetBaseResponse(feeTier))
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, resBytes)
+	}
+}
+
+func grantsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		granter := mux.Vars(r)["granter"]
+
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/order/%s/%s", types.QueryGrants, granter), nil)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		var grants types.TradingGrants
+		codec.Cdc.MustUnmarshalJSON(res, &grants)
+		resBytes, err := json.Marshal(common.GetBaseResponse(grants))
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, resBytes)
+	}
+}
+
+func haltsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/order/%s", types.QueryHalts), nil)
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+
+		var halts []types.ProductHalt
+		codec.Cdc.MustUnmarshalJSON(res, &halts)
+		resBytes, err := json.Marshal(common.GetBaseResponse(halts))
+		if err != nil {
+			common.HandleErrorMsg(w, cliCtx, err.Error())
+			return
+		}
+		rest.PostProcessResponse(w, cliCtx, resBytes)
+	}
+}
+
 func orderBookHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		product := r.URL.Query().Get("product")
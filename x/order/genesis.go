@@ -10,8 +10,9 @@ import (
 
 // GenesisState - all order state that must be provided at genesis
 type GenesisState struct {
-	Params     types.Params   `json:"params"`
-	OpenOrders []*types.Order `json:"open_orders"`
+	Params        types.Params         `json:"params"`
+	OpenOrders    []*types.Order       `json:"open_orders"`
+	TradingGrants []types.TradingGrant `json:"trading_grants"`
 }
 
 // DefaultGenesisState - default GenesisState used by Cosmos Hub
@@ -54,6 +55,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) {
 	if len(data.OpenOrders) > 0 {
 		keeper.Cache2Disk(ctx)
 	}
+
+	for _, grant := range data.TradingGrants {
+		keeper.SetTradingGrant(ctx, grant)
+	}
 }
 
 // ExportGenesis writes the current store values
@@ -109,8 +114,17 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) (data GenesisState) {
 		}
 	}
 
+	var tradingGrants []types.TradingGrant
+	keeper.IterateTradingGrants(ctx, func(grant types.TradingGrant) (stop bool) {
+		if !grant.IsExpired(ctx.BlockTime()) {
+			tradingGrants = append(tradingGrants, grant)
+		}
+		return false
+	})
+
 	return GenesisState{
-		Params:     *params,
-		OpenOrders: openOrders,
+		Params:        *params,
+		OpenOrders:    openOrders,
+		TradingGrants: tradingGrants,
 	}
 }
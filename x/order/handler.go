@@ -25,6 +25,14 @@ func CalculateGas(msg sdk.Msg, params *types.Params) (gas uint64) {
 		gas = msg.CalculateGas(params.NewOrderMsgGasUnit)
 	case types.MsgCancelOrders:
 		gas = msg.CalculateGas(params.CancelOrderMsgGasUnit)
+	case types.MsgCancelAllOrders:
+		gas = params.CancelOrderMsgGasUnit
+	case types.MsgAmendOrder:
+		gas = params.NewOrderMsgGasUnit
+	case types.MsgGrantTrading:
+		gas = params.NewOrderMsgGasUnit
+	case types.MsgRevokeTrading:
+		gas = params.CancelOrderMsgGasUnit
 	default:
 		gas = math.MaxUint64
 	}
@@ -35,7 +43,18 @@ func CalculateGas(msg sdk.Msg, params *types.Params) (gas uint64) {
 // NewOrderHandler returns the handler with version 0.
 func NewOrderHandler(keeper keeper.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
-		gas := CalculateGas(msg, keeper.GetParams(ctx))
+		params := keeper.GetParams(ctx)
+		gas := CalculateGas(msg, params)
+		// the open orders to cancel are looked up once for both the gas and the handling
+		var openOrders []*types.Order
+		if msg, ok := msg.(types.MsgCancelAllOrders); ok {
+			// cancel-all pays the cancel gas unit for every order it cancels
+			openOrders = keeper.GetAccountOpenOrders(ctx, msg.GetOwner(), msg.Product, msg.Side,
+				types.MultiCancelOrderItemLimit)
+			if len(openOrders) > 1 {
+				gas = uint64(len(openOrders)) * params.CancelOrderMsgGasUnit
+			}
+		}
 
 		// consume gas that msg required, it will panic if gas is insufficient
 		ctx.GasMeter().ConsumeGas(gas, storetypes.GasWriteCostFlatDesc)
@@ -64,6 +83,26 @@ func NewOrderHandler(keeper keeper.Keeper) sdk.Handler {
 			handlerFun = func() (*sdk.Result, error) {
 				return handleMsgCancelOrders(ctx, keeper, msg, logger)
 			}
+		case types.MsgCancelAllOrders:
+			name = "handleMsgCancelAllOrders"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgCancelAllOrders(ctx, keeper, msg, openOrders, logger)
+			}
+		case types.MsgAmendOrder:
+			name = "handleMsgAmendOrder"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgAmendOrder(ctx, keeper, msg, logger)
+			}
+		case types.MsgGrantTrading:
+			name = "handleMsgGrantTrading"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgGrantTrading(ctx, keeper, msg, logger)
+			}
+		case types.MsgRevokeTrading:
+			name = "handleMsgRevokeTrading"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgRevokeTrading(ctx, keeper, msg, logger)
+			}
 		default:
 			errMsg := fmt.Sprintf("Invalid msg type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -108,7 +147,7 @@ func checkOrderNewMsg(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgNewOrd
 	if msg.Quantity.LT(tokenPair.MinQuantity) {
 		return fmt.Errorf("quantity should be greater than %s", tokenPair.MinQuantity)
 	}
-	return nil
+	return keeper.CheckPriceBand(ctx, msg.Product, msg.Price)
 }
 
 func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ratio string) *types.Order {
@@ -128,7 +167,9 @@ func getOrderFromMsg(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrder, ra
 	)
 }
 
-func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
+// handleNewOrder places the order of the sender, the grantee places it under the trading grant of the sender if it
+// isn't empty
+func handleNewOrder(ctx sdk.Context, k Keeper, sender, grantee sdk.AccAddress,
 	item types.OrderItem, ratio string, logger log.Logger) (types.OrderResult, sdk.CacheMultiStore, error) {
 
 	cacheItem := ctx.MultiStore().CacheMultiStore()
@@ -141,12 +182,16 @@ func handleNewOrder(ctx sdk.Context, k Keeper, sender sdk.AccAddress,
 		Quantity: item.Quantity,
 	}
 	order := getOrderFromMsg(ctxItem, k, msg, ratio)
+	order.STPMode = item.STPMode
 	err := checkOrderNewMsg(ctxItem, k, msg)
 
 	if err == nil {
 		if k.IsProductLocked(ctx, msg.Product) {
 			err = sdk.ErrInternal(fmt.Sprintf("the trading pair (%s) is locked, please retry later", order.Product))
-		} else {
+		} else if !grantee.Empty() {
+			err = k.SpendTradingGrant(ctxItem, grantee, order)
+		}
+		if err == nil {
 			err = k.PlaceOrder(ctxItem, order)
 		}
 	}
@@ -181,10 +226,18 @@ func handleMsgNewOrders(ctx sdk.Context, k Keeper, msg types.MsgNewOrders,
 		ratio = "0.8"
 	}
 
+	var grantee sdk.AccAddress
+	if !msg.Granter.Empty() {
+		if _, err := k.GetValidTradingGrant(ctx, msg.Granter, msg.Sender); err != nil {
+			return nil, err
+		}
+		grantee = msg.Sender
+	}
+
 	rs := make([]types.OrderResult, 0, len(msg.OrderItems))
 	var handlerResult bitset.BitSet
 	for idx, item := range msg.OrderItems {
-		res, cacheItem, err := handleNewOrder(ctx, k, msg.Sender, item, ratio, logger)
+		res, cacheItem, err := handleNewOrder(ctx, k, msg.GetOwner(), grantee, item, ratio, logger)
 		if err == nil {
 			cacheItem.Write()
 			handlerResult.Set(uint(idx))
@@ -215,9 +268,18 @@ func ValidateMsgNewOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrde
 		ratio = "0.8"
 	}
 
+	// the notional spent by the items is checked in a cache context, which is discarded
+	grantCtx, _ := ctx.CacheContext()
+	if !msg.Granter.Empty() {
+		if _, err := k.GetValidTradingGrant(ctx, msg.Granter, msg.Sender); err != nil {
+			return nil, err
+		}
+	}
+
 	for _, item := range msg.OrderItems {
+		grantee := msg.Sender
 		msg := MsgNewOrder{
-			Sender:   msg.Sender,
+			Sender:   msg.GetOwner(),
 			Product:  item.Product,
 			Side:     item.Side,
 			Price:    item.Price,
@@ -232,6 +294,11 @@ func ValidateMsgNewOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrde
 		}
 
 		order := getOrderFromMsg(ctx, k, msg, ratio)
+		if !order.Sender.Equals(grantee) {
+			if err := k.SpendTradingGrant(grantCtx, grantee, order); err != nil {
+				return nil, err
+			}
+		}
 		_, err = k.TryPlaceOrder(ctx, order)
 		if err != nil {
 			return sdk.ErrInsufficientCoins(err.Error()).Result()
@@ -242,8 +309,8 @@ func ValidateMsgNewOrders(ctx sdk.Context, k keeper.Keeper, msg types.MsgNewOrde
 
 }
 
-func handleCancelOrder(context sdk.Context, k Keeper, sender sdk.AccAddress, orderID string, logger log.Logger) (
-	types.OrderResult, sdk.CacheMultiStore) {
+func handleCancelOrder(context sdk.Context, k Keeper, sender, grantee sdk.AccAddress, orderID string,
+	logger log.Logger) (types.OrderResult, sdk.CacheMultiStore) {
 
 	cacheItem := context.MultiStore().CacheMultiStore()
 	ctx := context.WithMultiStore(cacheItem)
@@ -253,7 +320,7 @@ func handleCancelOrder(context sdk.Context, k Keeper, sender sdk.AccAddress, ord
 		Sender:  sender,
 		OrderID: orderID,
 	}
-	err := validateCancelOrder(ctx, k, msg)
+	err := validateCancelOrder(ctx, k, msg, grantee)
 	var message string
 
 	if err == nil {
@@ -273,11 +340,19 @@ func handleCancelOrder(context sdk.Context, k Keeper, sender sdk.AccAddress, ord
 }
 
 func handleMsgCancelOrders(ctx sdk.Context, k Keeper, msg types.MsgCancelOrders, logger log.Logger) (*sdk.Result, error) {
+	var grantee sdk.AccAddress
+	if !msg.Granter.Empty() {
+		if _, err := k.GetValidTradingGrant(ctx, msg.Granter, msg.Sender); err != nil {
+			return nil, err
+		}
+		grantee = msg.Sender
+	}
+
 	cancelRes := []types.OrderResult{}
 	var handlerResult bitset.BitSet
 	for idx, orderID := range msg.OrderIDs {
 
-		res, cacheItem := handleCancelOrder(ctx, k, msg.Sender, orderID, logger)
+		res, cacheItem := handleCancelOrder(ctx, k, msg.GetOwner(), grantee, orderID, logger)
 		cancelRes = append(cancelRes, res)
 		cacheItem.Write()
 		if res.Error == nil {
@@ -310,7 +385,9 @@ func handleMsgCancelOrders(ctx sdk.Context, k Keeper, msg types.MsgCancelOrders,
 	}, nil
 }
 
-func validateCancelOrder(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCancelOrder) error {
+// validateCancelOrder checks the order canceled by the sender, the grantee cancels it under the trading grant of the
+// sender if it isn't empty
+func validateCancelOrder(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCancelOrder, grantee sdk.AccAddress) error {
 	order := keeper.GetOrder(ctx, msg.OrderID)
 
 	// Check order
@@ -323,6 +400,11 @@ func validateCancelOrder(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCan
 	if !order.Sender.Equals(msg.Sender) {
 		return sdk.ErrUnauthorized(fmt.Sprintf("not the owner of order(%v)", msg.OrderID))
 	}
+	if !grantee.Empty() {
+		if err := keeper.CheckCancelGrant(ctx, grantee, order); err != nil {
+			return err
+		}
+	}
 	if keeper.IsProductLocked(ctx, order.Product) {
 		return sdk.ErrInternal(fmt.Sprintf("the trading pair (%s) is locked, please retry later", order.Product))
 	}
@@ -331,12 +413,16 @@ func validateCancelOrder(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCan
 
 // ValidateMsgCancelOrders validates whether the msg of cancelOrders is valid.
 func ValidateMsgCancelOrders(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCancelOrders) error {
+	var grantee sdk.AccAddress
+	if !msg.Granter.Empty() {
+		grantee = msg.Sender
+	}
 	for _, orderID := range msg.OrderIDs {
 		msg := MsgCancelOrder{
-			Sender:  msg.Sender,
+			Sender:  msg.GetOwner(),
 			OrderID: orderID,
 		}
-		err := validateCancelOrder(ctx, keeper, msg)
+		err := validateCancelOrder(ctx, keeper, msg, grantee)
 		if err != nil {
 			return err
 		}
@@ -344,3 +430,206 @@ func ValidateMsgCancelOrders(ctx sdk.Context, keeper keeper.Keeper, msg types.Ms
 
 	return nil
 }
+
+// handleMsgCancelAllOrders cancels the open orders of the owner matching the msg, which are looked up for the gas
+func handleMsgCancelAllOrders(ctx sdk.Context, k Keeper, msg types.MsgCancelAllOrders, orders []*types.Order,
+	logger log.Logger) (*sdk.Result, error) {
+	var grantee sdk.AccAddress
+	if !msg.Granter.Empty() {
+		if _, err := k.GetValidTradingGrant(ctx, msg.Granter, msg.Sender); err != nil {
+			return nil, err
+		}
+		grantee = msg.Sender
+	}
+
+	cancelRes := make([]types.OrderResult, 0, len(orders))
+	canceledNum := 0
+	for _, order := range orders {
+		res, cacheItem := handleCancelOrder(ctx, k, msg.GetOwner(), grantee, order.OrderID, logger)
+		cancelRes = append(cancelRes, res)
+		cacheItem.Write()
+		if res.Error == nil {
+			canceledNum++
+		}
+	}
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+		"    msg<Sender:%s,Product:%s,Side:%s>\n"+
+		"    result<The User have canceled %d orders>\n",
+		ctx.BlockHeight(), "handleMsgCancelAllOrders",
+		msg.Sender, msg.Product, msg.Side, canceledNum))
+
+	if canceledNum == 0 {
+		return sdk.ErrUnknownRequest("no open order to cancel").Result()
+	}
+
+	rss, err := json.Marshal(&cancelRes)
+	if err != nil {
+		rss = []byte(fmt.Sprintf("failed to marshal result to JSON: %s", err))
+	}
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	event = event.AppendAttributes(sdk.NewAttribute("orders", string(rss)))
+	ctx.EventManager().EmitEvent(event)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// ValidateMsgCancelAllOrders validates whether the msg of cancelAllOrders is valid.
+func ValidateMsgCancelAllOrders(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCancelAllOrders) error {
+	if !msg.Granter.Empty() {
+		if _, err := keeper.GetValidTradingGrant(ctx, msg.Granter, msg.Sender); err != nil {
+			return err
+		}
+	}
+	if msg.Product != "" && keeper.IsProductLocked(ctx, msg.Product) {
+		return sdk.ErrInternal(fmt.Sprintf("the trading pair (%s) is locked, please retry later", msg.Product))
+	}
+	return nil
+}
+
+// validateAmendOrder checks the order amended by the sender, and returns the order with its new price and quantity.
+// The grantee amends it under the trading grant of the owner if it isn't empty
+func validateAmendOrder(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgAmendOrder,
+	grantee sdk.AccAddress) (order *types.Order, price, quantity sdk.Dec, err error) {
+	order = keeper.GetOrder(ctx, msg.OrderID)
+	if order == nil {
+		return nil, price, quantity, sdk.ErrUnknownRequest(
+			fmt.Sprintf("order(%s) does not exist or already closed", msg.OrderID))
+	}
+	if order.Status != types.OrderStatusOpen {
+		return nil, price, quantity, sdk.ErrInternal(fmt.Sprintf("cannot amend order with status(%d)", order.Status))
+	}
+	if !order.Sender.Equals(msg.GetOwner()) {
+		return nil, price, quantity, sdk.ErrUnauthorized(fmt.Sprintf("not the owner of order(%v)", msg.OrderID))
+	}
+	if keeper.IsProductLocked(ctx, order.Product) {
+		return nil, price, quantity, sdk.ErrInternal(
+			fmt.Sprintf("the trading pair (%s) is locked, please retry later", order.Product))
+	}
+
+	price, quantity = order.Price, order.Quantity
+	if msg.Price.IsPositive() {
+		price = msg.Price
+	}
+	if msg.Quantity.IsPositive() {
+		quantity = msg.Quantity
+	}
+	if price.Equal(order.Price) && quantity.Equal(order.Quantity) {
+		return nil, price, quantity, sdk.ErrUnknownRequest("neither price nor quantity of the order is changed")
+	}
+	newMsg := MsgNewOrder{
+		Sender:   order.Sender,
+		Product:  order.Product,
+		Side:     order.Side,
+		Price:    price,
+		Quantity: quantity,
+	}
+	if err := checkOrderNewMsg(ctx, keeper, newMsg); err != nil {
+		return nil, price, quantity, sdk.ErrUnknownRequest(err.Error())
+	}
+	filledQuantity := order.Quantity.Sub(order.RemainQuantity)
+	if quantity.LTE(filledQuantity) {
+		return nil, price, quantity, sdk.ErrUnknownRequest(
+			fmt.Sprintf("quantity(%s) should be greater than the filled quantity(%s)", quantity, filledQuantity))
+	}
+	if !grantee.Empty() {
+		if err := keeper.SpendTradingGrantOnAmend(ctx, grantee, order, price, quantity); err != nil {
+			return nil, price, quantity, err
+		}
+	}
+	return order, price, quantity, nil
+}
+
+func handleMsgAmendOrder(ctx sdk.Context, k Keeper, msg types.MsgAmendOrder, logger log.Logger) (*sdk.Result, error) {
+	var grantee sdk.AccAddress
+	if !msg.Granter.Empty() {
+		grantee = msg.Sender
+	}
+	order, price, quantity, err := validateAmendOrder(ctx, k, msg, grantee)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.AmendOrder(ctx, order, price, quantity); err != nil {
+		return sdk.ErrInsufficientCoins(err.Error()).Result()
+	}
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>\n"+
+		"    msg<Sender:%s,ID:%s,Price:%s,Quantity:%s>\n"+
+		"    result<The User have amended an order {ID:%s,RemainQuantity:%s} >\n",
+		ctx.BlockHeight(), "handleMsgAmendOrder",
+		msg.Sender, msg.OrderID, price, quantity, order.OrderID, order.RemainQuantity))
+
+	rss, err := json.Marshal(&[]types.OrderResult{{OrderID: order.OrderID}})
+	if err != nil {
+		rss = []byte(fmt.Sprintf("failed to marshal result to JSON: %s", err))
+	}
+	event := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName))
+	event = event.AppendAttributes(sdk.NewAttribute("orders", string(rss)))
+	ctx.EventManager().EmitEvent(event)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// ValidateMsgAmendOrder validates whether the msg of amendOrder is valid.
+func ValidateMsgAmendOrder(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgAmendOrder) error {
+	var grantee sdk.AccAddress
+	if !msg.Granter.Empty() {
+		grantee = msg.Sender
+	}
+	// the notional spent and the coins locked are checked in a cache context, which is discarded
+	cacheCtx, _ := ctx.CacheContext()
+	order, price, quantity, err := validateAmendOrder(cacheCtx, keeper, msg, grantee)
+	if err != nil {
+		return err
+	}
+	if err := keeper.TryAmendOrder(cacheCtx, order, price, quantity); err != nil {
+		return sdk.ErrInsufficientCoins(err.Error())
+	}
+	return nil
+}
+
+func handleMsgGrantTrading(ctx sdk.Context, k Keeper, msg types.MsgGrantTrading, logger log.Logger) (*sdk.Result, error) {
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("expiration(%s) should be after the block time", msg.Expiration)).Result()
+	}
+	for _, product := range msg.Products {
+		if k.GetDexKeeper().GetTokenPair(ctx, product) == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("trading pair '%s' does not exist", product)).Result()
+		}
+	}
+
+	grant := types.NewTradingGrant(msg.Granter, msg.Grantee, msg.Products, msg.Expiration, msg.MaxNotional)
+	k.SetTradingGrant(ctx, grant)
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, %s", ctx.BlockHeight(), "handleMsgGrantTrading", grant))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute("granter", msg.Granter.String()),
+		sdk.NewAttribute("grantee", msg.Grantee.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeTrading(ctx sdk.Context, k Keeper, msg types.MsgRevokeTrading, logger log.Logger) (*sdk.Result, error) {
+	if _, found := k.GetTradingGrant(ctx, msg.Granter, msg.Grantee); !found {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("%s has no trading grant from %s", msg.Grantee, msg.Granter)).Result()
+	}
+	k.DeleteTradingGrant(ctx, msg.Granter, msg.Grantee)
+
+	logger.Debug(fmt.Sprintf("BlockHeight<%d>, handler<%s>, granter<%s>, grantee<%s>",
+		ctx.BlockHeight(), "handleMsgRevokeTrading", msg.Granter, msg.Grantee))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute("granter", msg.Granter.String()),
+		sdk.NewAttribute("grantee", msg.Grantee.String()),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
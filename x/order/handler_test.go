@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/x/supply"
 
@@ -1028,3 +1029,210 @@ func getHash(t *testing.T, orderIdList []string, order *Order) {
 	fmt.Println(orderIdList)
 	fmt.Println(res)
 }
+
+func TestHandleMsgTradingGrant(t *testing.T) {
+	mapp, addrKeysSlice := getMockApp(t, 3)
+	keeper := mapp.orderKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	blockTime := time.Now()
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10).WithBlockTime(blockTime)
+	feeParams := types.DefaultTestParams()
+	mapp.orderKeeper.SetParams(ctx, &feeParams)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := mapp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	handler := NewOrderHandler(keeper)
+	granter, grantee, other := addrKeysSlice[0].Address, addrKeysSlice[1].Address, addrKeysSlice[2].Address
+
+	// no grant yet
+	orderItems := []types.OrderItem{types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "10.0", "1.0")}
+	newMsg := types.NewMsgNewOrders(grantee, orderItems).WithGranter(granter)
+	_, err = handler(ctx, newMsg)
+	require.NotNil(t, err)
+
+	// grant of an unknown product, or expired already
+	grantMsg := types.NewMsgGrantTrading(granter, grantee, []string{"abc_" + common.NativeToken},
+		blockTime.Add(time.Hour), sdk.MustNewDecFromStr("25"))
+	_, err = handler(ctx, grantMsg)
+	require.NotNil(t, err)
+	grantMsg = types.NewMsgGrantTrading(granter, grantee, []string{types.TestTokenPair}, blockTime,
+		sdk.MustNewDecFromStr("25"))
+	_, err = handler(ctx, grantMsg)
+	require.NotNil(t, err)
+
+	grantMsg.Expiration = blockTime.Add(time.Hour)
+	_, err = handler(ctx, grantMsg)
+	require.Nil(t, err)
+	grants := keeper.GetTradingGrants(ctx, granter)
+	require.Equal(t, 1, len(grants))
+	require.True(t, grants[0].SpentNotional.IsZero())
+
+	// the order placed by the grantee is owned by the granter
+	result, err := handler(ctx, newMsg)
+	require.Nil(t, err)
+	orderID := getOrderID(result)
+	order := keeper.GetOrder(ctx, orderID)
+	require.NotNil(t, order)
+	require.EqualValues(t, granter, order.Sender)
+	grant, found := keeper.GetTradingGrant(ctx, granter, grantee)
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("10"), grant.SpentNotional)
+
+	// over the remaining notional
+	overItems := []types.OrderItem{types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "10.0", "2.0")}
+	_, err = handler(ctx, types.NewMsgNewOrders(grantee, overItems).WithGranter(granter))
+	require.NotNil(t, err)
+
+	// others can't cancel the order, the grantee can
+	_, err = handler(ctx, types.NewMsgCancelOrder(other, orderID).WithGranter(granter))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgCancelOrder(grantee, orderID).WithGranter(granter))
+	require.Nil(t, err)
+	require.EqualValues(t, types.OrderStatusCancelled, keeper.GetOrder(ctx, orderID).Status)
+
+	// expired grant
+	expiredCtx := ctx.WithBlockTime(blockTime.Add(time.Hour))
+	_, err = handler(expiredCtx, newMsg)
+	require.NotNil(t, err)
+
+	// revoked grant
+	_, err = handler(ctx, types.NewMsgRevokeTrading(granter, grantee))
+	require.Nil(t, err)
+	_, err = handler(ctx, newMsg)
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgRevokeTrading(granter, grantee))
+	require.NotNil(t, err)
+}
+
+func TestHandleMsgAmendOrder(t *testing.T) {
+	mapp, addrKeysSlice := getMockApp(t, 1)
+	keeper := mapp.orderKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	feeParams := types.DefaultTestParams()
+	mapp.orderKeeper.SetParams(ctx, &feeParams)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := mapp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	handler := NewOrderHandler(keeper)
+	addr := addrKeysSlice[0].Address
+	orderItems := []types.OrderItem{
+		types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+		types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+	}
+	result, err := handler(ctx, types.NewMsgNewOrders(addr, orderItems))
+	require.Nil(t, err)
+	orderIDs := getOrderIDList(result)
+	require.Equal(t, 2, len(orderIDs))
+	key := types.FormatOrderIDsKey(types.TestTokenPair, sdk.MustNewDecFromStr("10.0"), types.BuyOrder)
+	nativeBalance := func() sdk.Dec {
+		return mapp.AccountKeeper.GetAccount(ctx, addr).GetCoins().AmountOf(common.NativeToken)
+	}
+	balance := nativeBalance()
+
+	// reduce the quantity, the order keeps its place and unlocks the coins
+	msg := types.NewMsgAmendOrder(addr, orderIDs[0], sdk.ZeroDec(), sdk.MustNewDecFromStr("0.5"))
+	require.Nil(t, ValidateMsgAmendOrder(ctx, keeper, msg))
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	order := keeper.GetOrder(ctx, orderIDs[0])
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), order.Quantity)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), order.RemainQuantity)
+	require.Equal(t, sdk.MustNewDecFromStr("5"), order.RemainLocked)
+	require.Zero(t, order.AmendHeight)
+	require.Equal(t, orderIDs, keeper.GetProductPriceOrderIDs(key))
+	require.Equal(t, balance.Add(sdk.MustNewDecFromStr("5")), nativeBalance())
+	depthBook := keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.Equal(t, sdk.MustNewDecFromStr("1.5"), depthBook.Items[0].BuyQuantity)
+
+	// increase the quantity, the order is moved to the back of the queue
+	msg = types.NewMsgAmendOrder(addr, orderIDs[0], sdk.ZeroDec(), sdk.MustNewDecFromStr("2"))
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, []string{orderIDs[1], orderIDs[0]}, keeper.GetProductPriceOrderIDs(key))
+	require.Equal(t, balance.Sub(sdk.MustNewDecFromStr("10")), nativeBalance())
+
+	// change the price in a later block, the order takes the liquidity in the block like a new one
+	ctx = ctx.WithBlockHeight(11)
+	msg = types.NewMsgAmendOrder(addr, orderIDs[0], sdk.MustNewDecFromStr("9"), sdk.ZeroDec())
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	order = keeper.GetOrder(ctx, orderIDs[0])
+	require.EqualValues(t, 11, order.AmendHeight)
+	require.Equal(t, types.RoleTaker, types.GetOrderRole(order, 11))
+	require.Equal(t, types.RoleMaker, types.GetOrderRole(keeper.GetOrder(ctx, orderIDs[1]), 11))
+	require.Equal(t, []string{orderIDs[1]}, keeper.GetProductPriceOrderIDs(key))
+	newKey := types.FormatOrderIDsKey(types.TestTokenPair, sdk.MustNewDecFromStr("9"), types.BuyOrder)
+	require.Equal(t, []string{orderIDs[0]}, keeper.GetProductPriceOrderIDs(newKey))
+	require.Equal(t, balance.Sub(sdk.MustNewDecFromStr("8")), nativeBalance())
+	depthBook = keeper.GetDepthBookCopy(types.TestTokenPair)
+	require.Equal(t, 2, len(depthBook.Items))
+	require.Equal(t, sdk.MustNewDecFromStr("2"), depthBook.Items[1].BuyQuantity)
+
+	// insufficient coins
+	msg = types.NewMsgAmendOrder(addr, orderIDs[0], sdk.ZeroDec(), sdk.MustNewDecFromStr("1000"))
+	require.NotNil(t, ValidateMsgAmendOrder(ctx, keeper, msg))
+	_, err = handler(ctx, msg)
+	require.NotNil(t, err)
+
+	// nothing changed, over accuracy, unknown order
+	_, err = handler(ctx, types.NewMsgAmendOrder(addr, orderIDs[0], sdk.MustNewDecFromStr("9"), sdk.ZeroDec()))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgAmendOrder(addr, orderIDs[0], sdk.MustNewDecFromStr("9.000000001"),
+		sdk.ZeroDec()))
+	require.NotNil(t, err)
+	_, err = handler(ctx, types.NewMsgAmendOrder(addr, "abc", sdk.MustNewDecFromStr("9"), sdk.ZeroDec()))
+	require.NotNil(t, err)
+}
+
+func TestHandleMsgCancelAllOrders(t *testing.T) {
+	mapp, addrKeysSlice := getMockApp(t, 2)
+	keeper := mapp.orderKeeper
+	mapp.BeginBlock(abci.RequestBeginBlock{Header: abci.Header{Height: 2}})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{}).WithBlockHeight(10)
+	feeParams := types.DefaultTestParams()
+	mapp.orderKeeper.SetParams(ctx, &feeParams)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := mapp.dexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	handler := NewOrderHandler(keeper)
+	addr, otherAddr := addrKeysSlice[0].Address, addrKeysSlice[1].Address
+	orderItems := []types.OrderItem{
+		types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "9.0", "1.0"),
+		types.NewOrderItem(types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+		types.NewOrderItem(types.TestTokenPair, types.SellOrder, "11.0", "1.0"),
+	}
+	_, err = handler(ctx, types.NewMsgNewOrders(addr, orderItems))
+	require.Nil(t, err)
+	_, err = handler(ctx, types.NewMsgNewOrders(otherAddr, orderItems))
+	require.Nil(t, err)
+	require.Equal(t, 3, len(keeper.GetAccountOpenOrders(ctx, addr, "", "", types.MultiCancelOrderItemLimit)))
+	require.Equal(t, 1, len(keeper.GetAccountOpenOrders(ctx, addr, "", "", 1)))
+
+	// cancel the sell orders only
+	msg := types.NewMsgCancelAllOrders(addr, types.TestTokenPair, types.SellOrder)
+	require.Nil(t, ValidateMsgCancelAllOrders(ctx, keeper, msg))
+	_, err = handler(ctx, msg)
+	require.Nil(t, err)
+	orders := keeper.GetAccountOpenOrders(ctx, addr, "", "", types.MultiCancelOrderItemLimit)
+	require.Equal(t, 2, len(orders))
+	for _, order := range orders {
+		require.Equal(t, types.BuyOrder, order.Side)
+	}
+
+	// cancel all
+	_, err = handler(ctx, types.NewMsgCancelAllOrders(addr, "", ""))
+	require.Nil(t, err)
+	require.Equal(t, 0, len(keeper.GetAccountOpenOrders(ctx, addr, "", "", types.MultiCancelOrderItemLimit)))
+	require.Equal(t, 3, len(keeper.GetAccountOpenOrders(ctx, otherAddr, "", "", types.MultiCancelOrderItemLimit)))
+
+	// nothing to cancel
+	_, err = handler(ctx, types.NewMsgCancelAllOrders(addr, "", ""))
+	require.NotNil(t, err)
+}
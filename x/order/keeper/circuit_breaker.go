@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// CheckPriceBand returns an error if the price is farther from the last price of the product than the price band
+func (k Keeper) CheckPriceBand(ctx sdk.Context, product string, price sdk.Dec) error {
+	bandRate := k.GetParams(ctx).GetPriceProtection(product).PriceBandRate
+	if !bandRate.IsPositive() {
+		return nil
+	}
+	refPrice := k.GetLastPrice(ctx, product)
+	if !refPrice.IsPositive() {
+		return nil
+	}
+	if types.PriceDeviation(price, refPrice).GT(bandRate) {
+		return fmt.Errorf("price(%s) is out of the price band [%s, %s] of %s", price,
+			refPrice.Mul(sdk.OneDec().Sub(bandRate)), refPrice.Mul(sdk.OneDec().Add(bandRate)), product)
+	}
+	return nil
+}
+
+// GetPriceWindow returns the recent clearing prices of the product, from the oldest to the newest
+func (k Keeper) GetPriceWindow(ctx sdk.Context, product string) (points []types.PricePoint) {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetPriceWindowKey(product))
+	if bz == nil {
+		return nil
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &points)
+	return points
+}
+
+// SetPriceWindow saves the recent clearing prices of the product, it's deleted if there is none
+func (k Keeper) SetPriceWindow(ctx sdk.Context, product string, points []types.PricePoint) {
+	store := ctx.KVStore(k.orderStoreKey)
+	if len(points) == 0 {
+		store.Delete(types.GetPriceWindowKey(product))
+		return
+	}
+	store.Set(types.GetPriceWindowKey(product), k.cdc.MustMarshalBinaryBare(points))
+}
+
+// GetProductHalt returns the circuit breaker halt of the product, or nil if the matching isn't paused
+func (k Keeper) GetProductHalt(ctx sdk.Context, product string) *types.ProductHalt {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetProductHaltKey(product))
+	if bz == nil {
+		return nil
+	}
+	halt := &types.ProductHalt{}
+	k.cdc.MustUnmarshalBinaryBare(bz, halt)
+	return halt
+}
+
+// SetProductHalt pauses the matching of the product and caches the halt for the backend and the stream
+func (k Keeper) SetProductHalt(ctx sdk.Context, halt types.ProductHalt) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetProductHaltKey(halt.Product), k.cdc.MustMarshalBinaryBare(halt))
+	k.cache.addProductHalt(halt)
+}
+
+// DeleteProductHalt resumes the matching of the product
+func (k Keeper) DeleteProductHalt(ctx sdk.Context, product string) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.GetProductHaltKey(product))
+}
+
+// GetProductHalts returns the circuit breaker halts of all the products
+func (k Keeper) GetProductHalts(ctx sdk.Context) (halts []types.ProductHalt) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.ProductHaltKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var halt types.ProductHalt
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &halt)
+		halts = append(halts, halt)
+	}
+	return halts
+}
+
+// GetBlockProductHalts returns the circuit breaker halts tripped in this block
+func (k Keeper) GetBlockProductHalts() []types.ProductHalt {
+	return k.cache.getProductHalts()
+}
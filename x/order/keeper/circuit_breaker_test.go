@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func TestCheckPriceBand(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	keeper.SetLastPrice(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"))
+
+	// disabled by default
+	require.Nil(t, keeper.CheckPriceBand(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("100.0")))
+
+	params := keeper.GetParams(ctx)
+	params.PriceBandRate = sdk.MustNewDecFromStr("0.1")
+	keeper.SetParams(ctx, params)
+	require.Nil(t, keeper.CheckPriceBand(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("11.0")))
+	require.Nil(t, keeper.CheckPriceBand(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("9.0")))
+	require.NotNil(t, keeper.CheckPriceBand(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("11.1")))
+	require.NotNil(t, keeper.CheckPriceBand(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("8.9")))
+
+	// the band of the product is overridden
+	protection := params.GetPriceProtection(types.TestTokenPair)
+	protection.PriceBandRate = sdk.MustNewDecFromStr("0.2")
+	params.ProductPriceProtections = types.ProductPriceProtections{{Product: types.TestTokenPair,
+		PriceProtection: protection}}
+	keeper.SetParams(ctx, params)
+	require.Nil(t, keeper.CheckPriceBand(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("11.9")))
+	require.NotNil(t, keeper.CheckPriceBand(ctx, types.TestTokenPair, sdk.MustNewDecFromStr("12.1")))
+}
+
+func TestProductHalt(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	points := []types.PricePoint{{BlockHeight: 9, Price: sdk.MustNewDecFromStr("10.0")}}
+	keeper.SetPriceWindow(ctx, types.TestTokenPair, points)
+	require.EqualValues(t, points, keeper.GetPriceWindow(ctx, types.TestTokenPair))
+	keeper.SetPriceWindow(ctx, types.TestTokenPair, nil)
+	require.Nil(t, keeper.GetPriceWindow(ctx, types.TestTokenPair))
+
+	require.Nil(t, keeper.GetProductHalt(ctx, types.TestTokenPair))
+	halt := types.NewProductHalt(types.TestTokenPair, 10, 5, sdk.MustNewDecFromStr("12.0"),
+		sdk.MustNewDecFromStr("10.0"))
+	keeper.SetProductHalt(ctx, halt)
+	require.EqualValues(t, &halt, keeper.GetProductHalt(ctx, types.TestTokenPair))
+	require.EqualValues(t, []types.ProductHalt{halt}, keeper.GetProductHalts(ctx))
+	require.EqualValues(t, []types.ProductHalt{halt}, keeper.GetBlockProductHalts())
+	require.True(t, halt.IsActive(14))
+	require.False(t, halt.IsActive(15))
+
+	keeper.DeleteProductHalt(ctx, types.TestTokenPair)
+	require.Nil(t, keeper.GetProductHalt(ctx, types.TestTokenPair))
+	keeper.cache.reset()
+	require.Empty(t, keeper.GetBlockProductHalts())
+}
@@ -234,3 +234,34 @@ func (c *DiskCache) removeOrder(order *types.Order) {
 
 	c.closeOrder(order.OrderID)
 }
+
+// amendOrder replaces the old order with the amended one in depthBookMap and orderIDsMap, the order is moved to the
+// back of the order ids of its price unless keepPlace
+func (c *DiskCache) amendOrder(oldOrder, order *types.Order, keepPlace bool) {
+	// update depth book map
+	depthBook := c.getDepthBook(order.Product)
+	if depthBook == nil {
+		depthBook = &types.DepthBook{}
+	}
+	depthBook.RemoveOrder(oldOrder)
+	depthBook.InsertOrder(order)
+	c.setDepthBook(order.Product, depthBook)
+
+	if keepPlace {
+		return
+	}
+
+	// update order id map
+	oldKey := types.FormatOrderIDsKey(oldOrder.Product, oldOrder.Price, oldOrder.Side)
+	oldOrderIDs := c.orderIDsMap.Data[oldKey]
+	orderIDs := make([]string, 0, len(oldOrderIDs))
+	for _, orderID := range oldOrderIDs {
+		if orderID != order.OrderID {
+			orderIDs = append(orderIDs, orderID)
+		}
+	}
+	c.setOrderIDs(oldKey, orderIDs)
+
+	key := types.FormatOrderIDsKey(order.Product, order.Price, order.Side)
+	c.setOrderIDs(key, append(c.orderIDsMap.Data[key], order.OrderID))
+}
@@ -41,10 +41,32 @@ func (k Keeper) DropExpireBlockHeight(ctx sdk.Context, blockHeight int64) {
 }
 
 // ===============================================
-// nolint
+// SetOrder sets the order to keeper, and keeps it in the open order index of its sender while it's open. The index
+// follows the order on its placing, filling and quitting, which all set the order with its new status
 func (k Keeper) SetOrder(ctx sdk.Context, orderID string, order *types.Order) {
 	store := ctx.KVStore(k.orderStoreKey)
 	store.Set(types.GetOrderKey(orderID), k.cdc.MustMarshalBinaryBare(order))
+
+	indexKey := types.GetAccountOrderKey(order.Sender, orderID)
+	if order.Status == types.OrderStatusOpen {
+		store.Set(indexKey, []byte{})
+	} else {
+		store.Delete(indexKey)
+	}
+}
+
+// getAccountOrderIDs returns the IDs of the open orders of the address in the index
+func (k Keeper) getAccountOrderIDs(ctx sdk.Context, addr sdk.AccAddress) []string {
+	store := ctx.KVStore(k.orderStoreKey)
+	prefix := types.GetAccountOrderPrefix(addr)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	var orderIDs []string
+	for ; iter.Valid(); iter.Next() {
+		orderIDs = append(orderIDs, string(iter.Key()[len(prefix):]))
+	}
+	return orderIDs
 }
 
 // nolint
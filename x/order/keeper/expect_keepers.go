@@ -49,4 +49,6 @@ type DexKeeper interface {
 	GetLockedProductsCopy(ctx sdk.Context) *types.ProductLockMap
 	IsAnyProductLocked(ctx sdk.Context) bool
 	GetOperator(ctx sdk.Context, addr sdk.AccAddress) (operator dex.DEXOperator, isExist bool)
+	GetParams(ctx sdk.Context) (params dex.Params)
+	ApplyTradingRuleUpdates(ctx sdk.Context) []*dex.TokenPair
 }
@@ -46,9 +46,10 @@ func GetZeroFee() sdk.SysCoins {
 	return sdk.SysCoins{sdk.ZeroFee()}
 }
 
-// GetDealFee is used to calculate the handling fee when matching an order
+// GetDealFee is used to calculate the handling fee when matching an order, the fee rate is the maker or the taker
+// rate of the order sender
 func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper GetFeeKeeper,
-	feeParams *types.Params) sdk.SysCoins {
+	feeRate sdk.Dec) sdk.SysCoins {
 	symbols := strings.Split(order.Product, "_")
 	symbol := symbols[0]
 	quantity := fillAmt
@@ -58,7 +59,7 @@ func GetDealFee(order *types.Order, fillAmt sdk.Dec, ctx sdk.Context, keeper Get
 	}
 
 	minFeeDec := sdk.MustNewDecFromStr(minFee)
-	feeAmt := quantity.Mul(feeParams.TradeFeeRate)
+	feeAmt := quantity.Mul(feeRate)
 	if feeAmt.GT(minFeeDec) {
 		return sdk.SysCoins{sdk.NewDecCoinFromDec(symbol, feeAmt)}
 	}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// the trade volumes of an account are stored by the days since the epoch, the volumes of the days out of the
+// rolling window are pruned once the account trades again
+func getTradeVolumeDay(ctx sdk.Context) int64 {
+	return ctx.BlockTime().Unix() / types.SecondsInADay
+}
+
+// GetTradeVolume returns the trade volume of the account in the native token in the rolling window
+func (k Keeper) GetTradeVolume(ctx sdk.Context, addr sdk.AccAddress) sdk.Dec {
+	store := ctx.KVStore(k.orderStoreKey)
+	start := getTradeVolumeDay(ctx) - types.TradeVolumeWindowDays + 1
+	if start < 0 {
+		start = 0
+	}
+	iter := store.Iterator(types.GetTradeVolumeKey(addr, start), sdk.PrefixEndBytes(types.GetTradeVolumePrefix(addr)))
+	defer iter.Close()
+
+	volume := sdk.ZeroDec()
+	for ; iter.Valid(); iter.Next() {
+		var dayVolume sdk.Dec
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &dayVolume)
+		volume = volume.Add(dayVolume)
+	}
+	return volume
+}
+
+// AddTradeVolume adds the volume to the trade volume of the account in the day of the block, and prunes the volumes
+// out of the rolling window
+func (k Keeper) AddTradeVolume(ctx sdk.Context, addr sdk.AccAddress, volume sdk.Dec) {
+	if !volume.IsPositive() {
+		return
+	}
+	store := ctx.KVStore(k.orderStoreKey)
+	day := getTradeVolumeDay(ctx)
+
+	prefix := types.GetTradeVolumePrefix(addr)
+	if start := day - types.TradeVolumeWindowDays + 1; start > 0 {
+		var staleKeys [][]byte
+		iter := store.Iterator(prefix, types.GetTradeVolumeKey(addr, start))
+		for ; iter.Valid(); iter.Next() {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+		iter.Close()
+		for _, key := range staleKeys {
+			store.Delete(key)
+		}
+	}
+
+	key := types.GetTradeVolumeKey(addr, day)
+	dayVolume := sdk.ZeroDec()
+	if bz := store.Get(key); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &dayVolume)
+	}
+	store.Set(key, k.cdc.MustMarshalBinaryBare(dayVolume.Add(volume)))
+}
+
+// GetTradeVolumeInNativeToken returns the value of the fill in the native token. The value of the product quoted in
+// another token is converted by the last price of the quote token, it's zero if the quote token isn't traded in the
+// native token
+func (k Keeper) GetTradeVolumeInNativeToken(ctx sdk.Context, product string, fillPrice, fillQuantity sdk.Dec) sdk.Dec {
+	symbols := strings.Split(product, "_")
+	if len(symbols) != 2 {
+		return sdk.ZeroDec()
+	}
+	switch {
+	case symbols[1] == common.NativeToken:
+		return fillPrice.Mul(fillQuantity)
+	case symbols[0] == common.NativeToken:
+		return fillQuantity
+	default:
+		quotePrice := k.GetLastPrice(ctx, symbols[1]+"_"+common.NativeToken)
+		return fillPrice.Mul(fillQuantity).Mul(quotePrice)
+	}
+}
+
+// GetAccountFeeTier returns the fee tier of the account by its trade volume in the rolling window
+func (k Keeper) GetAccountFeeTier(ctx sdk.Context, addr sdk.AccAddress) types.AccountFeeTier {
+	params := k.GetParams(ctx)
+	volume := k.GetTradeVolume(ctx, addr)
+	tier, discount := params.FeeTiers.GetTier(volume)
+	rate := sdk.OneDec().Sub(discount)
+	return types.AccountFeeTier{
+		Address:      addr,
+		Volume:       volume,
+		Tier:         tier,
+		Discount:     discount,
+		MakerFeeRate: params.MakerFeeRate.Mul(rate),
+		TakerFeeRate: params.TakerFeeRate.Mul(rate),
+	}
+}
+
+// GetProductFeeRate returns the fee rate of the role on the product. The rates set by the owner of the product are
+// clamped into the bounds in the dex params, which may be changed by the governance after they're set
+func (k Keeper) GetProductFeeRate(ctx sdk.Context, product, role string, feeParams *types.Params) sdk.Dec {
+	tokenPair := k.dexKeeper.GetTokenPair(ctx, product)
+	if tokenPair == nil || tokenPair.FeeConfig == nil {
+		if role == types.RoleMaker {
+			return feeParams.MakerFeeRate
+		}
+		return feeParams.TakerFeeRate
+	}
+
+	feeRate := tokenPair.FeeConfig.TakerFeeRate
+	if role == types.RoleMaker {
+		feeRate = tokenPair.FeeConfig.MakerFeeRate
+	}
+	dexParams := k.dexKeeper.GetParams(ctx)
+	switch {
+	case feeRate.LT(dexParams.MinProductFeeRate):
+		return dexParams.MinProductFeeRate
+	case feeRate.GT(dexParams.MaxProductFeeRate):
+		return dexParams.MaxProductFeeRate
+	default:
+		return feeRate
+	}
+}
+
+// GetDealFeeRate returns the fee rate of the account trading in the role on the product, which is discounted by its
+// fee tier
+func (k Keeper) GetDealFeeRate(ctx sdk.Context, addr sdk.AccAddress, product, role string,
+	feeParams *types.Params) sdk.Dec {
+	feeRate := k.GetProductFeeRate(ctx, product, role, feeParams)
+	if len(feeParams.FeeTiers) == 0 {
+		return feeRate
+	}
+	_, discount := feeParams.FeeTiers.GetTier(k.GetTradeVolume(ctx, addr))
+	return feeRate.Mul(sdk.OneDec().Sub(discount))
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/dex"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func TestTradeVolume(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	addr := testInput.TestAddrs[0]
+	start := time.Unix(100*types.SecondsInADay, 0)
+	ctx := testInput.Ctx.WithBlockTime(start)
+
+	keeper.AddTradeVolume(ctx, addr, sdk.NewDec(10))
+	keeper.AddTradeVolume(ctx, addr, sdk.NewDec(5))
+	keeper.AddTradeVolume(ctx, addr, sdk.ZeroDec())
+	require.Equal(t, sdk.NewDec(15), keeper.GetTradeVolume(ctx, addr))
+	require.True(t, keeper.GetTradeVolume(ctx, testInput.TestAddrs[1]).IsZero())
+
+	ctx = ctx.WithBlockTime(start.Add(10 * 24 * time.Hour))
+	keeper.AddTradeVolume(ctx, addr, sdk.NewDec(20))
+	require.Equal(t, sdk.NewDec(35), keeper.GetTradeVolume(ctx, addr))
+
+	// the volume of the first day leaves the rolling window
+	ctx = ctx.WithBlockTime(start.Add(types.TradeVolumeWindowDays * 24 * time.Hour))
+	require.Equal(t, sdk.NewDec(20), keeper.GetTradeVolume(ctx, addr))
+	keeper.AddTradeVolume(ctx, addr, sdk.NewDec(1))
+	require.Equal(t, sdk.NewDec(21), keeper.GetTradeVolume(ctx, addr))
+
+	store := ctx.KVStore(keeper.orderStoreKey)
+	require.Nil(t, store.Get(types.GetTradeVolumeKey(addr, 100)))
+}
+
+func TestGetTradeVolumeInNativeToken(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	price, quantity := sdk.NewDec(2), sdk.NewDec(3)
+	require.Equal(t, sdk.NewDec(6),
+		keeper.GetTradeVolumeInNativeToken(ctx, "xxb_"+common.NativeToken, price, quantity))
+	require.Equal(t, sdk.NewDec(3),
+		keeper.GetTradeVolumeInNativeToken(ctx, common.NativeToken+"_usdk", price, quantity))
+	require.True(t, keeper.GetTradeVolumeInNativeToken(ctx, "xxb_usdk", price, quantity).IsZero())
+
+	keeper.SetLastPrice(ctx, "usdk_"+common.NativeToken, sdk.MustNewDecFromStr("0.5"))
+	require.Equal(t, sdk.NewDec(3), keeper.GetTradeVolumeInNativeToken(ctx, "xxb_usdk", price, quantity))
+}
+
+func TestGetAccountFeeTier(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	addr := testInput.TestAddrs[0]
+
+	params := types.DefaultTestParams()
+	params.MakerFeeRate = sdk.MustNewDecFromStr("0.001")
+	params.TakerFeeRate = sdk.MustNewDecFromStr("0.002")
+	keeper.SetParams(ctx, &params)
+
+	// no tiers, no discount
+	require.Equal(t, params.MakerFeeRate, keeper.GetDealFeeRate(ctx, addr, types.TestTokenPair, types.RoleMaker, &params))
+	require.Equal(t, params.TakerFeeRate, keeper.GetDealFeeRate(ctx, addr, types.TestTokenPair, types.RoleTaker, &params))
+
+	params.FeeTiers = types.FeeTiers{
+		{MinVolume: sdk.NewDec(100), Discount: sdk.MustNewDecFromStr("0.5")},
+	}
+	keeper.SetParams(ctx, &params)
+
+	feeTier := keeper.GetAccountFeeTier(ctx, addr)
+	require.EqualValues(t, 0, feeTier.Tier)
+	require.Equal(t, params.TakerFeeRate, feeTier.TakerFeeRate)
+
+	keeper.AddTradeVolume(ctx, addr, sdk.NewDec(100))
+	feeTier = keeper.GetAccountFeeTier(ctx, addr)
+	require.EqualValues(t, 1, feeTier.Tier)
+	require.Equal(t, sdk.NewDec(100), feeTier.Volume)
+	require.Equal(t, sdk.MustNewDecFromStr("0.0005"), feeTier.MakerFeeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.001"), feeTier.TakerFeeRate)
+	require.Equal(t, feeTier.MakerFeeRate, keeper.GetDealFeeRate(ctx, addr, types.TestTokenPair, types.RoleMaker, &params))
+	require.Equal(t, feeTier.TakerFeeRate, keeper.GetDealFeeRate(ctx, addr, types.TestTokenPair, types.RoleTaker, &params))
+}
+
+func TestGetProductFeeRate(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+	params := types.DefaultTestParams()
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	require.Equal(t, params.MakerFeeRate, keeper.GetProductFeeRate(ctx, tokenPair.Name(), types.RoleMaker, &params))
+
+	feeConfig := dex.NewProductFeeConfig(sdk.MustNewDecFromStr("0.0002"), sdk.MustNewDecFromStr("0.003"),
+		sdk.OneDec())
+	tokenPair.FeeConfig = &feeConfig
+	testInput.DexKeeper.UpdateTokenPair(ctx, tokenPair.Name(), tokenPair)
+	require.Equal(t, feeConfig.MakerFeeRate, keeper.GetProductFeeRate(ctx, tokenPair.Name(), types.RoleMaker, &params))
+	require.Equal(t, feeConfig.TakerFeeRate, keeper.GetProductFeeRate(ctx, tokenPair.Name(), types.RoleTaker, &params))
+
+	// the rates set before are clamped into the bounds changed by the governance
+	dexParams := testInput.DexKeeper.GetParams(ctx)
+	dexParams.MinProductFeeRate = sdk.MustNewDecFromStr("0.0005")
+	dexParams.MaxProductFeeRate = sdk.MustNewDecFromStr("0.002")
+	testInput.DexKeeper.SetParams(ctx, dexParams)
+	require.Equal(t, dexParams.MinProductFeeRate, keeper.GetProductFeeRate(ctx, tokenPair.Name(), types.RoleMaker, &params))
+	require.Equal(t, dexParams.MaxProductFeeRate, keeper.GetProductFeeRate(ctx, tokenPair.Name(), types.RoleTaker, &params))
+}
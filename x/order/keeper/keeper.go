@@ -419,7 +419,36 @@ func (k Keeper) AddFeeDetail(ctx sdk.Context, from sdk.AccAddress, coins sdk.Sys
 	k.tokenKeeper.AddFeeDetail(ctx, from.String(), coins, feeType, "")
 }
 
-// SendFeesToProductOwner sends fees from the specified address to productOwner
+// GetOperatorFeeShare returns the share of the deal fees of the product sent to its operator. All the fees go to the
+// operator unless the owner of the product sets the share, which is capped by the max share in the dex params
+func (k Keeper) GetOperatorFeeShare(ctx sdk.Context, product string) sdk.Dec {
+	tokenPair := k.GetDexKeeper().GetTokenPair(ctx, product)
+	if tokenPair == nil || tokenPair.FeeConfig == nil {
+		return sdk.OneDec()
+	}
+	share := tokenPair.FeeConfig.OperatorFeeShare
+	if maxShare := k.GetDexKeeper().GetParams(ctx).MaxOperatorFeeShare; share.GT(maxShare) {
+		return maxShare
+	}
+	return share
+}
+
+// splitFees splits the fees into the part of the share and the rest
+func splitFees(coins sdk.SysCoins, share sdk.Dec) (shareFees, restFees sdk.SysCoins) {
+	for _, coin := range coins {
+		amount := coin.Amount.Mul(share)
+		if amount.IsPositive() {
+			shareFees = append(shareFees, sdk.NewDecCoinFromDec(coin.Denom, amount))
+		}
+		if rest := coin.Amount.Sub(amount); rest.IsPositive() {
+			restFees = append(restFees, sdk.NewDecCoinFromDec(coin.Denom, rest))
+		}
+	}
+	return
+}
+
+// SendFeesToProductOwner sends fees from the specified address to productOwner, the part out of the operator fee share
+// of the product goes to the fee collector
 func (k Keeper) SendFeesToProductOwner(ctx sdk.Context, coins sdk.SysCoins, from sdk.AccAddress,
 	feeType string, product string) (feeReceiver string, err error) {
 	if coins.IsZero() {
@@ -429,9 +458,18 @@ func (k Keeper) SendFeesToProductOwner(ctx sdk.Context, coins sdk.SysCoins, from
 	if err != nil {
 		return "", err
 	}
-	k.tokenKeeper.AddFeeDetail(ctx, from.String(), coins, feeType, "")
-	if err := k.tokenKeeper.SendCoinsFromAccountToAccount(ctx, from, to, coins); err != nil {
-		log.Printf("Send fee(%s) to address(%s) failed\n", coins.String(), to.String())
+
+	operatorFees, restFees := splitFees(coins, k.GetOperatorFeeShare(ctx, product))
+	if err := k.AddCollectedFees(ctx, restFees, from, feeType, true); err != nil {
+		log.Printf("Send fee(%s) to fee collector failed\n", restFees.String())
+		return "", err
+	}
+	if operatorFees.IsZero() {
+		return "", nil
+	}
+	k.tokenKeeper.AddFeeDetail(ctx, from.String(), operatorFees, feeType, "")
+	if err := k.tokenKeeper.SendCoinsFromAccountToAccount(ctx, from, to, operatorFees); err != nil {
+		log.Printf("Send fee(%s) to address(%s) failed\n", operatorFees.String(), to.String())
 		return "", err
 	}
 	return to.String(), nil
@@ -462,6 +500,42 @@ func (k Keeper) SetParams(ctx sdk.Context, params *types.Params) {
 	k.paramSpace.SetParamSet(ctx, params)
 }
 
+// legacyKeyTradeFeeRate is the key of the single trade fee rate replaced by the maker and the taker fee rates
+var legacyKeyTradeFeeRate = []byte("TradeFeeRate")
+
+// MigrateParams completes the params stored by an older software, whose missing keys make GetParams panic. The params
+// not stored yet take the default values, except that the maker and the taker fee rates take the former trade fee rate
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	if !k.paramSpace.Has(ctx, types.KeyMakerFeeRate) && !k.paramSpace.Has(ctx, types.KeyTakerFeeRate) {
+		var tradeFeeRate sdk.Dec
+		k.paramSpace.GetIfExists(ctx, legacyKeyTradeFeeRate, &tradeFeeRate)
+		if !tradeFeeRate.IsNil() {
+			params.MakerFeeRate, params.TakerFeeRate = tradeFeeRate, tradeFeeRate
+		}
+	}
+	k.SetParams(ctx, &params)
+}
+
+// MigrateAccountOrderIndex builds the open order index of the accounts from the open orders stored by an older
+// software, which didn't maintain the index
+func (k Keeper) MigrateAccountOrderIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.OrderKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var order types.Order
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &order)
+		if order.Status == types.OrderStatusOpen {
+			store.Set(types.GetAccountOrderKey(order.Sender, order.OrderID), []byte{})
+		}
+	}
+}
+
 // nolint
 func (k Keeper) GetMetric() *monitor.OrderMetric {
 	return k.metric
@@ -500,7 +574,8 @@ func (k Keeper) GetBestBidAndAsk(ctx sdk.Context, product string) (sdk.Dec, sdk.
 // RemoveOrderFromDepthBook removes order from depthBook, and updates cancelNum, expireNum, updatedOrderIDs from cache
 func (k Keeper) RemoveOrderFromDepthBook(order *types.Order, feeType string) {
 	k.addUpdatedOrderID(order.OrderID)
-	if feeType == types.FeeTypeOrderCancel {
+	if feeType == types.FeeTypeOrderCancel || feeType == types.FeeTypeOrderSTP ||
+		feeType == types.FeeTypeOrderTradingRule {
 		k.cache.IncreaseCancelNum()
 	} else if feeType == types.FeeTypeOrderExpire {
 		k.cache.IncreaseExpireNum()
@@ -5,7 +5,9 @@ import (
 
 	"github.com/okex/okexchain/x/common"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/stretchr/testify/require"
 
 	"github.com/okex/okexchain/x/dex"
@@ -338,6 +340,34 @@ func TestKeeper_SendFeesToProductOwner(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestKeeper_SendFeesToProductOwnerWithFeeShare(t *testing.T) {
+	testInput := CreateTestInputWithBalance(t, 1, 100)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	feeConfig := dex.NewProductFeeConfig(sdk.ZeroDec(), sdk.ZeroDec(), sdk.MustNewDecFromStr("0.6"))
+	tokenPair.FeeConfig = &feeConfig
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), keeper.GetOperatorFeeShare(ctx, tokenPair.Name()))
+
+	dealFee := sdk.SysCoins{{Denom: common.NativeToken, Amount: sdk.OneDec()}}
+	feeReceiver, err := keeper.SendFeesToProductOwner(ctx, dealFee, testInput.TestAddrs[0], types.FeeTypeOrderDeal,
+		tokenPair.Name())
+	require.Nil(t, err)
+	require.Equal(t, tokenPair.Owner.String(), feeReceiver)
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), keeper.GetCoins(ctx, tokenPair.Owner).AmountOf(common.NativeToken))
+	feeCollector := testInput.SupplyKeeper.GetModuleAccount(ctx, auth.FeeCollectorName)
+	require.Equal(t, sdk.MustNewDecFromStr("0.4"), feeCollector.GetCoins().AmountOf(common.NativeToken))
+
+	// the share is capped by the governance
+	dexParams := testInput.DexKeeper.GetParams(ctx)
+	dexParams.MaxOperatorFeeShare = sdk.MustNewDecFromStr("0.5")
+	testInput.DexKeeper.SetParams(ctx, dexParams)
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), keeper.GetOperatorFeeShare(ctx, tokenPair.Name()))
+}
+
 func TestKeeper_GetBestBidAndAsk(t *testing.T) {
 	testInput := CreateTestInputWithBalance(t, 1, 100)
 	keeper := testInput.OrderKeeper
@@ -380,3 +410,36 @@ func TestFilterDelistedProducts(t *testing.T) {
 	cleanProducts := keeper.FilterDelistedProducts(ctx, productsList)
 	require.EqualValues(t, expectedProductsList, cleanProducts)
 }
+
+func TestMigrateParams(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	// the params stored by the software before the maker and the taker fees, the self-trade prevention and the
+	// price protection
+	store := prefix.NewStore(ctx.KVStore(testInput.ParamsKey), []byte(types.DefaultParamspace+"/"))
+	for _, key := range [][]byte{types.KeyMakerFeeRate, types.KeyTakerFeeRate, types.KeyFeeTiers, types.KeySTPMode,
+		types.KeyPriceBandRate, types.KeyCircuitBreakerRate, types.KeyCircuitBreakerWindow, types.KeyCircuitBreakerHalt,
+		types.KeyProductProtections} {
+		store.Delete(key)
+	}
+	store.Set(legacyKeyTradeFeeRate, testInput.Cdc.MustMarshalJSON(sdk.MustNewDecFromStr("0.002")))
+	require.Panics(t, func() { keeper.GetParams(ctx) })
+
+	keeper.MigrateParams(ctx)
+	params := keeper.GetParams(ctx)
+	require.Equal(t, sdk.MustNewDecFromStr("0.002"), params.MakerFeeRate)
+	require.Equal(t, sdk.MustNewDecFromStr("0.002"), params.TakerFeeRate)
+	require.Empty(t, params.FeeTiers)
+	require.Equal(t, types.DefaultSTPMode, params.STPMode)
+	require.Equal(t, types.DefaultParams().GetPriceProtection(types.TestTokenPair),
+		params.GetPriceProtection(types.TestTokenPair))
+	require.Equal(t, types.DefaultTestParams().OrderExpireBlocks, params.OrderExpireBlocks)
+
+	// the params already migrated are kept
+	params.MakerFeeRate = sdk.MustNewDecFromStr("0.001")
+	keeper.SetParams(ctx, params)
+	keeper.MigrateParams(ctx)
+	require.Equal(t, params, keeper.GetParams(ctx))
+}
@@ -11,6 +11,7 @@ type Cache struct {
 	updatedOrderIDs  []string
 	blockMatchResult *types.BlockMatchResult
 	handlerTxMsgResult []bitset.BitSet
+	productHalts       []types.ProductHalt
 
 	// for statistic
 	cancelNum      int64 // canceled orders num in this block
@@ -32,6 +33,7 @@ func (c *Cache) reset() {
 	c.updatedOrderIDs = []string{}
 	c.blockMatchResult = &types.BlockMatchResult{}
 	c.handlerTxMsgResult = []bitset.BitSet{}
+	c.productHalts = nil
 
 	c.cancelNum = 0
 	c.expireNum = 0
@@ -51,6 +53,10 @@ func (c *Cache) addTxHandlerMsgResult(resultSet bitset.BitSet) {
 	c.handlerTxMsgResult = append(c.handlerTxMsgResult, resultSet)
 }
 
+func (c *Cache) addProductHalt(halt types.ProductHalt) {
+	c.productHalts = append(c.productHalts, halt)
+}
+
 // nolint
 func (c *Cache) IncreaseExpireNum() int64 {
 	c.expireNum++
@@ -99,6 +105,10 @@ func (c *Cache) getBlockMatchResult() *types.BlockMatchResult {
 	return c.blockMatchResult
 }
 
+func (c *Cache) getProductHalts() []types.ProductHalt {
+	return c.productHalts
+}
+
 func (c *Cache) getUpdatedOrderIDs() []string {
 	return c.updatedOrderIDs
 }
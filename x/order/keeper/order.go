@@ -64,13 +64,26 @@ func (k Keeper) CancelOrder(ctx sdk.Context, order *types.Order, logger log.Logg
 	return k.quitOrder(ctx, order, types.FeeTypeOrderCancel, logger)
 }
 
+// PreventSelfTrade quits the specified order with the self-trade prevented state
+func (k Keeper) PreventSelfTrade(ctx sdk.Context, order *types.Order, logger log.Logger) sdk.SysCoins {
+	return k.quitOrder(ctx, order, types.FeeTypeOrderSTP, logger)
+}
+
+// CancelNonconformingOrder quits the specified order with the canceled state because it doesn't conform to the
+// updated trading rule of its product, the fee locked is refunded in full
+func (k Keeper) CancelNonconformingOrder(ctx sdk.Context, order *types.Order, logger log.Logger) {
+	k.quitOrder(ctx, order, types.FeeTypeOrderTradingRule, logger)
+}
+
 // quitOrder unlocks & charges fee, unlocks coins, updates order, and updates DepthBook
 func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, logger log.Logger) (fee sdk.SysCoins) {
 	switch feeType {
-	case types.FeeTypeOrderCancel:
+	case types.FeeTypeOrderCancel, types.FeeTypeOrderTradingRule:
 		order.Cancel()
 	case types.FeeTypeOrderExpire:
 		order.Expire()
+	case types.FeeTypeOrderSTP:
+		order.PreventSelfTrade()
 	default:
 		return
 	}
@@ -81,6 +94,9 @@ func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, l
 
 	lockedFee := GetOrderNewFee(order)
 	fee = GetOrderCostFee(order, ctx)
+	if feeType == types.FeeTypeOrderTradingRule {
+		fee = GetZeroFee()
+	}
 	receiveFee := lockedFee.Sub(fee)
 
 	k.UnlockCoins(ctx, order.Sender, lockedFee, token.LockCoinsTypeFee)
@@ -101,6 +117,81 @@ func (k Keeper) quitOrder(ctx sdk.Context, order *types.Order, feeType string, l
 	return fee
 }
 
+// GetAccountOpenOrders returns at most limit open orders of the account from its open order index, the product and
+// the side filter the orders if they aren't empty
+func (k Keeper) GetAccountOpenOrders(ctx sdk.Context, addr sdk.AccAddress, product, side string,
+	limit int) []*types.Order {
+	var orders []*types.Order
+	for _, orderID := range k.getAccountOrderIDs(ctx, addr) {
+		order := k.GetOrder(ctx, orderID)
+		if order == nil || order.Status != types.OrderStatusOpen ||
+			(product != "" && order.Product != product) || (side != "" && order.Side != side) {
+			continue
+		}
+		orders = append(orders, order)
+		if len(orders) >= limit {
+			break
+		}
+	}
+	return orders
+}
+
+// TryAmendOrder tries to lock or unlock the coins of the sender for the change of the remaining quantity when the
+// order is amended to the price and the quantity
+func (k Keeper) TryAmendOrder(ctx sdk.Context, order *types.Order, price, quantity sdk.Dec) error {
+	remainQuantity := quantity.Sub(order.Quantity.Sub(order.RemainQuantity))
+	if !remainQuantity.IsPositive() {
+		return fmt.Errorf("quantity(%s) should be greater than the filled quantity(%s)",
+			quantity, order.Quantity.Sub(order.RemainQuantity))
+	}
+	remainLocked := remainQuantity
+	if order.Side == types.BuyOrder {
+		remainLocked = price.Mul(remainQuantity)
+	}
+
+	denom := order.NeedUnlockCoins()[0].Denom
+	if remainLocked.GT(order.RemainLocked) {
+		coins := sdk.SysCoins{sdk.NewDecCoinFromDec(denom, remainLocked.Sub(order.RemainLocked))}
+		return k.LockCoins(ctx, order.Sender, coins, token.LockCoinsTypeQuantity)
+	}
+	if remainLocked.LT(order.RemainLocked) {
+		coins := sdk.SysCoins{sdk.NewDecCoinFromDec(denom, order.RemainLocked.Sub(remainLocked))}
+		k.UnlockCoins(ctx, order.Sender, coins, token.LockCoinsTypeQuantity)
+	}
+	return nil
+}
+
+// AmendOrder changes the price and the quantity of the open order, executes TryAmendOrder, and updates DepthBook.
+// The order keeps its place in the queue if its price is unchanged and its quantity isn't increased, or it's moved to
+// the back of the queue at the price
+func (k Keeper) AmendOrder(ctx sdk.Context, order *types.Order, price, quantity sdk.Dec) error {
+	if err := k.TryAmendOrder(ctx, order, price, quantity); err != nil {
+		return err
+	}
+
+	oldOrder := *order
+	remainQuantity := quantity.Sub(order.Quantity.Sub(order.RemainQuantity))
+	order.Price = price
+	order.Quantity = quantity
+	order.RemainQuantity = remainQuantity
+	if order.Side == types.BuyOrder {
+		order.RemainLocked = price.Mul(remainQuantity)
+	} else {
+		order.RemainLocked = remainQuantity
+	}
+	// the order moved to the back of the queue takes the liquidity in the block like a new one
+	keepPlace := price.Equal(oldOrder.Price) && quantity.LTE(oldOrder.Quantity)
+	if !keepPlace {
+		order.AmendHeight = ctx.BlockHeight()
+	}
+	k.SetOrder(ctx, order.OrderID, order)
+
+	// update depth book and orderIDsMap in cache
+	k.addUpdatedOrderID(order.OrderID)
+	k.diskCache.amendOrder(&oldOrder, order, keepPlace)
+	return nil
+}
+
 func (k Keeper) DropExpiredOrdersByBlockHeight(ctx sdk.Context, expiredBlockHeight int64) {
 	logger := ctx.Logger().With("module", "order")
 	store := ctx.KVStore(k.orderStoreKey)
@@ -206,3 +206,43 @@ func TestPlaceOrderAndExpireOrder(t *testing.T) {
 	require.EqualValues(t, 0, keeper.diskCache.openNum)
 	require.EqualValues(t, 1, keeper.cache.expireNum)
 }
+
+func TestAccountOrderIndex(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "11.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "9.0", "1.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[0]
+	orders[1].Sender = testInput.TestAddrs[0]
+	orders[2].Sender = testInput.TestAddrs[1]
+	for _, order := range orders {
+		require.Nil(t, keeper.PlaceOrder(ctx, order))
+	}
+	require.Len(t, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[0], "", "", 10), 2)
+	require.Len(t, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[0], "", types.SellOrder, 10), 1)
+	require.Len(t, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[0], "btc-000_okt", "", 10), 0)
+	require.Len(t, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[1], "", "", 10), 1)
+
+	// the order quitted or filled leaves the index
+	keeper.CancelOrder(ctx, orders[0], ctx.Logger())
+	orders[1].Fill(orders[1].Price, orders[1].RemainQuantity)
+	keeper.UpdateOrder(orders[1], ctx)
+	require.Empty(t, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[0], "", "", 10))
+
+	// the index of the open orders stored by the software before it is built by the migration
+	store := ctx.KVStore(keeper.orderStoreKey)
+	store.Delete(types.GetAccountOrderKey(orders[2].Sender, orders[2].OrderID))
+	require.Empty(t, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[1], "", "", 10))
+	keeper.MigrateAccountOrderIndex(ctx)
+	require.Equal(t, []*types.Order{orders[2]}, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[1], "", "", 10))
+	require.Empty(t, keeper.GetAccountOpenOrders(ctx, testInput.TestAddrs[0], "", "", 10))
+}
@@ -31,6 +31,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 
 		case types.QueryDepthBookV2:
 			return queryDepthBookV2(ctx, path[1:], req, keeper)
+		case types.QueryFeeTier:
+			return queryFeeTier(ctx, path[1:], keeper)
+		case types.QueryGrants:
+			return queryGrants(ctx, path[1:], keeper)
+		case types.QueryHalts:
+			return queryHalts(ctx, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown order query endpoint")
 		}
@@ -222,3 +228,45 @@ func queryDepthBookV2(ctx sdk.Context, path []string, req abci.RequestQuery, kee
 	}
 	return res, nil
 }
+
+// queryFeeTier returns the fee tier of the account by its trade volume
+func queryFeeTier(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("address is required")
+	}
+	addr, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("invalid address: %s", path[0]))
+	}
+	bz := keeper.cdc.MustMarshalJSON(keeper.GetAccountFeeTier(ctx, addr))
+	return bz, nil
+}
+
+// queryGrants returns the trading grants given by the account
+func queryGrants(ctx sdk.Context, path []string, keeper Keeper) ([]byte, sdk.Error) {
+	if len(path) == 0 {
+		return nil, sdk.ErrUnknownRequest("granter address is required")
+	}
+	granter, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("invalid address: %s", path[0]))
+	}
+	grants := keeper.GetTradingGrants(ctx, granter)
+	if grants == nil {
+		grants = types.TradingGrants{}
+	}
+	bz := keeper.cdc.MustMarshalJSON(grants)
+	return bz, nil
+}
+
+// queryHalts returns the circuit breaker halts of the products whose matching is still paused
+func queryHalts(ctx sdk.Context, keeper Keeper) ([]byte, sdk.Error) {
+	halts := []types.ProductHalt{}
+	for _, halt := range keeper.GetProductHalts(ctx) {
+		if halt.IsActive(ctx.BlockHeight()) {
+			halts = append(halts, halt)
+		}
+	}
+	bz := keeper.cdc.MustMarshalJSON(halts)
+	return bz, nil
+}
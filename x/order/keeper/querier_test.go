@@ -228,9 +228,16 @@ func TestQueryParameters(t *testing.T) {
 		OrderExpireBlocks:     1000,
 		MaxDealsPerBlock:      10000,
 		FeePerBlock:           sdk.NewDecCoinFromDec(types.DefaultFeeDenomPerBlock, sdk.NewDec(1)),
-		TradeFeeRate:          sdk.MustNewDecFromStr("0.001"),
+		MakerFeeRate:          sdk.MustNewDecFromStr("0.001"),
+		TakerFeeRate:          sdk.MustNewDecFromStr("0.001"),
 		NewOrderMsgGasUnit:    1,
 		CancelOrderMsgGasUnit: 1,
+		STPMode:               types.STPModeCancelNewest,
+
+		PriceBandRate:            sdk.ZeroDec(),
+		CircuitBreakerRate:       sdk.ZeroDec(),
+		CircuitBreakerWindow:     types.DefaultCircuitBreakerWindow,
+		CircuitBreakerHaltBlocks: types.DefaultCircuitBreakerHaltBlocks,
 	}
 	keeper.SetParams(ctx, params)
 	path := []string{types.QueryParameters}
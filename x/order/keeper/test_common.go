@@ -41,6 +41,7 @@ type TestInput struct {
 	AccountKeeper auth.AccountKeeper
 	SupplyKeeper  supply.Keeper
 	DexKeeper     dex.Keeper
+	ParamsKey     sdk.StoreKey
 }
 
 // MakeTestCodec creates a codec used only for testing
@@ -126,6 +127,7 @@ func CreateTestInputWithBalance(t *testing.T, numAddrs, initQuantity int64) Test
 	// dex keeper
 	paramsSubspace := paramsKeeper.Subspace(dex.DefaultParamspace)
 	dexKeeper := dex.NewKeeper(auth.FeeCollectorName, supplyKeeper, paramsSubspace, tokenKeepr, nil, bankKeeper, storeKey, keyTokenPair, cdc)
+	dexKeeper.SetParams(ctx, *dex.DefaultParams())
 
 	// order keeper
 	orderKeeper := NewKeeper(tokenKeepr, supplyKeeper, dexKeeper,
@@ -154,7 +156,7 @@ func CreateTestInputWithBalance(t *testing.T, numAddrs, initQuantity int64) Test
 		require.Nil(t, err)
 	}
 
-	return TestInput{ctx, cdc, testAddrs, orderKeeper, tokenKeepr, accountKeeper, supplyKeeper, dexKeeper}
+	return TestInput{ctx, cdc, testAddrs, orderKeeper, tokenKeepr, accountKeeper, supplyKeeper, dexKeeper, keyParams}
 }
 
 // CreateTestInput creates TestInput with default params
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// SetTradingGrant saves the trading grant
+func (k Keeper) SetTradingGrant(ctx sdk.Context, grant types.TradingGrant) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Set(types.GetTradingGrantKey(grant.Granter, grant.Grantee), k.cdc.MustMarshalBinaryBare(grant))
+}
+
+// GetTradingGrant returns the trading grant from the granter to the grantee
+func (k Keeper) GetTradingGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant types.TradingGrant, found bool) {
+	store := ctx.KVStore(k.orderStoreKey)
+	bz := store.Get(types.GetTradingGrantKey(granter, grantee))
+	if bz == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+	return grant, true
+}
+
+// DeleteTradingGrant deletes the trading grant from the granter to the grantee
+func (k Keeper) DeleteTradingGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) {
+	store := ctx.KVStore(k.orderStoreKey)
+	store.Delete(types.GetTradingGrantKey(granter, grantee))
+}
+
+// GetTradingGrants returns the trading grants of the granter
+func (k Keeper) GetTradingGrants(ctx sdk.Context, granter sdk.AccAddress) (grants types.TradingGrants) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetTradingGrantPrefix(granter))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.TradingGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		grants = append(grants, grant)
+	}
+	return grants
+}
+
+// IterateTradingGrants iterates all the trading grants
+func (k Keeper) IterateTradingGrants(ctx sdk.Context, cb func(grant types.TradingGrant) (stop bool)) {
+	store := ctx.KVStore(k.orderStoreKey)
+	iter := sdk.KVStorePrefixIterator(store, types.TradingGrantKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.TradingGrant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+		if cb(grant) {
+			break
+		}
+	}
+}
+
+// GetValidTradingGrant returns the trading grant from the granter to the grantee, or an error if it doesn't exist or
+// it's expired
+func (k Keeper) GetValidTradingGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (types.TradingGrant, error) {
+	grant, found := k.GetTradingGrant(ctx, granter, grantee)
+	if !found {
+		return grant, sdk.ErrUnauthorized(fmt.Sprintf("%s has no trading grant from %s", grantee, granter))
+	}
+	if grant.IsExpired(ctx.BlockTime()) {
+		return grant, sdk.ErrUnauthorized(fmt.Sprintf("the trading grant from %s to %s expired at %s",
+			granter, grantee, grant.Expiration))
+	}
+	return grant, nil
+}
+
+// SpendTradingGrant checks the order placed by the grantee against the grant and spends its value out of the max
+// notional of the grant if it's set
+func (k Keeper) SpendTradingGrant(ctx sdk.Context, grantee sdk.AccAddress, order *types.Order) error {
+	grant, err := k.GetValidTradingGrant(ctx, order.Sender, grantee)
+	if err != nil {
+		return err
+	}
+	if !grant.AllowProduct(order.Product) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the trading grant doesn't allow product %s", order.Product))
+	}
+	// the orders aren't valued if the grant has no max notional
+	if !grant.HasMaxNotional() {
+		return nil
+	}
+
+	notional := k.GetTradeVolumeInNativeToken(ctx, order.Product, order.Price, order.Quantity)
+	if !notional.IsPositive() {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the order of product %s can't be valued in %s",
+			order.Product, common.NativeToken))
+	}
+	return k.spendTradingGrant(ctx, grant, notional)
+}
+
+// SpendTradingGrantOnAmend checks the order amended by the grantee to the price and the quantity against the grant,
+// and spends the increase of its remaining value out of the max notional of the grant if it's set
+func (k Keeper) SpendTradingGrantOnAmend(ctx sdk.Context, grantee sdk.AccAddress, order *types.Order,
+	price, quantity sdk.Dec) error {
+	if err := k.CheckCancelGrant(ctx, grantee, order); err != nil {
+		return err
+	}
+	grant, _ := k.GetTradingGrant(ctx, order.Sender, grantee)
+	if !grant.HasMaxNotional() {
+		return nil
+	}
+
+	remainQuantity := quantity.Sub(order.Quantity.Sub(order.RemainQuantity))
+	notional := k.GetTradeVolumeInNativeToken(ctx, order.Product, price, remainQuantity)
+	if !notional.IsPositive() {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the order of product %s can't be valued in %s",
+			order.Product, common.NativeToken))
+	}
+	increase := notional.Sub(k.GetTradeVolumeInNativeToken(ctx, order.Product, order.Price, order.RemainQuantity))
+	if !increase.IsPositive() {
+		return nil
+	}
+	return k.spendTradingGrant(ctx, grant, increase)
+}
+
+func (k Keeper) spendTradingGrant(ctx sdk.Context, grant types.TradingGrant, notional sdk.Dec) error {
+	if notional.GT(grant.RemainingNotional()) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the order value %s exceeds the remaining notional %s of the trading grant",
+			notional, grant.RemainingNotional()))
+	}
+	grant.SpentNotional = grant.SpentNotional.Add(notional)
+	k.SetTradingGrant(ctx, grant)
+	return nil
+}
+
+// CheckCancelGrant checks the order canceled by the grantee against the grant
+func (k Keeper) CheckCancelGrant(ctx sdk.Context, grantee sdk.AccAddress, order *types.Order) error {
+	grant, err := k.GetValidTradingGrant(ctx, order.Sender, grantee)
+	if err != nil {
+		return err
+	}
+	if !grant.AllowProduct(order.Product) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the trading grant doesn't allow product %s", order.Product))
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func TestTradingGrant(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	blockTime := time.Unix(1000, 0)
+	ctx := testInput.Ctx.WithBlockTime(blockTime)
+	granter, grantee := testInput.TestAddrs[0], testInput.TestAddrs[1]
+	product := "xxb_" + common.NativeToken
+
+	_, err := keeper.GetValidTradingGrant(ctx, granter, grantee)
+	require.NotNil(t, err)
+
+	grant := types.NewTradingGrant(granter, grantee, []string{product}, blockTime.Add(time.Hour), sdk.NewDec(10))
+	keeper.SetTradingGrant(ctx, grant)
+	require.Equal(t, 1, len(keeper.GetTradingGrants(ctx, granter)))
+	require.Equal(t, 0, len(keeper.GetTradingGrants(ctx, grantee)))
+
+	order := types.MockOrder("", product, types.BuyOrder, "2", "3")
+	order.Sender = granter
+	require.Nil(t, keeper.SpendTradingGrant(ctx, grantee, order))
+	grant, found := keeper.GetTradingGrant(ctx, granter, grantee)
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(6), grant.SpentNotional)
+	require.Equal(t, sdk.NewDec(4), grant.RemainingNotional())
+
+	// over the remaining notional
+	require.NotNil(t, keeper.SpendTradingGrant(ctx, grantee, order))
+
+	// product not allowed
+	otherOrder := types.MockOrder("", "yyb_"+common.NativeToken, types.BuyOrder, "1", "1")
+	otherOrder.Sender = granter
+	require.NotNil(t, keeper.SpendTradingGrant(ctx, grantee, otherOrder))
+	require.NotNil(t, keeper.CheckCancelGrant(ctx, grantee, otherOrder))
+	require.Nil(t, keeper.CheckCancelGrant(ctx, grantee, order))
+
+	// expired
+	expiredCtx := ctx.WithBlockTime(blockTime.Add(time.Hour))
+	require.NotNil(t, keeper.CheckCancelGrant(expiredCtx, grantee, order))
+
+	keeper.DeleteTradingGrant(ctx, granter, grantee)
+	_, found = keeper.GetTradingGrant(ctx, granter, grantee)
+	require.False(t, found)
+}
+
+func TestTradingGrantWithoutMaxNotional(t *testing.T) {
+	testInput := CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	blockTime := time.Unix(1000, 0)
+	ctx := testInput.Ctx.WithBlockTime(blockTime)
+	granter, grantee := testInput.TestAddrs[0], testInput.TestAddrs[1]
+	// the product quoted in a token without a price in the native token can't be valued
+	product := "xxb_yyb"
+	require.True(t, keeper.GetTradeVolumeInNativeToken(ctx, product, sdk.NewDec(2), sdk.NewDec(3)).IsZero())
+
+	grant := types.NewTradingGrant(granter, grantee, nil, blockTime.Add(time.Hour), sdk.Dec{})
+	require.False(t, grant.HasMaxNotional())
+	keeper.SetTradingGrant(ctx, grant)
+
+	order := types.MockOrder("", product, types.BuyOrder, "2", "3")
+	order.Sender = granter
+	require.Nil(t, keeper.SpendTradingGrant(ctx, grantee, order))
+	require.Nil(t, keeper.SpendTradingGrantOnAmend(ctx, grantee, order, sdk.NewDec(2), sdk.NewDec(30)))
+	grant, found := keeper.GetTradingGrant(ctx, granter, grantee)
+	require.True(t, found)
+	require.True(t, grant.SpentNotional.IsZero())
+
+	// the grant with the max notional still rejects the orders that can't be valued
+	keeper.SetTradingGrant(ctx, types.NewTradingGrant(granter, grantee, nil, blockTime.Add(time.Hour), sdk.NewDec(10)))
+	require.NotNil(t, keeper.SpendTradingGrant(ctx, grantee, order))
+}
@@ -0,0 +1,79 @@
+package periodicauction
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// resumeHaltedProducts resumes the matching of the products whose circuit breaker halt ends at this block, and
+// returns them to be matched again
+func resumeHaltedProducts(ctx sdk.Context, k keeper.Keeper) (products []string) {
+	logger := ctx.Logger().With("module", "order")
+	for _, halt := range k.GetProductHalts(ctx) {
+		if halt.IsActive(ctx.BlockHeight()) {
+			continue
+		}
+		k.DeleteProductHalt(ctx, halt.Product)
+		products = append(products, halt.Product)
+		logger.Info(fmt.Sprintf("BlockHeight<%d> resume the matching of product(%s)", ctx.BlockHeight(),
+			halt.Product))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCircuitBreakerResume,
+			sdk.NewAttribute(types.AttributeKeyProduct, halt.Product),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(halt.BlockHeight, 10)),
+		))
+	}
+	return products
+}
+
+// tripCircuitBreaker checks the clearing price against the clearing prices of the product in the recent blocks. It
+// pauses the matching of the product and returns true if the price moves too much, otherwise the price is recorded
+func tripCircuitBreaker(ctx sdk.Context, k keeper.Keeper, product string, price sdk.Dec, params *types.Params) bool {
+	protection := params.GetPriceProtection(product)
+	if !protection.CircuitBreakerRate.IsPositive() {
+		return false
+	}
+
+	blockHeight := ctx.BlockHeight()
+	var window []types.PricePoint
+	for _, point := range k.GetPriceWindow(ctx, product) {
+		if point.BlockHeight > blockHeight-protection.CircuitBreakerWindow {
+			window = append(window, point)
+		}
+	}
+
+	for _, point := range window {
+		if !point.Price.IsPositive() ||
+			types.PriceDeviation(price, point.Price).LTE(protection.CircuitBreakerRate) {
+			continue
+		}
+
+		halt := types.NewProductHalt(product, blockHeight, protection.CircuitBreakerHaltBlocks, price,
+			point.Price)
+		k.SetProductHalt(ctx, halt)
+		// the prices before the halt are dropped, so the matching won't be paused again right after it's resumed
+		k.SetPriceWindow(ctx, product, nil)
+		ctx.Logger().With("module", "order").Info(fmt.Sprintf("BlockHeight<%d> pause the matching of %s",
+			blockHeight, halt))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCircuitBreakerHalt,
+			sdk.NewAttribute(types.AttributeKeyProduct, product),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			sdk.NewAttribute(types.AttributeKeyRefPrice, point.Price.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockHeight, strconv.FormatInt(blockHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(halt.EndHeight, 10)),
+		))
+		return true
+	}
+
+	window = append(window, types.PricePoint{BlockHeight: blockHeight, Price: price})
+	k.SetPriceWindow(ctx, product, window)
+	return false
+}
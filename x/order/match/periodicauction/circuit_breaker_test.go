@@ -0,0 +1,124 @@
+package periodicauction
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/dex"
+	orderkeeper "github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func TestTripCircuitBreaker(t *testing.T) {
+	common.InitConfig()
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	params := types.DefaultTestParams()
+
+	// disabled
+	require.False(t, tripCircuitBreaker(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"), &params))
+	require.Nil(t, keeper.GetPriceWindow(ctx, types.TestTokenPair))
+
+	params.CircuitBreakerRate = sdk.MustNewDecFromStr("0.1")
+	params.CircuitBreakerWindow = 5
+	params.CircuitBreakerHaltBlocks = 3
+	require.False(t, tripCircuitBreaker(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"), &params))
+	ctx = ctx.WithBlockHeight(12)
+	require.False(t, tripCircuitBreaker(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("10.9"), &params))
+	require.Equal(t, 2, len(keeper.GetPriceWindow(ctx, types.TestTokenPair)))
+
+	// the price at height 10 is out of the window
+	ctx = ctx.WithBlockHeight(15)
+	require.False(t, tripCircuitBreaker(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("11.5"), &params))
+	require.Equal(t, 2, len(keeper.GetPriceWindow(ctx, types.TestTokenPair)))
+
+	// 12.1 moves more than 10% from 10.9
+	ctx = ctx.WithBlockHeight(16).WithEventManager(sdk.NewEventManager())
+	require.True(t, tripCircuitBreaker(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("12.1"), &params))
+	halt := keeper.GetProductHalt(ctx, types.TestTokenPair)
+	require.NotNil(t, halt)
+	require.EqualValues(t, 19, halt.EndHeight)
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.9"), halt.RefPrice)
+	require.Nil(t, keeper.GetPriceWindow(ctx, types.TestTokenPair))
+	require.Equal(t, types.EventTypeCircuitBreakerHalt, ctx.EventManager().Events()[0].Type)
+
+	// resumed at the end height
+	ctx = ctx.WithBlockHeight(18)
+	require.Empty(t, resumeHaltedProducts(ctx, keeper))
+	ctx = ctx.WithBlockHeight(19).WithEventManager(sdk.NewEventManager())
+	require.EqualValues(t, []string{types.TestTokenPair}, resumeHaltedProducts(ctx, keeper))
+	require.Nil(t, keeper.GetProductHalt(ctx, types.TestTokenPair))
+	require.Equal(t, types.EventTypeCircuitBreakerResume, ctx.EventManager().Events()[0].Type)
+}
+
+func TestTripCircuitBreakerByProduct(t *testing.T) {
+	common.InitConfig()
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+	params := types.DefaultTestParams()
+	params.CircuitBreakerRate = sdk.MustNewDecFromStr("0.1")
+
+	// the circuit breaker is disabled for the product
+	protection := params.GetPriceProtection(types.TestTokenPair)
+	protection.CircuitBreakerRate = sdk.ZeroDec()
+	params.ProductPriceProtections = types.ProductPriceProtections{{Product: types.TestTokenPair,
+		PriceProtection: protection}}
+	require.False(t, tripCircuitBreaker(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"), &params))
+	ctx = ctx.WithBlockHeight(11)
+	require.False(t, tripCircuitBreaker(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("20.0"), &params))
+	require.Nil(t, keeper.GetProductHalt(ctx, types.TestTokenPair))
+
+	// while the other products take the params of the module
+	product := "btc-000_" + common.NativeToken
+	require.False(t, tripCircuitBreaker(ctx, keeper, product, sdk.MustNewDecFromStr("10.0"), &params))
+	ctx = ctx.WithBlockHeight(12)
+	require.True(t, tripCircuitBreaker(ctx, keeper, product, sdk.MustNewDecFromStr("20.0"), &params))
+	require.NotNil(t, keeper.GetProductHalt(ctx, product))
+}
+
+func TestCalcMatchPriceAndExecutionWithCircuitBreaker(t *testing.T) {
+	common.InitConfig()
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.CircuitBreakerRate = sdk.MustNewDecFromStr("0.1")
+	keeper.SetParams(ctx, params)
+	keeper.SetPriceWindow(ctx, types.TestTokenPair,
+		[]types.PricePoint{{BlockHeight: 9, Price: sdk.MustNewDecFromStr("8.0")}})
+
+	keeper.ResetCache(ctx)
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "1.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[0]
+	orders[1].Sender = testInput.TestAddrs[1]
+	for _, order := range orders {
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	// the clearing price 10.0 moves more than 10% from 8.0, the matching is paused
+	resultMap := calcMatchPriceAndExecution(ctx, keeper, []string{types.TestTokenPair})
+	require.Empty(t, resultMap)
+	require.NotNil(t, keeper.GetProductHalt(ctx, types.TestTokenPair))
+
+	resultMap = calcMatchPriceAndExecution(ctx, keeper, []string{types.TestTokenPair})
+	require.Empty(t, resultMap)
+
+	// matched again after the matching is resumed
+	ctx = ctx.WithBlockHeight(10 + params.CircuitBreakerHaltBlocks)
+	require.EqualValues(t, []string{types.TestTokenPair}, resumeHaltedProducts(ctx, keeper))
+	resultMap = calcMatchPriceAndExecution(ctx, keeper, []string{types.TestTokenPair})
+	require.EqualValues(t, sdk.MustNewDecFromStr("10.0"), resultMap[types.TestTokenPair].Price)
+}
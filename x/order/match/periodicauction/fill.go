@@ -199,7 +199,7 @@ func balanceAccount(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keep
 	keeper.BalanceAccount(ctx, order.Sender, outputCoins, inputCoins)
 }
 
-func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, fillQuantity sdk.Dec,
+func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, fillQuantity sdk.Dec, role string,
 	feeParams *types.Params) (dealFee sdk.SysCoins, feeReceiver string) {
 	// charge fee
 	fee := orderkeeper.GetZeroFee()
@@ -217,7 +217,8 @@ func chargeFee(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper, f
 			ctx.Logger().Error(fmt.Sprintf("Send fee failed:%s\n", err.Error()))
 		}
 	}
-	dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeParams)
+	feeRate := keeper.GetDealFeeRate(ctx, order.Sender, order.Product, role, feeParams)
+	dealFee = orderkeeper.GetDealFee(order, fillQuantity, ctx, keeper, feeRate)
 	feeReceiver, err := keeper.SendFeesToProductOwner(ctx, dealFee, order.Sender, types.FeeTypeOrderDeal, order.Product)
 	if err == nil {
 		order.RecordOrderDealFee(fee)
@@ -241,7 +242,11 @@ func fillOrder(order *types.Order, ctx sdk.Context, keeper orderkeeper.Keeper,
 		order.Unlock()
 	}
 
-	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, feeParams)
+	// the fee is charged by the trade volume before the fill
+	role := types.GetOrderRole(order, ctx.BlockHeight())
+	dealFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, role, feeParams)
+	keeper.AddTradeVolume(ctx, order.Sender, keeper.GetTradeVolumeInNativeToken(ctx, order.Product, fillPrice, fillQuantity))
 	keeper.UpdateOrder(order, ctx) // update order info on filled
-	return &types.Deal{OrderID: order.OrderID, Side: order.Side, Quantity: fillQuantity, Fee: dealFee.String(), FeeReceiver: feeReceiver}
+	return &types.Deal{OrderID: order.OrderID, Side: order.Side, Quantity: fillQuantity, Fee: dealFee.String(),
+		FeeReceiver: feeReceiver, Role: role}
 }
@@ -706,7 +706,7 @@ func TestChargeFee(t *testing.T) {
 	feeParams := types.DefaultTestParams()
 
 	for _, order := range orders {
-		retFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, &feeParams)
+		retFee, feeReceiver := chargeFee(order, ctx, keeper, fillQuantity, types.RoleTaker, &feeParams)
 		require.NotEmpty(t, retFee)
 		require.NotEmpty(t, feeReceiver)
 	}
@@ -14,5 +14,6 @@ type PaEngine struct {
 func (e *PaEngine) Run(ctx sdk.Context, keeper keeper.Keeper) {
 	cleanupExpiredOrders(ctx, keeper)
 	cleanupOrdersWhoseTokenPairHaveBeenDelisted(ctx, keeper)
+	applyTradingRuleUpdates(ctx, keeper)
 	matchOrders(ctx, keeper)
 }
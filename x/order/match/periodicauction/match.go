@@ -264,14 +264,25 @@ func cleanupExpiredOrders(ctx sdk.Context, keeper keeper.Keeper) {
 
 func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 	blockHeight := ctx.BlockHeight()
+	// the products resumed from the circuit breaker halts are matched even if they have no new orders
+	resumedProducts := resumeHaltedProducts(ctx, keeper)
 	orderNum := keeper.GetBlockOrderNum(ctx, blockHeight)
 	// no new orders in this block & no product lock in previous blocks, skip match
-	if orderNum == 0 && !keeper.AnyProductLocked(ctx) {
+	if orderNum == 0 && !keeper.AnyProductLocked(ctx) && len(resumedProducts) == 0 {
 		return
 	}
 
 	// step0: get active products
 	products := keeper.GetDiskCache().GetNewDepthbookKeys()
+	productSet := make(map[string]bool, len(products))
+	for _, product := range products {
+		productSet[product] = true
+	}
+	for _, product := range resumedProducts {
+		if !productSet[product] {
+			products = append(products, product)
+		}
+	}
 	products = keeper.FilterDelistedProducts(ctx, products)
 	keeper.GetDexKeeper().SortProducts(ctx, products) // sort products
 
@@ -302,16 +313,23 @@ func matchOrders(ctx sdk.Context, keeper keeper.Keeper) {
 
 func calcMatchPriceAndExecution(ctx sdk.Context, k keeper.Keeper, products []string) map[string]types.MatchResult {
 	resultMap := make(map[string]types.MatchResult)
+	params := k.GetParams(ctx)
 
 	for _, product := range products {
 		tokenPair := k.GetDexKeeper().GetTokenPair(ctx, product)
-		if tokenPair == nil {
+		if tokenPair == nil || k.GetProductHalt(ctx, product) != nil {
 			continue
 		}
 		book := k.GetDepthBookCopy(product)
 		bestPrice, maxExecution := periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit,
 			k.GetLastPrice(ctx, product))
-		if maxExecution.IsPositive() {
+		// the self trades prevented change the depth book, so calculate the match price again
+		for maxExecution.IsPositive() && preventSelfTrades(ctx, k, product, bestPrice, maxExecution, params.STPMode) {
+			book = k.GetDepthBookCopy(product)
+			bestPrice, maxExecution = periodicAuctionMatchPrice(book, tokenPair.MaxPriceDigit,
+				k.GetLastPrice(ctx, product))
+		}
+		if maxExecution.IsPositive() && !tripCircuitBreaker(ctx, k, product, bestPrice, params) {
 			k.SetLastPrice(ctx, product, bestPrice)
 			resultMap[product] = types.MatchResult{BlockHeight: ctx.BlockHeight(), Price: bestPrice,
 				Quantity: maxExecution, Deals: []types.Deal{}}
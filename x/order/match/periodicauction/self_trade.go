@@ -0,0 +1,133 @@
+package periodicauction
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// allocateOrders returns the buy and sell orders which would be filled at bestPrice, in the same order as
+// fillBuyOrders and fillSellOrders fill them
+func allocateOrders(ctx sdk.Context, k keeper.Keeper, product string,
+	bestPrice, maxExecution sdk.Dec) (buyOrders, sellOrders []*types.Order) {
+	book := k.GetDepthBookCopy(product)
+
+	allocate := func(price sdk.Dec, side string, executed sdk.Dec, orders []*types.Order) (sdk.Dec, []*types.Order) {
+		key := types.FormatOrderIDsKey(product, price, side)
+		for _, orderID := range k.GetProductPriceOrderIDs(key) {
+			if executed.GTE(maxExecution) {
+				break
+			}
+			order := k.GetOrder(ctx, orderID)
+			if order == nil || order.Status != types.OrderStatusOpen {
+				continue
+			}
+			orders = append(orders, order)
+			executed = executed.Add(order.RemainQuantity)
+		}
+		return executed, orders
+	}
+
+	// buy orders, prices from high to low
+	buyExecuted := sdk.ZeroDec()
+	for i := 0; i < len(book.Items) && book.Items[i].Price.GTE(bestPrice) && buyExecuted.LT(maxExecution); i++ {
+		if book.Items[i].BuyQuantity.IsPositive() {
+			buyExecuted, buyOrders = allocate(book.Items[i].Price, types.BuyOrder, buyExecuted, buyOrders)
+		}
+	}
+
+	// sell orders, prices from low to high
+	sellExecuted := sdk.ZeroDec()
+	for i := len(book.Items) - 1; i >= 0 && book.Items[i].Price.LTE(bestPrice) && sellExecuted.LT(maxExecution); i-- {
+		if book.Items[i].SellQuantity.IsPositive() {
+			sellExecuted, sellOrders = allocate(book.Items[i].Price, types.SellOrder, sellExecuted, sellOrders)
+		}
+	}
+	return buyOrders, sellOrders
+}
+
+// preventSelfTrades applies the self-trade prevention modes to the buy and sell orders of the same sender which would
+// be filled at bestPrice. The first buy and sell orders of every sender are handled by the mode of the newer one, or
+// the default mode if the order has none. It returns true if any order is canceled or decremented, so the match price
+// needs to be calculated again
+func preventSelfTrades(ctx sdk.Context, k keeper.Keeper, product string, bestPrice, maxExecution sdk.Dec,
+	defaultMode string) bool {
+	buyOrders, sellOrders := allocateOrders(ctx, k, product, bestPrice, maxExecution)
+	if len(buyOrders) == 0 || len(sellOrders) == 0 {
+		return false
+	}
+
+	firstSellOrders := make(map[string]*types.Order)
+	for _, order := range sellOrders {
+		if _, ok := firstSellOrders[order.Sender.String()]; !ok {
+			firstSellOrders[order.Sender.String()] = order
+		}
+	}
+
+	prevented := false
+	handled := make(map[string]bool)
+	for _, buyOrder := range buyOrders {
+		sender := buyOrder.Sender.String()
+		sellOrder, ok := firstSellOrders[sender]
+		if !ok || handled[sender] {
+			continue
+		}
+		handled[sender] = true
+
+		newer, older := buyOrder, sellOrder
+		if types.IsNewerOrderID(sellOrder.OrderID, buyOrder.OrderID) {
+			newer, older = sellOrder, buyOrder
+		}
+		mode := newer.STPMode
+		if mode == "" {
+			mode = defaultMode
+		}
+
+		var done bool
+		switch mode {
+		case types.STPModeCancelNewest:
+			done = preventSelfTrade(ctx, k, newer, mode, newer.RemainQuantity)
+		case types.STPModeCancelOldest:
+			done = preventSelfTrade(ctx, k, older, mode, older.RemainQuantity)
+		case types.STPModeCancelBoth:
+			done = preventSelfTrade(ctx, k, newer, mode, newer.RemainQuantity)
+			done = preventSelfTrade(ctx, k, older, mode, older.RemainQuantity) || done
+		case types.STPModeDecrement:
+			quantity := sdk.MinDec(buyOrder.RemainQuantity, sellOrder.RemainQuantity)
+			done = preventSelfTrade(ctx, k, buyOrder, mode, quantity)
+			done = preventSelfTrade(ctx, k, sellOrder, mode, quantity) || done
+		}
+		// the orders left unchanged would be handled the same way again, so the match price isn't recalculated
+		prevented = prevented || done
+	}
+	return prevented
+}
+
+// preventSelfTrade decrements the remaining quantity of the order by quantity, and quits the order with the
+// self-trade prevented state if nothing remains. It returns false if the order fails to be decremented
+func preventSelfTrade(ctx sdk.Context, k keeper.Keeper, order *types.Order, mode string, quantity sdk.Dec) bool {
+	logger := ctx.Logger().With("module", "order")
+	if quantity.LT(order.RemainQuantity) {
+		if err := k.AmendOrder(ctx, order, order.Price, order.Quantity.Sub(quantity)); err != nil {
+			logger.Error(fmt.Sprintf("failed to decrement order(%s) by %s: %v", order.OrderID, quantity, err))
+			return false
+		}
+	} else {
+		k.PreventSelfTrade(ctx, order, logger)
+	}
+	logger.Info(fmt.Sprintf("BlockHeight<%d> order(%s) self-trade prevented, mode: %s, quantity: %s",
+		ctx.BlockHeight(), order.OrderID, mode, quantity))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSelfTradePrevented,
+		sdk.NewAttribute(types.AttributeKeyOrderID, order.OrderID),
+		sdk.NewAttribute(types.AttributeKeySender, order.Sender.String()),
+		sdk.NewAttribute(types.AttributeKeyProduct, order.Product),
+		sdk.NewAttribute(types.AttributeKeySTPMode, mode),
+		sdk.NewAttribute(types.AttributeKeyQuantity, quantity.String()),
+	))
+	return true
+}
@@ -0,0 +1,141 @@
+package periodicauction
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/dex"
+	orderkeeper "github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func prepareSelfTrade(t *testing.T, buyMode, sellMode string) (orderkeeper.TestInput, []*types.Order) {
+	common.InitConfig()
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	testInput.DexKeeper.SetOperator(ctx, dex.DEXOperator{
+		Address:            tokenPair.Owner,
+		HandlingFeeAddress: tokenPair.Owner,
+	})
+
+	keeper.ResetCache(ctx)
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "10.0", "2.0"),
+	}
+	orders[0].Sender = testInput.TestAddrs[0]
+	orders[0].STPMode = buyMode
+	orders[1].Sender = testInput.TestAddrs[0]
+	orders[1].STPMode = sellMode
+	for _, order := range orders {
+		err := keeper.PlaceOrder(ctx, order)
+		require.NoError(t, err)
+	}
+	return testInput, orders
+}
+
+func TestPreventSelfTrades(t *testing.T) {
+	tests := []struct {
+		buyMode, sellMode, defaultMode string
+		expectStatus                   []int64
+		expectRemain                   []string
+		expectEvents                   int
+	}{
+		// the sell order is the newer one, its mode is used
+		{"", types.STPModeCancelNewest, types.STPModeNone,
+			[]int64{types.OrderStatusOpen, types.OrderStatusSelfTradePrevented}, []string{"1.0", "2.0"}, 1},
+		{"", types.STPModeCancelOldest, types.STPModeNone,
+			[]int64{types.OrderStatusSelfTradePrevented, types.OrderStatusOpen}, []string{"1.0", "2.0"}, 1},
+		{types.STPModeCancelNewest, types.STPModeCancelBoth, types.STPModeNone,
+			[]int64{types.OrderStatusSelfTradePrevented, types.OrderStatusSelfTradePrevented}, []string{"1.0", "2.0"}, 2},
+		{"", types.STPModeDecrement, types.STPModeNone,
+			[]int64{types.OrderStatusSelfTradePrevented, types.OrderStatusOpen}, []string{"1.0", "1.0"}, 2},
+		// the default mode is used if the newer order has none
+		{types.STPModeCancelNewest, "", types.STPModeCancelOldest,
+			[]int64{types.OrderStatusSelfTradePrevented, types.OrderStatusOpen}, []string{"1.0", "2.0"}, 1},
+	}
+
+	for _, test := range tests {
+		testInput, orders := prepareSelfTrade(t, test.buyMode, test.sellMode)
+		keeper := testInput.OrderKeeper
+		ctx := testInput.Ctx.WithEventManager(sdk.NewEventManager())
+
+		prevented := preventSelfTrades(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"),
+			sdk.MustNewDecFromStr("1.0"), test.defaultMode)
+		require.True(t, prevented)
+
+		for i, order := range orders {
+			order = keeper.GetOrder(ctx, order.OrderID)
+			require.EqualValues(t, test.expectStatus[i], order.Status)
+			require.EqualValues(t, sdk.MustNewDecFromStr(test.expectRemain[i]), order.RemainQuantity)
+		}
+
+		prevents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeSelfTradePrevented {
+				prevents++
+			}
+		}
+		require.EqualValues(t, test.expectEvents, prevents)
+
+		// no self trade is left
+		require.False(t, preventSelfTrades(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"),
+			sdk.MustNewDecFromStr("1.0"), test.defaultMode))
+	}
+}
+
+func TestPreventSelfTradesByNoneMode(t *testing.T) {
+	testInput, orders := prepareSelfTrade(t, "", "")
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	prevented := preventSelfTrades(ctx, keeper, types.TestTokenPair, sdk.MustNewDecFromStr("10.0"),
+		sdk.MustNewDecFromStr("1.0"), types.STPModeNone)
+	require.False(t, prevented)
+
+	// the orders are matched as before
+	resultMap := calcMatchPriceAndExecution(ctx, keeper, []string{types.TestTokenPair})
+	require.EqualValues(t, sdk.MustNewDecFromStr("1.0"), resultMap[types.TestTokenPair].Quantity)
+	for _, order := range orders {
+		order = keeper.GetOrder(ctx, order.OrderID)
+		require.EqualValues(t, types.OrderStatusOpen, order.Status)
+	}
+}
+
+func TestCalcMatchPriceAndExecutionWithSelfTrade(t *testing.T) {
+	testInput, orders := prepareSelfTrade(t, "", types.STPModeCancelNewest)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx
+
+	resultMap := calcMatchPriceAndExecution(ctx, keeper, []string{types.TestTokenPair})
+	_, ok := resultMap[types.TestTokenPair]
+	require.False(t, ok)
+	require.EqualValues(t, types.OrderStatusSelfTradePrevented, keeper.GetOrder(ctx, orders[1].OrderID).Status)
+}
+
+func TestPreventSelfTradeFailed(t *testing.T) {
+	testInput, orders := prepareSelfTrade(t, "", types.STPModeDecrement)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithEventManager(sdk.NewEventManager())
+
+	// the coins to lock for the decremented sell order are missing
+	sellOrder := keeper.GetOrder(ctx, orders[1].OrderID)
+	sellOrder.RemainLocked = sdk.MustNewDecFromStr("0.5")
+	acc := testInput.AccountKeeper.GetAccount(ctx, sellOrder.Sender)
+	require.Nil(t, acc.SetCoins(nil))
+	testInput.AccountKeeper.SetAccount(ctx, acc)
+
+	require.False(t, preventSelfTrade(ctx, keeper, sellOrder, types.STPModeDecrement, sdk.OneDec()))
+	require.EqualValues(t, sdk.MustNewDecFromStr("2.0"), keeper.GetOrder(ctx, sellOrder.OrderID).RemainQuantity)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeSelfTradePrevented, event.Type)
+	}
+}
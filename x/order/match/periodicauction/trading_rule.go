@@ -0,0 +1,68 @@
+package periodicauction
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	dextypes "github.com/okex/okexchain/x/dex/types"
+	"github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+// applyTradingRuleUpdates applies the trading rule updates of the products effective at this block before matching.
+// The open orders that don't conform to the new rules are canceled and refunded if the policy in the dex params says
+// so, otherwise they're grandfathered
+func applyTradingRuleUpdates(ctx sdk.Context, k keeper.Keeper) {
+	tokenPairs := k.GetDexKeeper().ApplyTradingRuleUpdates(ctx)
+	if len(tokenPairs) == 0 {
+		return
+	}
+
+	logger := ctx.Logger().With("module", "order")
+	policy := k.GetDexKeeper().GetParams(ctx).TradingRuleUpdatePolicy
+	for _, tokenPair := range tokenPairs {
+		canceled := 0
+		if policy == dextypes.TradingRulePolicyCancel {
+			canceled = cancelNonconformingOrders(ctx, k, tokenPair, logger)
+		}
+		logger.Info(fmt.Sprintf("BlockHeight<%d> apply the trading rule of product(%s), %d orders canceled",
+			ctx.BlockHeight(), tokenPair.Name(), canceled))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTradingRuleApplied,
+			sdk.NewAttribute(types.AttributeKeyProduct, tokenPair.Name()),
+			sdk.NewAttribute(types.AttributeKeyMaxPriceDigit, strconv.FormatInt(tokenPair.MaxPriceDigit, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaxSizeDigit, strconv.FormatInt(tokenPair.MaxQuantityDigit, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinTradeSize, tokenPair.MinQuantity.String()),
+			sdk.NewAttribute(types.AttributeKeyPolicy, policy),
+			sdk.NewAttribute(types.AttributeKeyCanceledOrders, strconv.Itoa(canceled)),
+		))
+	}
+}
+
+// cancelNonconformingOrders cancels the open orders of the token pair whose price or quantity doesn't conform to its
+// trading rule, and returns the number of the orders canceled
+func cancelNonconformingOrders(ctx sdk.Context, k keeper.Keeper, tokenPair *dextypes.TokenPair,
+	logger log.Logger) (canceled int) {
+	product := tokenPair.Name()
+	book := k.GetDepthBookCopy(product)
+	for _, item := range book.Items {
+		for _, side := range []string{types.BuyOrder, types.SellOrder} {
+			// copy the order ids, which are changed in place when the orders are removed from the depth book
+			orderIDs := append([]string{}, k.GetProductPriceOrderIDs(types.FormatOrderIDsKey(product, item.Price,
+				side))...)
+			for _, orderID := range orderIDs {
+				order := k.GetOrder(ctx, orderID)
+				if order == nil || tokenPair.ConformsToTradingRule(order.Price, order.Quantity) {
+					continue
+				}
+				k.CancelNonconformingOrder(ctx, order, logger)
+				canceled++
+			}
+		}
+	}
+	return canceled
+}
@@ -0,0 +1,85 @@
+package periodicauction
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/dex"
+	dextypes "github.com/okex/okexchain/x/dex/types"
+	orderkeeper "github.com/okex/okexchain/x/order/keeper"
+	"github.com/okex/okexchain/x/order/types"
+)
+
+func prepareTradingRuleUpdate(t *testing.T, policy string) (orderkeeper.TestInput, []*types.Order, sdk.SysCoins) {
+	common.InitConfig()
+	testInput := orderkeeper.CreateTestInput(t)
+	keeper := testInput.OrderKeeper
+	ctx := testInput.Ctx.WithBlockHeight(10)
+
+	tokenPair := dex.GetBuiltInTokenPair()
+	err := testInput.DexKeeper.SaveTokenPair(ctx, tokenPair)
+	require.Nil(t, err)
+	dexParams := testInput.DexKeeper.GetParams(ctx)
+	dexParams.TradingRuleUpdatePolicy = policy
+	testInput.DexKeeper.SetParams(ctx, dexParams)
+
+	keeper.ResetCache(ctx)
+	coins := testInput.TokenKeeper.GetCoins(ctx, testInput.TestAddrs[0])
+	orders := []*types.Order{
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.0", "1.0"),
+		mockOrder("", types.TestTokenPair, types.BuyOrder, "10.05", "1.0"),
+		mockOrder("", types.TestTokenPair, types.SellOrder, "11.0", "0.5"),
+	}
+	for _, order := range orders {
+		order.Sender = testInput.TestAddrs[0]
+		require.NoError(t, keeper.PlaceOrder(ctx, order))
+	}
+
+	// the price digit becomes 1 and the min quantity becomes 1 from height 11
+	update := dextypes.NewTradingRuleUpdate(1, 8, sdk.OneDec(), 11)
+	testInput.DexKeeper.ScheduleTradingRuleUpdate(ctx, tokenPair, update)
+	return testInput, orders, coins
+}
+
+func TestApplyTradingRuleUpdatesWithCancelPolicy(t *testing.T) {
+	testInput, orders, coins := prepareTradingRuleUpdate(t, dextypes.TradingRulePolicyCancel)
+	keeper := testInput.OrderKeeper
+
+	ctx := testInput.Ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	applyTradingRuleUpdates(ctx, keeper)
+	require.Empty(t, ctx.EventManager().Events())
+
+	ctx = ctx.WithBlockHeight(11)
+	applyTradingRuleUpdates(ctx, keeper)
+	require.EqualValues(t, 1, testInput.DexKeeper.GetTokenPair(ctx, types.TestTokenPair).MaxPriceDigit)
+	require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, orders[0].OrderID).Status)
+	require.EqualValues(t, types.OrderStatusCancelled, keeper.GetOrder(ctx, orders[1].OrderID).Status)
+	require.EqualValues(t, types.OrderStatusCancelled, keeper.GetOrder(ctx, orders[2].OrderID).Status)
+	require.Equal(t, 1, len(keeper.GetDepthBookCopy(types.TestTokenPair).Items))
+
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	require.Equal(t, types.EventTypeTradingRuleApplied, event.Type)
+	require.Equal(t, "2", string(event.Attributes[len(event.Attributes)-1].Value))
+
+	// the fees of the canceled orders are refunded in full
+	keeper.CancelOrder(ctx, keeper.GetOrder(ctx, orders[0].OrderID), ctx.Logger())
+	cancelFee := orderkeeper.GetOrderCostFee(orders[0], ctx)
+	require.Equal(t, coins.Sub(cancelFee), testInput.TokenKeeper.GetCoins(ctx, testInput.TestAddrs[0]))
+}
+
+func TestApplyTradingRuleUpdatesWithGrandfatherPolicy(t *testing.T) {
+	testInput, orders, _ := prepareTradingRuleUpdate(t, dextypes.TradingRulePolicyGrandfather)
+	keeper := testInput.OrderKeeper
+
+	ctx := testInput.Ctx.WithBlockHeight(11).WithEventManager(sdk.NewEventManager())
+	applyTradingRuleUpdates(ctx, keeper)
+	require.EqualValues(t, 1, testInput.DexKeeper.GetTokenPair(ctx, types.TestTokenPair).MaxPriceDigit)
+	for _, order := range orders {
+		require.EqualValues(t, types.OrderStatusOpen, keeper.GetOrder(ctx, order.OrderID).Status)
+	}
+	require.Equal(t, types.EventTypeTradingRuleApplied, ctx.EventManager().Events()[0].Type)
+}
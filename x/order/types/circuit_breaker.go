@@ -0,0 +1,138 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	DefaultPriceBandRate            = "0" // disabled
+	DefaultCircuitBreakerRate       = "0" // disabled
+	DefaultCircuitBreakerWindow     = 100 // blocks
+	DefaultCircuitBreakerHaltBlocks = 100 // blocks
+
+	EventTypeCircuitBreakerHalt   = "circuit_breaker_halt"
+	EventTypeCircuitBreakerResume = "circuit_breaker_resume"
+
+	AttributeKeyPrice       = "price"
+	AttributeKeyRefPrice    = "ref_price"
+	AttributeKeyBlockHeight = "block_height"
+	AttributeKeyEndHeight   = "end_height"
+)
+
+// PriceProtection is the price band and the circuit breaker params applied to a product
+type PriceProtection struct {
+	PriceBandRate            sdk.Dec `json:"price_band_rate"`
+	CircuitBreakerRate       sdk.Dec `json:"circuit_breaker_rate"`
+	CircuitBreakerWindow     int64   `json:"circuit_breaker_window"`
+	CircuitBreakerHaltBlocks int64   `json:"circuit_breaker_halt_blocks"`
+}
+
+// Validate returns an error if any rate is negative or the window or the halt blocks isn't positive
+func (p PriceProtection) Validate() error {
+	if p.PriceBandRate.IsNil() || p.PriceBandRate.IsNegative() {
+		return fmt.Errorf("price band rate must not be negative")
+	}
+	if p.CircuitBreakerRate.IsNil() || p.CircuitBreakerRate.IsNegative() {
+		return fmt.Errorf("circuit breaker rate must not be negative")
+	}
+	if p.CircuitBreakerWindow <= 0 {
+		return fmt.Errorf("circuit breaker window must be positive")
+	}
+	if p.CircuitBreakerHaltBlocks <= 0 {
+		return fmt.Errorf("circuit breaker halt blocks must be positive")
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (p PriceProtection) String() string {
+	return fmt.Sprintf("price band rate: %s, circuit breaker rate: %s, circuit breaker window: %d, "+
+		"circuit breaker halt blocks: %d", p.PriceBandRate, p.CircuitBreakerRate, p.CircuitBreakerWindow,
+		p.CircuitBreakerHaltBlocks)
+}
+
+// ProductPriceProtection overrides the price protection params of the order module for a product
+type ProductPriceProtection struct {
+	Product         string          `json:"product"`
+	PriceProtection PriceProtection `json:"price_protection"`
+}
+
+// ProductPriceProtections is the products whose price protection params are tuned by the governance
+type ProductPriceProtections []ProductPriceProtection
+
+// Validate returns an error if any product is empty or duplicated, or any override is invalid
+func (ps ProductPriceProtections) Validate() error {
+	products := make(map[string]bool, len(ps))
+	for _, p := range ps {
+		if len(p.Product) == 0 {
+			return fmt.Errorf("product of the price protection must not be empty")
+		}
+		if products[p.Product] {
+			return fmt.Errorf("duplicated price protection of product(%s)", p.Product)
+		}
+		products[p.Product] = true
+		if err := p.PriceProtection.Validate(); err != nil {
+			return fmt.Errorf("invalid price protection of product(%s): %v", p.Product, err)
+		}
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (ps ProductPriceProtections) String() string {
+	if len(ps) == 0 {
+		return "[]"
+	}
+	var b strings.Builder
+	for _, p := range ps {
+		b.WriteString(fmt.Sprintf("\n    %s: %s", p.Product, p.PriceProtection))
+	}
+	return b.String()
+}
+
+// PricePoint is the clearing price of a product in a block
+type PricePoint struct {
+	BlockHeight int64   `json:"block_height"`
+	Price       sdk.Dec `json:"price"`
+}
+
+// ProductHalt is the pause of the matching of a product by the circuit breaker, the matching is paused from
+// BlockHeight and resumed at EndHeight
+type ProductHalt struct {
+	Product     string  `json:"product"`
+	BlockHeight int64   `json:"block_height"`
+	EndHeight   int64   `json:"end_height"`
+	Price       sdk.Dec `json:"price"`
+	RefPrice    sdk.Dec `json:"ref_price"`
+}
+
+// NewProductHalt creates a new instance of ProductHalt
+func NewProductHalt(product string, blockHeight, haltBlocks int64, price, refPrice sdk.Dec) ProductHalt {
+	return ProductHalt{
+		Product:     product,
+		BlockHeight: blockHeight,
+		EndHeight:   blockHeight + haltBlocks,
+		Price:       price,
+		RefPrice:    refPrice,
+	}
+}
+
+// IsActive returns true if the matching is still paused at the block height
+func (h ProductHalt) IsActive(blockHeight int64) bool {
+	return blockHeight < h.EndHeight
+}
+
+// String implements the stringer interface
+func (h ProductHalt) String() string {
+	return fmt.Sprintf("product: %s, block height: %d, end height: %d, price: %s, ref price: %s",
+		h.Product, h.BlockHeight, h.EndHeight, h.Price, h.RefPrice)
+}
+
+// PriceDeviation returns the rate of the distance from the reference price to the price
+func PriceDeviation(price, refPrice sdk.Dec) sdk.Dec {
+	return price.Sub(refPrice).Abs().Quo(refPrice)
+}
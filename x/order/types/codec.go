@@ -8,6 +8,10 @@ import (
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgNewOrders{}, "okexchain/order/MsgNew", nil)
 	cdc.RegisterConcrete(MsgCancelOrders{}, "okexchain/order/MsgCancel", nil)
+	cdc.RegisterConcrete(MsgCancelAllOrders{}, "okexchain/order/MsgCancelAll", nil)
+	cdc.RegisterConcrete(MsgAmendOrder{}, "okexchain/order/MsgAmend", nil)
+	cdc.RegisterConcrete(MsgGrantTrading{}, "okexchain/order/MsgGrantTrading", nil)
+	cdc.RegisterConcrete(MsgRevokeTrading{}, "okexchain/order/MsgRevokeTrading", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout this module
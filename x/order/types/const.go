@@ -12,7 +12,10 @@ const (
 	FeeTypeOrderExpire  = "expire"
 	FeeTypeOrderDeal    = "deal"
 	FeeTypeOrderReceive = "receive"
-	TestTokenPair       = common.TestToken + "_" + sdk.DefaultBondDenom
-	BuyOrder            = "BUY"
-	SellOrder           = "SELL"
+	FeeTypeOrderSTP     = "stp"
+	// FeeTypeOrderTradingRule quits the orders that don't conform to the updated trading rule without any fee
+	FeeTypeOrderTradingRule = "trading_rule"
+	TestTokenPair           = common.TestToken + "_" + sdk.DefaultBondDenom
+	BuyOrder                = "BUY"
+	SellOrder               = "SELL"
 )
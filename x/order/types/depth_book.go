@@ -13,6 +13,7 @@ type Deal struct {
 	Quantity    sdk.Dec `json:"quantity"`
 	Fee         string  `json:"fee"`
 	FeeReceiver string  `json:"fee_receiver"`
+	Role        string  `json:"role"`
 }
 
 // nolint
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// nolint
+const (
+	// the role of an order in the periodic auction of a block
+	RoleMaker = "maker"
+	RoleTaker = "taker"
+
+	// TradeVolumeWindowDays is the number of the days of the rolling trade volume of an account
+	TradeVolumeWindowDays = 30
+	SecondsInADay         = 24 * 60 * 60
+)
+
+// GetOrderRole returns the role of the order in the periodic auction of the block. The order resting on the depth
+// book before the block provides the liquidity as a maker, while the order placed or requeued by an amendment in the
+// block takes the liquidity
+func GetOrderRole(order *Order, blockHeight int64) string {
+	if GetBlockHeightFromOrderID(order.OrderID) < blockHeight && order.AmendHeight < blockHeight {
+		return RoleMaker
+	}
+	return RoleTaker
+}
+
+// FeeTier discounts the maker and the taker fee rates of the accounts, whose trade volume in the rolling window is
+// not less than MinVolume
+type FeeTier struct {
+	MinVolume sdk.Dec `json:"min_volume"`
+	Discount  sdk.Dec `json:"discount"`
+}
+
+// FeeTiers is the fee tiers in the ascending order of the min volumes
+type FeeTiers []FeeTier
+
+// Validate returns an error if the tiers aren't in the strictly ascending order of the min volumes or any discount
+// is out of [0, 1]
+func (tiers FeeTiers) Validate() error {
+	for i, tier := range tiers {
+		if tier.MinVolume.IsNil() || tier.MinVolume.IsNegative() {
+			return fmt.Errorf("min volume of fee tier %d must not be negative", i+1)
+		}
+		if tier.Discount.IsNil() || tier.Discount.IsNegative() || tier.Discount.GT(sdk.OneDec()) {
+			return fmt.Errorf("discount of fee tier %d must be between 0 and 1", i+1)
+		}
+		if i > 0 && !tier.MinVolume.GT(tiers[i-1].MinVolume) {
+			return fmt.Errorf("min volume of fee tier %d must be greater than the previous one", i+1)
+		}
+	}
+	return nil
+}
+
+// GetTier returns the tier of the volume and its discount. The tier is 0 without any discount if the volume doesn't
+// reach the first tier, otherwise the tier i is tiers[i-1]
+func (tiers FeeTiers) GetTier(volume sdk.Dec) (int64, sdk.Dec) {
+	for i := len(tiers) - 1; i >= 0; i-- {
+		if volume.GTE(tiers[i].MinVolume) {
+			return int64(i + 1), tiers[i].Discount
+		}
+	}
+	return 0, sdk.ZeroDec()
+}
+
+// String implements the stringer interface
+func (tiers FeeTiers) String() string {
+	items := make([]string, len(tiers))
+	for i, tier := range tiers {
+		items[i] = fmt.Sprintf("%s:%s", tier.MinVolume, tier.Discount)
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+// AccountFeeTier is the fee tier of an account and its fee rates after the discount
+type AccountFeeTier struct {
+	Address sdk.AccAddress `json:"address"`
+	// Volume is the trade volume of the account in the native token in the rolling window
+	Volume       sdk.Dec `json:"volume"`
+	Tier         int64   `json:"tier"`
+	Discount     sdk.Dec `json:"discount"`
+	MakerFeeRate sdk.Dec `json:"maker_fee_rate"`
+	TakerFeeRate sdk.Dec `json:"taker_fee_rate"`
+}
+
+// String implements the stringer interface
+func (t AccountFeeTier) String() string {
+	return fmt.Sprintf(`Account Fee Tier:
+  Address: %s
+  Volume: %s
+  Tier: %d
+  Discount: %s
+  MakerFeeRate: %s
+  TakerFeeRate: %s`, t.Address, t.Volume, t.Tier, t.Discount, t.MakerFeeRate, t.TakerFeeRate)
+}
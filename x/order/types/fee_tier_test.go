@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeTiers(t *testing.T) {
+	tiers := FeeTiers{
+		{MinVolume: sdk.NewDec(1000), Discount: sdk.MustNewDecFromStr("0.1")},
+		{MinVolume: sdk.NewDec(10000), Discount: sdk.MustNewDecFromStr("0.25")},
+	}
+	require.Nil(t, tiers.Validate())
+	require.Equal(t, "[1000.000000000000000000:0.100000000000000000, "+
+		"10000.000000000000000000:0.250000000000000000]", tiers.String())
+
+	tier, discount := tiers.GetTier(sdk.NewDec(999))
+	require.EqualValues(t, 0, tier)
+	require.True(t, discount.IsZero())
+	tier, discount = tiers.GetTier(sdk.NewDec(1000))
+	require.EqualValues(t, 1, tier)
+	require.Equal(t, sdk.MustNewDecFromStr("0.1"), discount)
+	tier, discount = tiers.GetTier(sdk.NewDec(20000))
+	require.EqualValues(t, 2, tier)
+	require.Equal(t, sdk.MustNewDecFromStr("0.25"), discount)
+
+	// not ascending
+	invalid := FeeTiers{tiers[1], tiers[0]}
+	require.NotNil(t, invalid.Validate())
+	// discount out of [0, 1]
+	invalid = FeeTiers{{MinVolume: sdk.NewDec(1), Discount: sdk.NewDec(2)}}
+	require.NotNil(t, invalid.Validate())
+	invalid = FeeTiers{{MinVolume: sdk.NewDec(-1), Discount: sdk.ZeroDec()}}
+	require.NotNil(t, invalid.Validate())
+}
+
+func TestGetOrderRole(t *testing.T) {
+	order := MockOrder(FormatOrderID(10, 1), TestTokenPair, BuyOrder, "10", "1")
+	require.Equal(t, RoleTaker, GetOrderRole(order, 10))
+	require.Equal(t, RoleMaker, GetOrderRole(order, 11))
+
+	// the order requeued by an amendment takes the liquidity in the block of the amendment
+	order.AmendHeight = 12
+	require.Equal(t, RoleTaker, GetOrderRole(order, 12))
+	require.Equal(t, RoleMaker, GetOrderRole(order, 13))
+}
@@ -25,6 +25,9 @@ const (
 	QueryParameters  = "params"
 	QueryStore       = "store"
 	QueryDepthBookV2 = "depthbookV2"
+	QueryFeeTier     = "feetier"
+	QueryGrants      = "grants"
+	QueryHalts       = "halts"
 
 	OrderStoreKey = ModuleName
 )
@@ -40,6 +43,11 @@ var (
 	PriceKey             = []byte{0x14}
 	ExpireBlockHeightKey = []byte{0x15}
 	OrderNumPerBlockKey  = []byte{0x16}
+	TradeVolumeKey       = []byte{0x21}
+	TradingGrantKey      = []byte{0x22}
+	PriceWindowKey       = []byte{0x23}
+	ProductHaltKey       = []byte{0x24}
+	AccountOrderKey      = []byte{0x25}
 
 	// none iterator keys
 	RecentlyClosedOrderIDsKey = []byte{0x17}
@@ -78,6 +86,46 @@ func GetExpireBlockHeightKey(blockHeight int64) []byte {
 	return append(ExpireBlockHeightKey, sdk.Uint64ToBigEndian(uint64(blockHeight))...)
 }
 
+// GetTradeVolumePrefix returns the prefix of the daily trade volumes of the address
+func GetTradeVolumePrefix(addr sdk.AccAddress) []byte {
+	return append(TradeVolumeKey, addr.Bytes()...)
+}
+
+// GetTradeVolumeKey returns the key of the trade volume of the address in the day since the epoch
+func GetTradeVolumeKey(addr sdk.AccAddress, day int64) []byte {
+	return append(GetTradeVolumePrefix(addr), sdk.Uint64ToBigEndian(uint64(day))...)
+}
+
+// GetTradingGrantPrefix returns the prefix of the trading grants of the granter
+func GetTradingGrantPrefix(granter sdk.AccAddress) []byte {
+	return append(TradingGrantKey, granter.Bytes()...)
+}
+
+// GetTradingGrantKey returns the key of the trading grant from the granter to the grantee
+func GetTradingGrantKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetTradingGrantPrefix(granter), grantee.Bytes()...)
+}
+
+// GetAccountOrderPrefix returns the prefix of the open order index of the address
+func GetAccountOrderPrefix(addr sdk.AccAddress) []byte {
+	return append(AccountOrderKey, addr.Bytes()...)
+}
+
+// GetAccountOrderKey returns the key of the open order of the address in the index
+func GetAccountOrderKey(addr sdk.AccAddress, orderID string) []byte {
+	return append(GetAccountOrderPrefix(addr), []byte(orderID)...)
+}
+
+// GetPriceWindowKey returns the key of the recent clearing prices of the product
+func GetPriceWindowKey(product string) []byte {
+	return append(PriceWindowKey, []byte(product)...)
+}
+
+// GetProductHaltKey returns the key of the circuit breaker halt of the product
+func GetProductHaltKey(product string) []byte {
+	return append(ProductHaltKey, []byte(product)...)
+}
+
 // nolint
 func FormatOrderIDsKey(product string, price sdk.Dec, side string) string {
 	return fmt.Sprintf("%v:%v:%v", product, price.String(), side)
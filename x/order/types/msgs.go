@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -61,6 +62,9 @@ func NewMsgCancelOrder(sender sdk.AccAddress, orderID string) MsgCancelOrders {
 type MsgNewOrders struct {
 	Sender     sdk.AccAddress `json:"sender"` // order maker address
 	OrderItems []OrderItem    `json:"order_items"`
+	// Granter is the owner of the orders placed by the sender under its trading grant, the sender owns the orders if
+	// it's empty
+	Granter sdk.AccAddress `json:"granter,omitempty"`
 }
 
 // nolint
@@ -69,6 +73,8 @@ type OrderItem struct {
 	Side     string  `json:"side"`     // BUY/SELL
 	Price    sdk.Dec `json:"price"`    // price of the order
 	Quantity sdk.Dec `json:"quantity"` // quantity of the order
+	// STPMode is the self-trade prevention mode of the order, the mode in params is used if empty
+	STPMode string `json:"stp_mode,omitempty"`
 }
 
 // nolint
@@ -90,6 +96,20 @@ func NewMsgNewOrders(sender sdk.AccAddress, orderItems []OrderItem) MsgNewOrders
 	}
 }
 
+// WithGranter places the orders on behalf of the granter
+func (msg MsgNewOrders) WithGranter(granter sdk.AccAddress) MsgNewOrders {
+	msg.Granter = granter
+	return msg
+}
+
+// GetOwner returns the owner of the orders
+func (msg MsgNewOrders) GetOwner() sdk.AccAddress {
+	if msg.Granter.Empty() {
+		return msg.Sender
+	}
+	return msg.Granter
+}
+
 // nolint
 func (msg MsgNewOrders) Route() string { return "order" }
 
@@ -101,6 +121,9 @@ func (msg MsgNewOrders) ValidateBasic() sdk.Error {
 	if msg.Sender.Empty() {
 		return sdk.ErrInvalidAddress(msg.Sender.String())
 	}
+	if msg.Granter.Equals(msg.Sender) {
+		return sdk.ErrInvalidAddress("granter is the same as sender")
+	}
 	if msg.OrderItems == nil || len(msg.OrderItems) == 0 {
 		return sdk.ErrUnknownRequest("invalid OrderItems")
 	}
@@ -125,6 +148,11 @@ func (msg MsgNewOrders) ValidateBasic() sdk.Error {
 		if !(item.Price.IsPositive() && item.Quantity.IsPositive()) {
 			return sdk.ErrUnknownRequest("Price/Quantity must be positive")
 		}
+		if item.STPMode != "" {
+			if err := ValidateSTPMode(item.STPMode); err != nil {
+				return sdk.ErrUnknownRequest(err.Error())
+			}
+		}
 	}
 
 	return nil
@@ -150,6 +178,8 @@ func (msg MsgNewOrders) CalculateGas(gasUnit uint64) uint64 {
 type MsgCancelOrders struct {
 	Sender   sdk.AccAddress `json:"sender"` // order maker address
 	OrderIDs []string       `json:"order_ids"`
+	// Granter is the owner of the orders canceled by the sender under its trading grant
+	Granter sdk.AccAddress `json:"granter,omitempty"`
 }
 
 // NewMsgCancelOrders is a constructor function for MsgCancelOrder
@@ -161,6 +191,20 @@ func NewMsgCancelOrders(sender sdk.AccAddress, orderIDItems []string) MsgCancelO
 	return msgCancelOrder
 }
 
+// WithGranter cancels the orders on behalf of the granter
+func (msg MsgCancelOrders) WithGranter(granter sdk.AccAddress) MsgCancelOrders {
+	msg.Granter = granter
+	return msg
+}
+
+// GetOwner returns the owner of the orders
+func (msg MsgCancelOrders) GetOwner() sdk.AccAddress {
+	if msg.Granter.Empty() {
+		return msg.Sender
+	}
+	return msg.Granter
+}
+
 // nolint
 func (msg MsgCancelOrders) Route() string { return "order" }
 
@@ -172,6 +216,9 @@ func (msg MsgCancelOrders) ValidateBasic() sdk.Error {
 	if msg.Sender.Empty() {
 		return sdk.ErrInvalidAddress(msg.Sender.String())
 	}
+	if msg.Granter.Equals(msg.Sender) {
+		return sdk.ErrInvalidAddress("granter is the same as sender")
+	}
 	if msg.OrderIDs == nil || len(msg.OrderIDs) == 0 {
 		return sdk.ErrUnknownRequest("invalid OrderIDs")
 	}
@@ -218,6 +265,250 @@ func (msg MsgCancelOrders) CalculateGas(gasUnit uint64) uint64 {
 	return uint64(len(msg.OrderIDs)) * gasUnit
 }
 
+// MsgCancelAllOrders cancels all the open orders of the sender, the product and the side filter the orders if they
+// aren't empty
+type MsgCancelAllOrders struct {
+	Sender  sdk.AccAddress `json:"sender"`
+	Product string         `json:"product"`
+	Side    string         `json:"side"`
+	// Granter is the owner of the orders canceled by the sender under its trading grant
+	Granter sdk.AccAddress `json:"granter,omitempty"`
+}
+
+// NewMsgCancelAllOrders is a constructor function for MsgCancelAllOrders
+func NewMsgCancelAllOrders(sender sdk.AccAddress, product, side string) MsgCancelAllOrders {
+	return MsgCancelAllOrders{
+		Sender:  sender,
+		Product: product,
+		Side:    side,
+	}
+}
+
+// WithGranter cancels the orders on behalf of the granter
+func (msg MsgCancelAllOrders) WithGranter(granter sdk.AccAddress) MsgCancelAllOrders {
+	msg.Granter = granter
+	return msg
+}
+
+// GetOwner returns the owner of the orders
+func (msg MsgCancelAllOrders) GetOwner() sdk.AccAddress {
+	if msg.Granter.Empty() {
+		return msg.Sender
+	}
+	return msg.Granter
+}
+
+// nolint
+func (msg MsgCancelAllOrders) Route() string { return RouterKey }
+
+// nolint
+func (msg MsgCancelAllOrders) Type() string { return "cancel_all" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgCancelAllOrders) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress(msg.Sender.String())
+	}
+	if msg.Granter.Equals(msg.Sender) {
+		return sdk.ErrInvalidAddress("granter is the same as sender")
+	}
+	if msg.Product != "" && len(strings.Split(msg.Product, "_")) != 2 {
+		return sdk.ErrUnknownRequest("Product should be in the format of \"base_quote\"")
+	}
+	if msg.Side != "" && msg.Side != BuyOrder && msg.Side != SellOrder {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Side is expected to be \"BUY\", \"SELL\" or empty, but got \"%s\"", msg.Side))
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelAllOrders) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelAllOrders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgAmendOrder changes the price and the quantity of an open order in place, a zero price or quantity keeps the
+// current one. The quantity is the new total quantity of the order including the filled part
+type MsgAmendOrder struct {
+	Sender   sdk.AccAddress `json:"sender"`
+	OrderID  string         `json:"order_id"`
+	Price    sdk.Dec        `json:"price"`
+	Quantity sdk.Dec        `json:"quantity"`
+	// Granter is the owner of the order amended by the sender under its trading grant
+	Granter sdk.AccAddress `json:"granter,omitempty"`
+}
+
+// NewMsgAmendOrder is a constructor function for MsgAmendOrder
+func NewMsgAmendOrder(sender sdk.AccAddress, orderID string, price, quantity sdk.Dec) MsgAmendOrder {
+	return MsgAmendOrder{
+		Sender:   sender,
+		OrderID:  orderID,
+		Price:    price,
+		Quantity: quantity,
+	}
+}
+
+// WithGranter amends the order on behalf of the granter
+func (msg MsgAmendOrder) WithGranter(granter sdk.AccAddress) MsgAmendOrder {
+	msg.Granter = granter
+	return msg
+}
+
+// GetOwner returns the owner of the order
+func (msg MsgAmendOrder) GetOwner() sdk.AccAddress {
+	if msg.Granter.Empty() {
+		return msg.Sender
+	}
+	return msg.Granter
+}
+
+// nolint
+func (msg MsgAmendOrder) Route() string { return RouterKey }
+
+// nolint
+func (msg MsgAmendOrder) Type() string { return "amend" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgAmendOrder) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress(msg.Sender.String())
+	}
+	if msg.Granter.Equals(msg.Sender) {
+		return sdk.ErrInvalidAddress("granter is the same as sender")
+	}
+	if msg.OrderID == "" {
+		return sdk.ErrUnknownRequest("orderID cannot be empty")
+	}
+	if msg.Price.IsNil() || msg.Quantity.IsNil() || msg.Price.IsNegative() || msg.Quantity.IsNegative() {
+		return sdk.ErrUnknownRequest("Price/Quantity must not be negative")
+	}
+	if msg.Price.IsZero() && msg.Quantity.IsZero() {
+		return sdk.ErrUnknownRequest("either Price or Quantity should be amended")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAmendOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAmendOrder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgGrantTrading authorizes the grantee to place and cancel orders on behalf of the granter, it replaces the grant
+// to the grantee given before
+type MsgGrantTrading struct {
+	Granter     sdk.AccAddress `json:"granter"`
+	Grantee     sdk.AccAddress `json:"grantee"`
+	Products    []string       `json:"products"`
+	Expiration  time.Time      `json:"expiration"`
+	MaxNotional sdk.Dec        `json:"max_notional"`
+}
+
+// NewMsgGrantTrading is a constructor function for MsgGrantTrading
+func NewMsgGrantTrading(granter, grantee sdk.AccAddress, products []string, expiration time.Time,
+	maxNotional sdk.Dec) MsgGrantTrading {
+	return MsgGrantTrading{
+		Granter:     granter,
+		Grantee:     grantee,
+		Products:    products,
+		Expiration:  expiration,
+		MaxNotional: maxNotional,
+	}
+}
+
+// nolint
+func (msg MsgGrantTrading) Route() string { return RouterKey }
+
+// nolint
+func (msg MsgGrantTrading) Type() string { return "grant_trading" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgGrantTrading) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() || msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing granter or grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return sdk.ErrInvalidAddress("granter is the same as grantee")
+	}
+	if len(msg.Products) > OrderItemLimit {
+		return sdk.ErrUnknownRequest("Numbers of products should not be more than " + strconv.Itoa(OrderItemLimit))
+	}
+	for _, product := range msg.Products {
+		if len(strings.Split(product, "_")) != 2 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid product: %s", product))
+		}
+	}
+	if msg.Expiration.IsZero() {
+		return sdk.ErrUnknownRequest("missing expiration")
+	}
+	// the max notional is optional
+	if !msg.MaxNotional.IsNil() && msg.MaxNotional.IsNegative() {
+		return sdk.ErrUnknownRequest("max notional can't be negative")
+	}
+	return nil
+}
+
+// GetSignBytes : encodes the message for signing
+func (msg MsgGrantTrading) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgGrantTrading) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// MsgRevokeTrading revokes the trading grant to the grantee
+type MsgRevokeTrading struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewMsgRevokeTrading is a constructor function for MsgRevokeTrading
+func NewMsgRevokeTrading(granter, grantee sdk.AccAddress) MsgRevokeTrading {
+	return MsgRevokeTrading{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+// nolint
+func (msg MsgRevokeTrading) Route() string { return RouterKey }
+
+// nolint
+func (msg MsgRevokeTrading) Type() string { return "revoke_trading" }
+
+// ValidateBasic : Implements Msg.
+func (msg MsgRevokeTrading) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() || msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("missing granter or grantee address")
+	}
+	return nil
+}
+
+// GetSignBytes : encodes the message for signing
+func (msg MsgRevokeTrading) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeTrading) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
 // nolint
 type OrderResult struct {
 	Error   error  `json:"error"`
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"strconv"
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/okex/okexchain/x/common"
 
 	"github.com/stretchr/testify/require"
@@ -313,3 +315,132 @@ func TestHasDuplicatedID(t *testing.T) {
 	result2 := hasDuplicatedID(ids2)
 	require.EqualValues(t, true, result2)
 }
+
+func TestMsgGrantTrading(t *testing.T) {
+	granter, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	grantee, err := hex.DecodeString("3434343434343434343434343434343434343434")
+	require.Nil(t, err)
+	expiration := time.Now().Add(time.Hour)
+	maxNotional := sdk.MustNewDecFromStr("100")
+
+	msg := NewMsgGrantTrading(granter, grantee, []string{TestTokenPair}, expiration, maxNotional)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "order", msg.Route())
+	require.Equal(t, "grant_trading", msg.Type())
+	require.EqualValues(t, granter, msg.GetSigners()[0])
+
+	// unrestricted products
+	msg = NewMsgGrantTrading(granter, grantee, nil, expiration, maxNotional)
+	require.Nil(t, msg.ValidateBasic())
+
+	// same granter and grantee
+	msg = NewMsgGrantTrading(granter, granter, nil, expiration, maxNotional)
+	require.NotNil(t, msg.ValidateBasic())
+
+	// empty grantee
+	msg = NewMsgGrantTrading(granter, nil, nil, expiration, maxNotional)
+	require.NotNil(t, msg.ValidateBasic())
+
+	// invalid product
+	msg = NewMsgGrantTrading(granter, grantee, []string{"abc"}, expiration, maxNotional)
+	require.NotNil(t, msg.ValidateBasic())
+
+	// missing expiration
+	msg = NewMsgGrantTrading(granter, grantee, nil, time.Time{}, maxNotional)
+	require.NotNil(t, msg.ValidateBasic())
+
+	// the max notional is optional, but it can't be negative
+	msg = NewMsgGrantTrading(granter, grantee, nil, expiration, sdk.ZeroDec())
+	require.Nil(t, msg.ValidateBasic())
+	msg = NewMsgGrantTrading(granter, grantee, nil, expiration, sdk.Dec{})
+	require.Nil(t, msg.ValidateBasic())
+	msg = NewMsgGrantTrading(granter, grantee, nil, expiration, sdk.NewDec(-1))
+	require.NotNil(t, msg.ValidateBasic())
+
+	// revoke
+	revokeMsg := NewMsgRevokeTrading(granter, grantee)
+	require.Nil(t, revokeMsg.ValidateBasic())
+	require.Equal(t, "revoke_trading", revokeMsg.Type())
+	require.EqualValues(t, granter, revokeMsg.GetSigners()[0])
+	revokeMsg = NewMsgRevokeTrading(granter, nil)
+	require.NotNil(t, revokeMsg.ValidateBasic())
+}
+
+func TestMsgOrdersWithGranter(t *testing.T) {
+	sender, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	granter, err := hex.DecodeString("3434343434343434343434343434343434343434")
+	require.Nil(t, err)
+
+	newMsg := NewMsgNewOrder(sender, TestTokenPair, BuyOrder, testPrice, testQuantity)
+	require.EqualValues(t, sender, newMsg.GetOwner())
+	newMsg = newMsg.WithGranter(granter)
+	require.Nil(t, newMsg.ValidateBasic())
+	require.EqualValues(t, granter, newMsg.GetOwner())
+	require.EqualValues(t, sender, newMsg.GetSigners()[0])
+	require.NotNil(t, newMsg.WithGranter(sender).ValidateBasic())
+
+	cancelMsg := NewMsgCancelOrder(sender, testOrderID)
+	require.EqualValues(t, sender, cancelMsg.GetOwner())
+	cancelMsg = cancelMsg.WithGranter(granter)
+	require.Nil(t, cancelMsg.ValidateBasic())
+	require.EqualValues(t, granter, cancelMsg.GetOwner())
+	require.NotNil(t, cancelMsg.WithGranter(sender).ValidateBasic())
+}
+
+func TestMsgCancelAllOrders(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+
+	msg := NewMsgCancelAllOrders(addr, "", "")
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "order", msg.Route())
+	require.Equal(t, "cancel_all", msg.Type())
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	msg = NewMsgCancelAllOrders(addr, TestTokenPair, SellOrder)
+	require.Nil(t, msg.ValidateBasic())
+
+	// invalid product
+	msg = NewMsgCancelAllOrders(addr, "abc", "")
+	require.NotNil(t, msg.ValidateBasic())
+
+	// invalid side
+	msg = NewMsgCancelAllOrders(addr, "", "abc")
+	require.NotNil(t, msg.ValidateBasic())
+
+	// empty sender
+	msg = NewMsgCancelAllOrders(nil, "", "")
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+func TestMsgAmendOrder(t *testing.T) {
+	addr, err := hex.DecodeString("1212121212121212123412121212121212121234")
+	require.Nil(t, err)
+	price, quantity := sdk.MustNewDecFromStr(testPrice), sdk.MustNewDecFromStr(testQuantity)
+
+	msg := NewMsgAmendOrder(addr, testOrderID, price, quantity)
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, "order", msg.Route())
+	require.Equal(t, "amend", msg.Type())
+	require.EqualValues(t, addr, msg.GetSigners()[0])
+
+	// amend the price or the quantity only
+	msg = NewMsgAmendOrder(addr, testOrderID, price, sdk.ZeroDec())
+	require.Nil(t, msg.ValidateBasic())
+	msg = NewMsgAmendOrder(addr, testOrderID, sdk.ZeroDec(), quantity)
+	require.Nil(t, msg.ValidateBasic())
+
+	// nothing amended
+	msg = NewMsgAmendOrder(addr, testOrderID, sdk.ZeroDec(), sdk.ZeroDec())
+	require.NotNil(t, msg.ValidateBasic())
+
+	// negative price
+	msg = NewMsgAmendOrder(addr, testOrderID, price.Neg(), quantity)
+	require.NotNil(t, msg.ValidateBasic())
+
+	// empty order id
+	msg = NewMsgAmendOrder(addr, "", price, quantity)
+	require.NotNil(t, msg.ValidateBasic())
+}
@@ -20,6 +20,8 @@ const (
 	Expired
 	PartialFilledCancelled
 	PartialFilledExpired
+	SelfTradePrevented              OrderStatus = 7
+	PartialFilledSelfTradePrevented OrderStatus = 8
 )
 
 func (p OrderStatus) String() string {
@@ -36,6 +38,10 @@ func (p OrderStatus) String() string {
 		return "PartialFilledCancelled"
 	case PartialFilledExpired:
 		return "PartialFilledExpired"
+	case SelfTradePrevented:
+		return "SelfTradePrevented"
+	case PartialFilledSelfTradePrevented:
+		return "PartialFilledSelfTradePrevented"
 	default:
 		return "Unknown"
 	}
@@ -50,6 +56,8 @@ const (
 	OrderStatusPartialFilledCancelled = 4
 	OrderStatusPartialFilledExpired   = 5
 	//OrderStatusPartialFilled          = 6
+	OrderStatusSelfTradePrevented              = 7
+	OrderStatusPartialFilledSelfTradePrevented = 8
 )
 
 // nolint
@@ -77,7 +85,10 @@ type Order struct {
 	Timestamp         int64          `json:"timestamp"`        // created timestamp
 	OrderExpireBlocks int64          `json:"order_expire_blocks"`
 	FeePerBlock       sdk.SysCoin    `json:"fee_per_block"`
-	ExtraInfo         string         `json:"extra_info"` // extra info of order in json format
+	ExtraInfo         string         `json:"extra_info"`         // extra info of order in json format
+	STPMode           string         `json:"stp_mode,omitempty"` // self-trade prevention mode, see STPModeXXX
+	// height of the last amendment moving the order to the back of the queue
+	AmendHeight int64 `json:"amend_height,omitempty"`
 }
 
 // nolint
@@ -216,6 +227,15 @@ func (order *Order) Expire() {
 	}
 }
 
+// nolint
+func (order *Order) PreventSelfTrade() {
+	if order.RemainQuantity.Equal(order.Quantity) {
+		order.Status = OrderStatusSelfTradePrevented
+	} else {
+		order.Status = OrderStatusPartialFilledSelfTradePrevented
+	}
+}
+
 // NeedLockCoins : when place a new order, we should lock the coins of sender
 func (order *Order) NeedLockCoins() sdk.SysCoins {
 	if order.Side == BuyOrder {
@@ -276,3 +296,19 @@ func GetBlockHeightFromOrderID(orderID string) int64 {
 
 	return blockHeight
 }
+
+// IsNewerOrderID returns true if the order of orderID is placed after the order of otherID
+func IsNewerOrderID(orderID, otherID string) bool {
+	var height, num, otherHeight, otherNum int64
+	format := "ID%d-%d"
+	if _, err := fmt.Sscanf(orderID, format, &height, &num); err != nil {
+		log.Println(err)
+	}
+	if _, err := fmt.Sscanf(otherID, format, &otherHeight, &otherNum); err != nil {
+		log.Println(err)
+	}
+	if height != otherHeight {
+		return height > otherHeight
+	}
+	return num > otherNum
+}
@@ -166,3 +166,25 @@ func TestGetBlockHeightFromOrderID(t *testing.T) {
 	num = GetBlockHeightFromOrderID(orderID)
 	require.Equal(t, blockHeight, num)
 }
+
+func TestIsNewerOrderID(t *testing.T) {
+	require.True(t, IsNewerOrderID(FormatOrderID(10, 2), FormatOrderID(10, 1)))
+	require.True(t, IsNewerOrderID(FormatOrderID(11, 1), FormatOrderID(10, 2)))
+	require.False(t, IsNewerOrderID(FormatOrderID(10, 1), FormatOrderID(10, 1)))
+	require.False(t, IsNewerOrderID(FormatOrderID(9, 100), FormatOrderID(10, 1)))
+}
+
+func TestOrderPreventSelfTrade(t *testing.T) {
+	order := MockOrder("", TestTokenPair, BuyOrder, "10.0", "1.0")
+	order.PreventSelfTrade()
+	require.EqualValues(t, OrderStatusSelfTradePrevented, order.Status)
+	require.Equal(t, "SelfTradePrevented", OrderStatus(order.Status).String())
+
+	order = MockOrder("", TestTokenPair, BuyOrder, "10.0", "1.0")
+	order.Fill(sdk.MustNewDecFromStr("10.0"), sdk.MustNewDecFromStr("0.4"))
+	order.PreventSelfTrade()
+	require.EqualValues(t, OrderStatusPartialFilledSelfTradePrevented, order.Status)
+
+	require.NoError(t, ValidateSTPMode(STPModeDecrement))
+	require.Error(t, ValidateSTPMode("cancel"))
+}
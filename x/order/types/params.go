@@ -17,7 +17,8 @@ const (
 	// Fee param
 	DefaultFeeAmountPerBlock     = "0" // okt
 	DefaultFeeDenomPerBlock      = common.NativeToken
-	DefaultFeeRateTrade          = "0.001" // percentage
+	DefaultFeeRateMaker          = "0.001" // percentage
+	DefaultFeeRateTaker          = "0.001" // percentage
 	DefaultNewOrderMsgGasUnit    = 40000
 	DefaultCancelOrderMsgGasUnit = 30000
 )
@@ -27,9 +28,17 @@ var (
 	KeyOrderExpireBlocks     = []byte("OrderExpireBlocks")
 	KeyMaxDealsPerBlock      = []byte("MaxDealsPerBlock")
 	KeyFeePerBlock           = []byte("FeePerBlock")
-	KeyTradeFeeRate          = []byte("TradeFeeRate")
+	KeyMakerFeeRate          = []byte("MakerFeeRate")
+	KeyTakerFeeRate          = []byte("TakerFeeRate")
+	KeyFeeTiers              = []byte("FeeTiers")
 	KeyNewOrderMsgGasUnit    = []byte("NewOrderMsgGasUnit")
 	KeyCancelOrderMsgGasUnit = []byte("CancelOrderMsgGasUnit")
+	KeySTPMode               = []byte("STPMode")
+	KeyPriceBandRate         = []byte("PriceBandRate")
+	KeyCircuitBreakerRate    = []byte("CircuitBreakerRate")
+	KeyCircuitBreakerWindow  = []byte("CircuitBreakerWindow")
+	KeyCircuitBreakerHalt    = []byte("CircuitBreakerHaltBlocks")
+	KeyProductProtections    = []byte("ProductPriceProtections")
 	DefaultFeePerBlock       = sdk.NewDecCoinFromDec(DefaultFeeDenomPerBlock, sdk.MustNewDecFromStr(DefaultFeeAmountPerBlock))
 )
 
@@ -41,9 +50,22 @@ type Params struct {
 	OrderExpireBlocks     int64       `json:"order_expire_blocks"`
 	MaxDealsPerBlock      int64       `json:"max_deals_per_block"`
 	FeePerBlock           sdk.SysCoin `json:"fee_per_block"`
-	TradeFeeRate          sdk.Dec     `json:"trade_fee_rate"`
+	MakerFeeRate          sdk.Dec     `json:"maker_fee_rate"`
+	TakerFeeRate          sdk.Dec     `json:"taker_fee_rate"`
+	FeeTiers              FeeTiers    `json:"fee_tiers"`
 	NewOrderMsgGasUnit    uint64      `json:"new_order_msg_gas_unit"`
 	CancelOrderMsgGasUnit uint64      `json:"cancel_order_msg_gas_unit"`
+	// STPMode is the self-trade prevention mode of the orders placed without one
+	STPMode string `json:"stp_mode"`
+	// PriceBandRate is the max distance rate from the last price to the price of a new order, zero disables it
+	PriceBandRate sdk.Dec `json:"price_band_rate"`
+	// CircuitBreakerRate is the max move rate of the clearing price within CircuitBreakerWindow blocks, the matching
+	// of the product is paused for CircuitBreakerHaltBlocks blocks if it's exceeded. Zero disables it
+	CircuitBreakerRate       sdk.Dec `json:"circuit_breaker_rate"`
+	CircuitBreakerWindow     int64   `json:"circuit_breaker_window"`
+	CircuitBreakerHaltBlocks int64   `json:"circuit_breaker_halt_blocks"`
+	// ProductPriceProtections overrides the four params above for the products listed
+	ProductPriceProtections ProductPriceProtections `json:"product_price_protections"`
 }
 
 // ParamKeyTable for auth module
@@ -56,6 +78,30 @@ func validateParams(value interface{}) error {
 	return nil
 }
 
+func validateSTPMode(value interface{}) error {
+	mode, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+	return ValidateSTPMode(mode)
+}
+
+func validateProductPriceProtections(value interface{}) error {
+	protections, ok := value.(ProductPriceProtections)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+	return protections.Validate()
+}
+
+func validateFeeTiers(value interface{}) error {
+	tiers, ok := value.(FeeTiers)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", value)
+	}
+	return tiers.Validate()
+}
+
 // ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
 // pairs of auth module's parameters.
 // nolint
@@ -64,9 +110,17 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{KeyOrderExpireBlocks, &p.OrderExpireBlocks, common.ValidateInt64Positive("order expire blocks")},
 		{KeyMaxDealsPerBlock, &p.MaxDealsPerBlock, common.ValidateInt64Positive("max deals per block")},
 		{KeyFeePerBlock, &p.FeePerBlock, common.ValidateSysCoin("fee per block")},
-		{KeyTradeFeeRate, &p.TradeFeeRate, common.ValidateRateNotNeg("trade fee rate")},
+		{KeyMakerFeeRate, &p.MakerFeeRate, common.ValidateRateNotNeg("maker fee rate")},
+		{KeyTakerFeeRate, &p.TakerFeeRate, common.ValidateRateNotNeg("taker fee rate")},
+		{KeyFeeTiers, &p.FeeTiers, validateFeeTiers},
 		{KeyNewOrderMsgGasUnit, &p.NewOrderMsgGasUnit, common.ValidateUint64Positive("new order msg gas unit")},
 		{KeyCancelOrderMsgGasUnit, &p.CancelOrderMsgGasUnit, common.ValidateUint64Positive("cancel order msg gas unit")},
+		{KeySTPMode, &p.STPMode, validateSTPMode},
+		{KeyPriceBandRate, &p.PriceBandRate, common.ValidateRateNotNeg("price band rate")},
+		{KeyCircuitBreakerRate, &p.CircuitBreakerRate, common.ValidateRateNotNeg("circuit breaker rate")},
+		{KeyCircuitBreakerWindow, &p.CircuitBreakerWindow, common.ValidateInt64Positive("circuit breaker window")},
+		{KeyCircuitBreakerHalt, &p.CircuitBreakerHaltBlocks, common.ValidateInt64Positive("circuit breaker halt blocks")},
+		{KeyProductProtections, &p.ProductPriceProtections, validateProductPriceProtections},
 	}
 }
 
@@ -76,9 +130,16 @@ func DefaultParams() Params {
 		OrderExpireBlocks:     DefaultOrderExpireBlocks,
 		MaxDealsPerBlock:      DefaultMaxDealsPerBlock,
 		FeePerBlock:           DefaultFeePerBlock,
-		TradeFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTrade),
+		MakerFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateMaker),
+		TakerFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTaker),
 		NewOrderMsgGasUnit:    DefaultNewOrderMsgGasUnit,
 		CancelOrderMsgGasUnit: DefaultCancelOrderMsgGasUnit,
+		STPMode:               DefaultSTPMode,
+
+		PriceBandRate:            sdk.MustNewDecFromStr(DefaultPriceBandRate),
+		CircuitBreakerRate:       sdk.MustNewDecFromStr(DefaultCircuitBreakerRate),
+		CircuitBreakerWindow:     DefaultCircuitBreakerWindow,
+		CircuitBreakerHaltBlocks: DefaultCircuitBreakerHaltBlocks,
 	}
 }
 
@@ -88,9 +149,35 @@ func (p Params) String() string {
   OrderExpireBlocks: %d
   MaxDealsPerBlock: %d
   FeePerBlock: %s
-  TradeFeeRate: %s
+  MakerFeeRate: %s
+  TakerFeeRate: %s
+  FeeTiers: %s
   NewOrderMsgGasUnit: %d
-  CancelOrderMsgGasUnit: %d`, p.OrderExpireBlocks,
+  CancelOrderMsgGasUnit: %d
+  STPMode: %s
+  PriceBandRate: %s
+  CircuitBreakerRate: %s
+  CircuitBreakerWindow: %d
+  CircuitBreakerHaltBlocks: %d
+  ProductPriceProtections: %s`, p.OrderExpireBlocks,
 		p.MaxDealsPerBlock, p.FeePerBlock,
-		p.TradeFeeRate, p.NewOrderMsgGasUnit, p.CancelOrderMsgGasUnit)
+		p.MakerFeeRate, p.TakerFeeRate, p.FeeTiers, p.NewOrderMsgGasUnit, p.CancelOrderMsgGasUnit, p.STPMode,
+		p.PriceBandRate, p.CircuitBreakerRate, p.CircuitBreakerWindow, p.CircuitBreakerHaltBlocks,
+		p.ProductPriceProtections)
+}
+
+// GetPriceProtection returns the price protection params of the product, which are the params above unless they're
+// overridden for the product
+func (p Params) GetPriceProtection(product string) PriceProtection {
+	for _, protection := range p.ProductPriceProtections {
+		if protection.Product == product {
+			return protection.PriceProtection
+		}
+	}
+	return PriceProtection{
+		PriceBandRate:            p.PriceBandRate,
+		CircuitBreakerRate:       p.CircuitBreakerRate,
+		CircuitBreakerWindow:     p.CircuitBreakerWindow,
+		CircuitBreakerHaltBlocks: p.CircuitBreakerHaltBlocks,
+	}
 }
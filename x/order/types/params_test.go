@@ -15,9 +15,23 @@ func TestParamSetPairs(t *testing.T) {
 			OrderExpireBlocks:     1000,
 			MaxDealsPerBlock:      10000,
 			FeePerBlock:           sdk.NewDecCoinFromDec(DefaultFeeDenomPerBlock, sdk.MustNewDecFromStr("0.000001")),
-			TradeFeeRate:          sdk.MustNewDecFromStr("0.001"),
+			MakerFeeRate:          sdk.MustNewDecFromStr("0.0005"),
+			TakerFeeRate:          sdk.MustNewDecFromStr("0.001"),
+			FeeTiers:              FeeTiers{{MinVolume: sdk.NewDec(1000), Discount: sdk.NewDecWithPrec(1, 1)}},
 			NewOrderMsgGasUnit:    123,
 			CancelOrderMsgGasUnit: 456,
+			STPMode:               STPModeDecrement,
+
+			PriceBandRate:            sdk.MustNewDecFromStr("0.1"),
+			CircuitBreakerRate:       sdk.MustNewDecFromStr("0.2"),
+			CircuitBreakerWindow:     50,
+			CircuitBreakerHaltBlocks: 30,
+			ProductPriceProtections: ProductPriceProtections{{Product: TestTokenPair, PriceProtection: PriceProtection{
+				PriceBandRate:            sdk.MustNewDecFromStr("0.2"),
+				CircuitBreakerRate:       sdk.ZeroDec(),
+				CircuitBreakerWindow:     10,
+				CircuitBreakerHaltBlocks: 10,
+			}}},
 		},
 	}
 
@@ -33,14 +47,32 @@ func TestParamSetPairs(t *testing.T) {
 				if !v.Value.(*sdk.SysCoin).IsEqual(test.FeePerBlock) {
 					t.Errorf("key(%s) -> %x, want %x", v.Key, test.FeePerBlock, v.Value)
 				}
-			case string(KeyTradeFeeRate):
-				if !v.Value.(*sdk.Dec).Equal(test.TradeFeeRate) {
-					t.Errorf("key(%s) -> %x, want %x", v.Key, test.TradeFeeRate, v.Value)
+			case string(KeyMakerFeeRate):
+				if !v.Value.(*sdk.Dec).Equal(test.MakerFeeRate) {
+					t.Errorf("key(%s) -> %x, want %x", v.Key, test.MakerFeeRate, v.Value)
+				}
+			case string(KeyTakerFeeRate):
+				if !v.Value.(*sdk.Dec).Equal(test.TakerFeeRate) {
+					t.Errorf("key(%s) -> %x, want %x", v.Key, test.TakerFeeRate, v.Value)
 				}
+			case string(KeyFeeTiers):
+				require.EqualValues(t, test.FeeTiers, *(v.Value.(*FeeTiers)))
 			case string(KeyNewOrderMsgGasUnit):
 				require.EqualValues(t, test.NewOrderMsgGasUnit, *(v.Value.(*uint64)))
 			case string(KeyCancelOrderMsgGasUnit):
 				require.EqualValues(t, test.CancelOrderMsgGasUnit, *(v.Value.(*uint64)))
+			case string(KeySTPMode):
+				require.EqualValues(t, test.STPMode, *(v.Value.(*string)))
+			case string(KeyPriceBandRate):
+				require.EqualValues(t, test.PriceBandRate, *(v.Value.(*sdk.Dec)))
+			case string(KeyCircuitBreakerRate):
+				require.EqualValues(t, test.CircuitBreakerRate, *(v.Value.(*sdk.Dec)))
+			case string(KeyCircuitBreakerWindow):
+				require.EqualValues(t, test.CircuitBreakerWindow, *(v.Value.(*int64)))
+			case string(KeyCircuitBreakerHalt):
+				require.EqualValues(t, test.CircuitBreakerHaltBlocks, *(v.Value.(*int64)))
+			case string(KeyProductProtections):
+				require.EqualValues(t, test.ProductPriceProtections, *(v.Value.(*ProductPriceProtections)))
 			}
 		}
 	}
@@ -52,8 +84,37 @@ func TestParamsString(t *testing.T) {
   OrderExpireBlocks: 259200
   MaxDealsPerBlock: 1000
   FeePerBlock: 0.000000000000000000` + common.NativeToken + `
-  TradeFeeRate: 0.001000000000000000
+  MakerFeeRate: 0.001000000000000000
+  TakerFeeRate: 0.001000000000000000
+  FeeTiers: []
   NewOrderMsgGasUnit: 40000
-  CancelOrderMsgGasUnit: 30000`
+  CancelOrderMsgGasUnit: 30000
+  STPMode: none
+  PriceBandRate: 0.000000000000000000
+  CircuitBreakerRate: 0.000000000000000000
+  CircuitBreakerWindow: 100
+  CircuitBreakerHaltBlocks: 100
+  ProductPriceProtections: []`
 	require.EqualValues(t, expectString, param.String())
 }
+
+func TestGetPriceProtection(t *testing.T) {
+	params := DefaultParams()
+	protection := params.GetPriceProtection(TestTokenPair)
+	require.EqualValues(t, params.PriceBandRate, protection.PriceBandRate)
+	require.EqualValues(t, params.CircuitBreakerHaltBlocks, protection.CircuitBreakerHaltBlocks)
+
+	protection.PriceBandRate = sdk.MustNewDecFromStr("0.1")
+	params.ProductPriceProtections = ProductPriceProtections{{Product: TestTokenPair, PriceProtection: protection}}
+	require.Nil(t, params.ProductPriceProtections.Validate())
+	require.EqualValues(t, protection, params.GetPriceProtection(TestTokenPair))
+	require.EqualValues(t, params.PriceBandRate, params.GetPriceProtection("btc-000_okt").PriceBandRate)
+
+	// duplicated product
+	params.ProductPriceProtections = append(params.ProductPriceProtections, params.ProductPriceProtections[0])
+	require.NotNil(t, params.ProductPriceProtections.Validate())
+
+	protection.CircuitBreakerWindow = 0
+	params.ProductPriceProtections = ProductPriceProtections{{Product: TestTokenPair, PriceProtection: protection}}
+	require.NotNil(t, params.ProductPriceProtections.Validate())
+}
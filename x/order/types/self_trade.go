@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+)
+
+// Self-trade prevention modes, which decide what to do when a buy order and a sell order of the same sender would be
+// filled against each other at the clearing price
+const (
+	// STPModeNone fills the orders as usual
+	STPModeNone = "none"
+	// STPModeCancelNewest cancels the newer order
+	STPModeCancelNewest = "cancel_newest"
+	// STPModeCancelOldest cancels the older order
+	STPModeCancelOldest = "cancel_oldest"
+	// STPModeCancelBoth cancels both the orders
+	STPModeCancelBoth = "cancel_both"
+	// STPModeDecrement decreases both the orders by the smaller remaining quantity, and cancels the order left empty
+	STPModeDecrement = "decrement"
+
+	DefaultSTPMode = STPModeNone
+)
+
+// nolint
+const (
+	EventTypeSelfTradePrevented = "self_trade_prevented"
+
+	AttributeKeyOrderID  = "order_id"
+	AttributeKeySender   = "sender"
+	AttributeKeyProduct  = "product"
+	AttributeKeySTPMode  = "stp_mode"
+	AttributeKeyQuantity = "quantity"
+)
+
+// ValidateSTPMode returns an error if the mode isn't a self-trade prevention mode
+func ValidateSTPMode(mode string) error {
+	switch mode {
+	case STPModeNone, STPModeCancelNewest, STPModeCancelOldest, STPModeCancelBoth, STPModeDecrement:
+		return nil
+	default:
+		return fmt.Errorf("invalid self-trade prevention mode: %s", mode)
+	}
+}
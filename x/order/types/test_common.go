@@ -11,9 +11,16 @@ func DefaultTestParams() Params {
 		OrderExpireBlocks:     DefaultOrderExpireBlocks,
 		MaxDealsPerBlock:      DefaultMaxDealsPerBlock,
 		FeePerBlock:           DefaultTestFeePerBlock,
-		TradeFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTrade),
+		MakerFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateMaker),
+		TakerFeeRate:          sdk.MustNewDecFromStr(DefaultFeeRateTaker),
 		NewOrderMsgGasUnit:    1,
 		CancelOrderMsgGasUnit: 1,
+		STPMode:               DefaultSTPMode,
+
+		PriceBandRate:            sdk.ZeroDec(),
+		CircuitBreakerRate:       sdk.ZeroDec(),
+		CircuitBreakerWindow:     DefaultCircuitBreakerWindow,
+		CircuitBreakerHaltBlocks: DefaultCircuitBreakerHaltBlocks,
 	}
 }
 
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TradingGrant authorizes the grantee to place and cancel orders on behalf of the granter. It carries no right to
+// transfer or withdraw the coins of the granter
+type TradingGrant struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	// Products restricts the grant to the products, it's unrestricted if empty
+	Products   []string  `json:"products"`
+	Expiration time.Time `json:"expiration"`
+	// MaxNotional is the max value of all the orders placed by the grantee in the native token, it's unlimited if
+	// zero. SpentNotional is the value placed already, which is only counted if MaxNotional is set
+	MaxNotional   sdk.Dec `json:"max_notional"`
+	SpentNotional sdk.Dec `json:"spent_notional"`
+}
+
+// NewTradingGrant creates a new instance of TradingGrant, the max notional is unlimited if it's nil or zero
+func NewTradingGrant(granter, grantee sdk.AccAddress, products []string, expiration time.Time,
+	maxNotional sdk.Dec) TradingGrant {
+	if maxNotional.IsNil() {
+		maxNotional = sdk.ZeroDec()
+	}
+	return TradingGrant{
+		Granter:       granter,
+		Grantee:       grantee,
+		Products:      products,
+		Expiration:    expiration,
+		MaxNotional:   maxNotional,
+		SpentNotional: sdk.ZeroDec(),
+	}
+}
+
+// IsExpired returns true if the grant is expired at the time
+func (g TradingGrant) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(g.Expiration)
+}
+
+// AllowProduct returns true if the grantee is allowed to trade the product
+func (g TradingGrant) AllowProduct(product string) bool {
+	if len(g.Products) == 0 {
+		return true
+	}
+	for _, p := range g.Products {
+		if p == product {
+			return true
+		}
+	}
+	return false
+}
+
+// HasMaxNotional returns true if the value of the orders placed by the grantee is limited by MaxNotional
+func (g TradingGrant) HasMaxNotional() bool {
+	return !g.MaxNotional.IsNil() && g.MaxNotional.IsPositive()
+}
+
+// RemainingNotional returns the value of the orders the grantee is allowed to place still, it's only meaningful if
+// the grant has the max notional
+func (g TradingGrant) RemainingNotional() sdk.Dec {
+	return g.MaxNotional.Sub(g.SpentNotional)
+}
+
+// String implements the stringer interface
+func (g TradingGrant) String() string {
+	products := "all"
+	if len(g.Products) > 0 {
+		products = strings.Join(g.Products, ",")
+	}
+	maxNotional := "unlimited"
+	if g.HasMaxNotional() {
+		maxNotional = g.MaxNotional.String()
+	}
+	return fmt.Sprintf(`Trading Grant:
+  Granter: %s
+  Grantee: %s
+  Products: %s
+  Expiration: %s
+  MaxNotional: %s
+  SpentNotional: %s`, g.Granter, g.Grantee, products, g.Expiration, maxNotional, g.SpentNotional)
+}
+
+// TradingGrants is a slice of TradingGrant
+type TradingGrants []TradingGrant
+
+// String implements the stringer interface
+func (gs TradingGrants) String() string {
+	items := make([]string, len(gs))
+	for i, g := range gs {
+		items[i] = g.String()
+	}
+	return strings.Join(items, "\n")
+}
@@ -0,0 +1,12 @@
+package types
+
+// nolint
+const (
+	EventTypeTradingRuleApplied = "trading_rule_applied"
+
+	AttributeKeyMaxPriceDigit  = "max_price_digit"
+	AttributeKeyMaxSizeDigit   = "max_size_digit"
+	AttributeKeyMinTradeSize   = "min_trade_size"
+	AttributeKeyPolicy         = "policy"
+	AttributeKeyCanceledOrders = "canceled_orders"
+)
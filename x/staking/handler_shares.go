@@ -204,6 +204,7 @@ func handleMsgAddShares(ctx sdk.Context, msg types.MsgAddShares, k keeper.Keeper
 	delegator.ValidatorAddresses = getValsAddrs(vals)
 	delegator.Shares = shares
 	k.SetDelegator(ctx, delegator)
+	k.OnStakingAddShares(ctx, msg.DelAddr, delegator.ValidatorAddresses, shares)
 
 	ctx.EventManager().EmitEvent(buildEventForHandlerAddShares(delegator))
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
@@ -267,6 +268,7 @@ func handleMsgDeposit(ctx sdk.Context, msg types.MsgDeposit, k keeper.Keeper) (*
 	if err != nil {
 		return nil, err
 	}
+	k.OnStakingDeposit(ctx, msg.DelegatorAddress, msg.Amount)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -287,6 +289,7 @@ func handleMsgWithdraw(ctx sdk.Context, msg types.MsgWithdraw, k keeper.Keeper)
 	if err != nil {
 		return nil, err
 	}
+	k.OnStakingWithdraw(ctx, msg.DelegatorAddress, msg.Amount)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
@@ -49,3 +49,29 @@ func (k Keeper) AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddres
 		k.hooks.AfterValidatorDestroyed(ctx, consAddr, valAddr)
 	}
 }
+
+// SetObserverKeeper adds a keeper notified of the delegator operations
+func (k *Keeper) SetObserverKeeper(ok types.ObserverKeeper) {
+	k.observerKeepers = append(k.observerKeepers, ok)
+}
+
+// OnStakingDeposit - notify the observers of the deposit
+func (k Keeper) OnStakingDeposit(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.SysCoin) {
+	for _, observer := range k.observerKeepers {
+		observer.OnStakingDeposit(ctx, delAddr, amount)
+	}
+}
+
+// OnStakingWithdraw - notify the observers of the withdrawal
+func (k Keeper) OnStakingWithdraw(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.SysCoin) {
+	for _, observer := range k.observerKeepers {
+		observer.OnStakingWithdraw(ctx, delAddr, amount)
+	}
+}
+
+// OnStakingAddShares - notify the observers of the shares added
+func (k Keeper) OnStakingAddShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, shares sdk.Dec) {
+	for _, observer := range k.observerKeepers {
+		observer.OnStakingAddShares(ctx, delAddr, valAddrs, shares)
+	}
+}
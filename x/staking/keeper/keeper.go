@@ -25,6 +25,7 @@ type Keeper struct {
 	cdc                *codec.Codec
 	supplyKeeper       types.SupplyKeeper
 	hooks              types.StakingHooks
+	observerKeepers    []types.ObserverKeeper
 	paramstore         params.Subspace
 	validatorCache     map[string]cachedValidator
 	validatorCacheList *list.List
@@ -87,3 +87,11 @@ type StakingHooks interface {
 	// Must be called when a validator is destroyed by tx
 	AfterValidatorDestroyed(ctx sdk.Context, consAddr sdk.ConsAddress, valAddr sdk.ValAddress)
 }
+
+// ObserverKeeper is notified of the deposits, the withdrawals and the shares added by the delegators (noalias)
+type ObserverKeeper interface {
+	OnStakingDeposit(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.SysCoin)
+	OnStakingWithdraw(ctx sdk.Context, delAddr sdk.AccAddress, amount sdk.SysCoin)
+	// OnStakingAddShares is called with the validators and the shares added to each of them
+	OnStakingAddShares(ctx sdk.Context, delAddr sdk.AccAddress, valAddrs []sdk.ValAddress, shares sdk.Dec)
+}
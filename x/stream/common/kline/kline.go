@@ -1,6 +1,7 @@
 package kline
 
 import (
+	"encoding/json"
 	"sync"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -60,6 +61,30 @@ func NewKlineData() *KlineData {
 	}
 }
 
+// klineDataJSON is the json of KlineData, whose data fields are unexported
+type klineDataJSON struct {
+	Height        int64                  `json:"height"`
+	MatchResults  []*backend.MatchResult `json:"match_results"`
+	NewTokenPairs []*dex.TokenPair       `json:"new_token_pairs"`
+}
+
+func (kd KlineData) MarshalJSON() ([]byte, error) {
+	return json.Marshal(klineDataJSON{
+		Height:        kd.Height,
+		MatchResults:  kd.matchResults,
+		NewTokenPairs: kd.newTokenPairs,
+	})
+}
+
+func (kd *KlineData) UnmarshalJSON(bz []byte) error {
+	var data klineDataJSON
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return err
+	}
+	kd.Height, kd.matchResults, kd.newTokenPairs = data.Height, data.MatchResults, data.NewTokenPairs
+	return nil
+}
+
 func (kd KlineData) BlockHeight() int64 {
 	return kd.Height
 }
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/okex/okexchain/x/ammswap"
@@ -16,6 +18,33 @@ type Cache struct {
 	updatedAccAddress map[string]struct{}
 	swapInfos         []*backend.SwapInfo
 	newSwapTokenPairs []*ammswap.SwapTokenPair
+	liquidityInfos    []*SwapLiquidityInfo
+	updatedSwapPairs  map[string]struct{}
+	updatedFarmPools  map[string]struct{}
+	updatedFarmLocks  map[FarmLockKey]struct{}
+}
+
+// SwapLiquidityInfo is a liquidity change of a swap token pair
+type SwapLiquidityInfo struct {
+	Address       string `json:"address"`
+	TokenPairName string `json:"swap_pair"`
+	Type          string `json:"type"`
+	BaseAmount    string `json:"base_amount"`
+	QuoteAmount   string `json:"quote_amount"`
+	Liquidity     string `json:"liquidity"`
+	Timestamp     int64  `json:"timestamp"`
+}
+
+// types of the liquidity changes
+const (
+	LiquidityTypeAdd    = "add"
+	LiquidityTypeRemove = "remove"
+)
+
+// FarmLockKey is the key of the lock of an address in a farm pool
+type FarmLockKey struct {
+	PoolName string
+	Address  string
 }
 
 func NewCache() *Cache {
@@ -26,6 +55,10 @@ func NewCache() *Cache {
 		updatedAccAddress: make(map[string]struct{}),
 		swapInfos:         make([]*backend.SwapInfo, 0, 2000),
 		newSwapTokenPairs: make([]*ammswap.SwapTokenPair, 0, 2000),
+		liquidityInfos:    make([]*SwapLiquidityInfo, 0, 2000),
+		updatedSwapPairs:  make(map[string]struct{}),
+		updatedFarmPools:  make(map[string]struct{}),
+		updatedFarmLocks:  make(map[FarmLockKey]struct{}),
 	}
 }
 
@@ -37,6 +70,10 @@ func (c *Cache) Reset() {
 	c.updatedAccAddress = make(map[string]struct{})
 	c.swapInfos = make([]*backend.SwapInfo, 0, 2000)
 	c.newSwapTokenPairs = make([]*ammswap.SwapTokenPair, 0, 2000)
+	c.liquidityInfos = make([]*SwapLiquidityInfo, 0, 2000)
+	c.updatedSwapPairs = make(map[string]struct{})
+	c.updatedFarmPools = make(map[string]struct{})
+	c.updatedFarmLocks = make(map[FarmLockKey]struct{})
 }
 
 func (c *Cache) AddTransaction(transaction *backend.Transaction) {
@@ -100,3 +137,60 @@ func (c *Cache) AddNewSwapTokenPair(swapTokenPair *ammswap.SwapTokenPair) {
 func (c *Cache) GetNewSwapTokenPairs() []*ammswap.SwapTokenPair {
 	return c.newSwapTokenPairs
 }
+
+// AddSwapLiquidityInfo appends liquidityInfo to cache liquidityInfos
+func (c *Cache) AddSwapLiquidityInfo(liquidityInfo *SwapLiquidityInfo) {
+	c.liquidityInfos = append(c.liquidityInfos, liquidityInfo)
+}
+
+// GetSwapLiquidityInfos returns the liquidity changes in the block
+func (c *Cache) GetSwapLiquidityInfos() []*SwapLiquidityInfo {
+	return c.liquidityInfos
+}
+
+// AddUpdatedSwapPair marks the reserves of the swap token pair updated
+func (c *Cache) AddUpdatedSwapPair(tokenPairName string) {
+	c.updatedSwapPairs[tokenPairName] = struct{}{}
+}
+
+// GetUpdatedSwapPairs returns the names of the swap token pairs whose reserves are updated in the block
+func (c *Cache) GetUpdatedSwapPairs() (tokenPairNames []string) {
+	for name := range c.updatedSwapPairs {
+		tokenPairNames = append(tokenPairNames, name)
+	}
+	sort.Strings(tokenPairNames)
+	return tokenPairNames
+}
+
+// AddUpdatedFarmPool marks the farm pool updated
+func (c *Cache) AddUpdatedFarmPool(poolName string) {
+	c.updatedFarmPools[poolName] = struct{}{}
+}
+
+// GetUpdatedFarmPools returns the names of the farm pools updated in the block
+func (c *Cache) GetUpdatedFarmPools() (poolNames []string) {
+	for name := range c.updatedFarmPools {
+		poolNames = append(poolNames, name)
+	}
+	sort.Strings(poolNames)
+	return poolNames
+}
+
+// AddUpdatedFarmLock marks the lock of the address in the farm pool updated
+func (c *Cache) AddUpdatedFarmLock(poolName string, address sdk.AccAddress) {
+	c.updatedFarmLocks[FarmLockKey{PoolName: poolName, Address: address.String()}] = struct{}{}
+}
+
+// GetUpdatedFarmLocks returns the locks updated in the block
+func (c *Cache) GetUpdatedFarmLocks() (locks []FarmLockKey) {
+	for key := range c.updatedFarmLocks {
+		locks = append(locks, key)
+	}
+	sort.Slice(locks, func(i, j int) bool {
+		if locks[i].PoolName != locks[j].PoolName {
+			return locks[i].PoolName < locks[j].PoolName
+		}
+		return locks[i].Address < locks[j].Address
+	})
+	return locks
+}
@@ -125,7 +125,7 @@ func createStreamTaskWithData(ctx sdk.Context, s *Stream) *TaskWithData {
 		case EngineWebSocketKind:
 			websocket.InitialCache(ctx, s.orderKeeper, s.dexKeeper, s.logger)
 			wsdata := websocket.NewPushData()
-			wsdata.SetData(ctx, s.orderKeeper, s.tokenKeeper, s.dexKeeper, s.swapKeeper, s.Cache)
+			wsdata.SetData(ctx, s.orderKeeper, s.tokenKeeper, s.dexKeeper, s.swapKeeper, s.farmKeeper, s.Cache)
 			data = wsdata
 		}
 
@@ -3,10 +3,12 @@ package stream
 import (
 	"fmt"
 	"github.com/okex/okexchain/x/stream/common/kline"
+	"github.com/okex/okexchain/x/stream/filesink"
 	"github.com/okex/okexchain/x/stream/kafkaclient"
 	"strings"
 	"time"
 
+	"github.com/okex/okexchain/x/stream/webhook"
 	"github.com/okex/okexchain/x/stream/websocket"
 
 	"github.com/pkg/errors"
@@ -35,6 +37,14 @@ const (
 	StreamPulsarKind    Kind = 0x03
 	StreamWebSocketKind Kind = 0x04
 	StreamKafkaKind     Kind = 0x05
+	// the file kinds of the engines, each engine writes its own segments
+	StreamFileAnalysisKind Kind = 0x06
+	StreamFileNotifyKind   Kind = 0x07
+	StreamFileKlineKind    Kind = 0x08
+	// the webhook kinds of the engines
+	StreamWebhookAnalysisKind Kind = 0x09
+	StreamWebhookNotifyKind   Kind = 0x0a
+	StreamWebhookKlineKind    Kind = 0x0b
 
 	EngineNilKind       EngineKind = 0x00
 	EngineAnalysisKind  EngineKind = 0x01
@@ -49,6 +59,14 @@ var StreamKind2EngineKindMap = map[Kind]EngineKind{
 	StreamPulsarKind:    EngineKlineKind,
 	StreamKafkaKind:     EngineKlineKind,
 	StreamWebSocketKind: EngineWebSocketKind,
+
+	StreamFileAnalysisKind: EngineAnalysisKind,
+	StreamFileNotifyKind:   EngineNotifyKind,
+	StreamFileKlineKind:    EngineKlineKind,
+
+	StreamWebhookAnalysisKind: EngineAnalysisKind,
+	StreamWebhookNotifyKind:   EngineNotifyKind,
+	StreamWebhookKlineKind:    EngineKlineKind,
 }
 
 var EngineKind2StreamKindMap = map[EngineKind]Kind{
@@ -252,11 +270,17 @@ type EngineCreator func(url string, logger log.Logger, cfg *appCfg.StreamConfig)
 
 func GetEngineCreator(eKind EngineKind, sKind Kind) (EngineCreator, error) {
 	m := map[string]EngineCreator{
-		fmt.Sprintf("%d_%d", EngineAnalysisKind, StreamMysqlKind):      NewMySQLEngine,
-		fmt.Sprintf("%d_%d", EngineNotifyKind, StreamRedisKind):        NewRedisEngine,
-		fmt.Sprintf("%d_%d", EngineKlineKind, StreamPulsarKind):        NewPulsarEngine,
-		fmt.Sprintf("%d_%d", EngineWebSocketKind, StreamWebSocketKind): websocket.NewEngine,
-		fmt.Sprintf("%d_%d", EngineKlineKind, StreamKafkaKind):         NewKafkaEngine,
+		fmt.Sprintf("%d_%d", EngineAnalysisKind, StreamMysqlKind):           NewMySQLEngine,
+		fmt.Sprintf("%d_%d", EngineNotifyKind, StreamRedisKind):             NewRedisEngine,
+		fmt.Sprintf("%d_%d", EngineKlineKind, StreamPulsarKind):             NewPulsarEngine,
+		fmt.Sprintf("%d_%d", EngineWebSocketKind, StreamWebSocketKind):      websocket.NewEngine,
+		fmt.Sprintf("%d_%d", EngineKlineKind, StreamKafkaKind):              NewKafkaEngine,
+		fmt.Sprintf("%d_%d", EngineAnalysisKind, StreamFileAnalysisKind):    filesink.NewEngine,
+		fmt.Sprintf("%d_%d", EngineNotifyKind, StreamFileNotifyKind):        filesink.NewEngine,
+		fmt.Sprintf("%d_%d", EngineKlineKind, StreamFileKlineKind):          filesink.NewEngine,
+		fmt.Sprintf("%d_%d", EngineAnalysisKind, StreamWebhookAnalysisKind): webhook.NewEngineCreator(types.StreamDataAnalysisKind),
+		fmt.Sprintf("%d_%d", EngineNotifyKind, StreamWebhookNotifyKind):     webhook.NewEngineCreator(types.StreamDataNotifyKind),
+		fmt.Sprintf("%d_%d", EngineKlineKind, StreamWebhookKlineKind):       webhook.NewEngineCreator(types.StreamDataKlineKind),
 	}
 
 	key := fmt.Sprintf("%d_%d", eKind, sKind)
@@ -278,6 +302,8 @@ func ParseStreamEngineConfig(logger log.Logger, cfg *appCfg.StreamConfig) (map[E
 
 		// Desktop Stream Engine Mode: mysql | websocket
 		// HA Stream Engine Mode: mysql | redis | pulsar(kafka)
+		// Offline Stream Engine Mode: file, e.g. analysis|file|/data/stream?format=json
+		// Webhook Stream Engine Mode: webhook, e.g. notify|webhook|https://example.com/hook;secret=xxx
 
 		if len(enginesConf) != 3 {
 			return nil, fmt.Errorf("expected list in a form of \"engine_type:stream_type:stream_url\" pairs, given pair %s, list %s", item, list)
@@ -285,6 +311,14 @@ func ParseStreamEngineConfig(logger log.Logger, cfg *appCfg.StreamConfig) (map[E
 
 		engineType := StringToEngineKind(enginesConf[0])
 		streamType := StringToStreamKind(enginesConf[1])
+		switch strings.ToLower(enginesConf[1]) {
+		case "file":
+			streamType = FileStreamKind(engineType)
+			EngineKind2StreamKindMap[engineType] = streamType
+		case "webhook":
+			streamType = WebhookStreamKind(engineType)
+			EngineKind2StreamKindMap[engineType] = streamType
+		}
 		streamURL := enginesConf[2]
 
 		creatorFunc, err := GetEngineCreator(engineType, streamType)
@@ -318,6 +352,34 @@ func StringToEngineKind(kind string) EngineKind {
 	}
 }
 
+// FileStreamKind returns the file stream kind of the engine kind
+func FileStreamKind(kind EngineKind) Kind {
+	switch kind {
+	case EngineAnalysisKind:
+		return StreamFileAnalysisKind
+	case EngineNotifyKind:
+		return StreamFileNotifyKind
+	case EngineKlineKind:
+		return StreamFileKlineKind
+	default:
+		return StreamNilKind
+	}
+}
+
+// WebhookStreamKind returns the webhook stream kind of the engine kind
+func WebhookStreamKind(kind EngineKind) Kind {
+	switch kind {
+	case EngineAnalysisKind:
+		return StreamWebhookAnalysisKind
+	case EngineNotifyKind:
+		return StreamWebhookNotifyKind
+	case EngineKlineKind:
+		return StreamWebhookKlineKind
+	default:
+		return StreamNilKind
+	}
+}
+
 func StringToStreamKind(kind string) Kind {
 	kind = strings.ToLower(kind)
 	switch kind {
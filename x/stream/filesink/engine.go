@@ -0,0 +1,143 @@
+package filesink
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+
+	appCfg "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/okex/okexchain/x/stream/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// the segments of the stream data of a kind are prefixed by the name of the kind
+var dataKindPrefixes = map[types.StreamDataKind]string{
+	types.StreamDataAnalysisKind:  "analysis",
+	types.StreamDataNotifyKind:    "notify",
+	types.StreamDataKlineKind:     "kline",
+	types.StreamDataWebSocketKind: "websocket",
+}
+
+// DataKindPrefix returns the prefix of the segments of the stream data kind
+func DataKindPrefix(kind types.StreamDataKind) string {
+	if prefix, ok := dataKindPrefixes[kind]; ok {
+		return prefix
+	}
+	return fmt.Sprintf("kind%d", kind)
+}
+
+// Engine writes the stream data to the segment files in a dir
+type Engine struct {
+	url    string
+	dir    string
+	opts   Options
+	logger log.Logger
+
+	mtx     sync.Mutex
+	writers map[types.StreamDataKind]*Writer
+}
+
+// NewEngine creates the file engine by the url, which is the dir of the segments with the optional query of
+// format(json|lpjson), max_segment_bytes and max_segment_heights, e.g. /data/stream?format=lpjson
+func NewEngine(engineURL string, logger log.Logger, cfg *appCfg.StreamConfig) (types.IStreamEngine, error) {
+	dir, opts, err := ParseURL(engineURL)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	logger.Info(fmt.Sprintf("file engine writes segments to %s", dir))
+	return &Engine{
+		url:     engineURL,
+		dir:     dir,
+		opts:    opts,
+		logger:  logger,
+		writers: make(map[types.StreamDataKind]*Writer),
+	}, nil
+}
+
+// ParseURL returns the dir and the options of the file engine url
+func ParseURL(engineURL string) (dir string, opts Options, err error) {
+	u, err := url.Parse(engineURL)
+	if err != nil {
+		return "", opts, err
+	}
+	if u.Scheme != "" && u.Scheme != "file" {
+		return "", opts, fmt.Errorf("unsupported scheme %s of file engine url %s", u.Scheme, engineURL)
+	}
+	dir = u.Path
+	if dir == "" {
+		return "", opts, fmt.Errorf("no dir in file engine url %s", engineURL)
+	}
+
+	opts = DefaultOptions()
+	query := u.Query()
+	if opts.Format, err = StringToFormat(query.Get("format")); err != nil {
+		return "", opts, err
+	}
+	if v := query.Get("max_segment_bytes"); v != "" {
+		if opts.MaxSegmentBytes, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return "", opts, fmt.Errorf("invalid max_segment_bytes %s", v)
+		}
+	}
+	if v := query.Get("max_segment_heights"); v != "" {
+		if opts.MaxSegmentHeights, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return "", opts, fmt.Errorf("invalid max_segment_heights %s", v)
+		}
+	}
+	return dir, opts, nil
+}
+
+func (e *Engine) URL() string {
+	return e.url
+}
+
+// Write appends the data to the segments of its kind, success is set once the record is synced to the disk. The data
+// of a height written already, e.g. by the task rerun after a restart, is considered success
+func (e *Engine) Write(data types.IStreamData, success *bool) {
+	e.logger.Debug("Entering FileEngine Write")
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	w, err := e.getWriter(data.DataType())
+	if err != nil {
+		e.logger.Error(fmt.Sprintf("file engine open segments failed: %s", err.Error()))
+		*success = false
+		return
+	}
+	if data.BlockHeight() <= w.LastHeight() {
+		e.logger.Info(fmt.Sprintf("file engine skips the data of height %d, which is written already", data.BlockHeight()))
+		*success = true
+		return
+	}
+
+	bz, err := json.Marshal(data)
+	if err != nil {
+		e.logger.Error(fmt.Sprintf("file engine marshal data failed: %s", err.Error()))
+		*success = false
+		return
+	}
+	if err = w.Append(Record{Height: data.BlockHeight(), Kind: data.DataType(), Data: bz}); err != nil {
+		e.logger.Error(fmt.Sprintf("file engine write failed: %s", err.Error()))
+		*success = false
+		return
+	}
+	e.logger.Debug(fmt.Sprintf("FileEngine write result: height %d, %d bytes", data.BlockHeight(), len(bz)))
+	*success = true
+}
+
+func (e *Engine) getWriter(kind types.StreamDataKind) (*Writer, error) {
+	if w, ok := e.writers[kind]; ok {
+		return w, nil
+	}
+	w, err := OpenWriter(e.dir, DataKindPrefix(kind), e.opts)
+	if err != nil {
+		return nil, err
+	}
+	e.writers[kind] = w
+	return w, nil
+}
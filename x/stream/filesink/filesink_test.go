@@ -0,0 +1,156 @@
+package filesink
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/stream/types"
+)
+
+type testData struct {
+	Height int64  `json:"height"`
+	Value  string `json:"value"`
+}
+
+func (d testData) BlockHeight() int64 {
+	return d.Height
+}
+
+func (d testData) DataType() types.StreamDataKind {
+	return types.StreamDataKlineKind
+}
+
+func newRecord(height int64) Record {
+	bz, _ := json.Marshal(testData{Height: height, Value: "v"})
+	return Record{Height: height, Kind: types.StreamDataKlineKind, Data: bz}
+}
+
+func replayHeights(t *testing.T, r *Reader, from int64) []int64 {
+	var heights []int64
+	require.NoError(t, r.Replay(from, func(rec Record) error {
+		heights = append(heights, rec.Height)
+		return nil
+	}))
+	return heights
+}
+
+func TestWriterRotateAndReplay(t *testing.T) {
+	for _, format := range []byte{FormatJSON, FormatLengthPrefix} {
+		dir, err := ioutil.TempDir("", "filesink")
+		require.NoError(t, err)
+		defer os.RemoveAll(dir)
+
+		w, err := OpenWriter(dir, "kline", Options{Format: format, MaxSegmentHeights: 3})
+		require.NoError(t, err)
+		for h := int64(1); h <= 7; h++ {
+			require.NoError(t, w.Append(newRecord(h)))
+		}
+		require.Error(t, w.Append(newRecord(7)))
+		require.NoError(t, w.Close())
+
+		segments, err := listSegments(dir, "kline")
+		require.NoError(t, err)
+		require.Len(t, segments, 3)
+		require.EqualValues(t, 4, segments[1].startHeight)
+
+		r := NewReader(dir, "kline")
+		require.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7}, replayHeights(t, r, 0))
+		require.Equal(t, []int64{5, 6, 7}, replayHeights(t, r, 5))
+
+		var data testData
+		require.NoError(t, r.Replay(7, func(rec Record) error {
+			require.Equal(t, types.StreamDataKlineKind, rec.Kind)
+			return json.Unmarshal(rec.Data, &data)
+		}))
+		require.Equal(t, testData{Height: 7, Value: "v"}, data)
+	}
+}
+
+func TestWriterRecoverTornRecord(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	w, err := OpenWriter(dir, "kline", DefaultOptions())
+	require.NoError(t, err)
+	require.NoError(t, w.Append(newRecord(1)))
+	require.NoError(t, w.Append(newRecord(2)))
+	require.NoError(t, w.Close())
+
+	// tear the last record
+	segments, err := listSegments(dir, "kline")
+	require.NoError(t, err)
+	info, err := os.Stat(segments[0].path)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(segments[0].path, info.Size()-3))
+	require.Equal(t, []int64{1}, replayHeights(t, NewReader(dir, "kline"), 0))
+
+	w, err = OpenWriter(dir, "kline", DefaultOptions())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, w.LastHeight())
+	require.NoError(t, w.Append(newRecord(2)))
+	require.NoError(t, w.Close())
+	require.Equal(t, []int64{1, 2}, replayHeights(t, NewReader(dir, "kline"), 0))
+}
+
+func TestReaderTail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	w, err := OpenWriter(dir, "kline", Options{Format: FormatJSON, MaxSegmentHeights: 2})
+	require.NoError(t, err)
+	defer w.Close()
+	require.NoError(t, w.Append(newRecord(1)))
+
+	heights := make(chan int64, 10)
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() {
+		done <- NewReader(dir, "kline").Tail(1, 10*time.Millisecond, stop, func(rec Record) error {
+			heights <- rec.Height
+			return nil
+		})
+	}()
+
+	for h := int64(2); h <= 5; h++ {
+		require.NoError(t, w.Append(newRecord(h)))
+	}
+	for h := int64(1); h <= 5; h++ {
+		select {
+		case height := <-heights:
+			require.Equal(t, h, height)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("height %d isn't tailed", h)
+		}
+	}
+	close(stop)
+	require.NoError(t, <-done)
+}
+
+func TestEngineWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "filesink")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, _, err = ParseURL(dir + "?format=xml")
+	require.Error(t, err)
+	engine, err := NewEngine("file://"+dir+"?format=lpjson&max_segment_heights=10", log.NewNopLogger(), nil)
+	require.NoError(t, err)
+
+	success := false
+	engine.Write(testData{Height: 2}, &success)
+	require.True(t, success)
+	// the data written already is considered success
+	success = false
+	engine.Write(testData{Height: 2}, &success)
+	require.True(t, success)
+
+	require.Equal(t, []int64{2}, replayHeights(t, NewReader(dir, DataKindPrefix(types.StreamDataKlineKind)), 0))
+}
@@ -0,0 +1,227 @@
+package filesink
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"time"
+)
+
+// Reader reads the records of the segments of a prefix in a dir, which may be written concurrently by a writer
+type Reader struct {
+	dir    string
+	prefix string
+}
+
+// NewReader returns the reader of the segments of the prefix in the dir
+func NewReader(dir, prefix string) *Reader {
+	return &Reader{dir: dir, prefix: prefix}
+}
+
+// Replay calls fn with the records from the height to the last one written. The replay stops at the first error
+// returned by fn
+func (r *Reader) Replay(fromHeight int64, fn func(Record) error) error {
+	it, err := r.Iterator(fromHeight)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for {
+		rec, err := it.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err = fn(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// Tail calls fn with the records from the height, then waits for the new records by polling the segments at the
+// interval until stop is closed
+func (r *Reader) Tail(fromHeight int64, interval time.Duration, stop <-chan struct{}, fn func(Record) error) error {
+	it, err := r.Iterator(fromHeight)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		rec, err := it.Next()
+		if err == io.EOF {
+			select {
+			case <-stop:
+				return nil
+			case <-ticker.C:
+				continue
+			}
+		} else if err != nil {
+			return err
+		}
+		if err = fn(rec); err != nil {
+			return err
+		}
+	}
+}
+
+// Iterator returns an iterator of the records from the height
+func (r *Reader) Iterator(fromHeight int64) (*Iterator, error) {
+	segments, err := listSegments(r.dir, r.prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	// start from the last segment beginning before the height
+	idx := 0
+	for i, seg := range segments {
+		if seg.startHeight <= fromHeight {
+			idx = i
+		}
+	}
+	return &Iterator{reader: r, fromHeight: fromHeight, segments: segments, idx: idx}, nil
+}
+
+// Iterator iterates the records of the segments in the order of the heights
+type Iterator struct {
+	reader     *Reader
+	fromHeight int64
+	segments   []segment
+	idx        int
+
+	file    *os.File
+	br      *bufio.Reader
+	format  byte
+	offset  int64
+	drained bool
+}
+
+// Next returns the next record. It returns io.EOF if there is no more record for now, more records may be returned
+// by the following calls once they are written
+func (it *Iterator) Next() (Record, error) {
+	for {
+		if it.file == nil {
+			if err := it.openSegment(); err != nil {
+				return Record{}, err
+			}
+		}
+
+		payload, n, err := readFrame(it.br)
+		switch err {
+		case nil:
+			it.offset += n
+			rec, err := decodeRecord(it.format, payload)
+			if err != nil {
+				return Record{}, err
+			}
+			if rec.Height < it.fromHeight {
+				continue
+			}
+			return rec, nil
+		case io.EOF, io.ErrUnexpectedEOF:
+			// the segment is finished once the next one is started, it's drained again for the records written
+			// before the next segment, then the iteration moves to the next segment
+			next, err := it.hasNextSegment()
+			if err != nil {
+				return Record{}, err
+			}
+			if next && it.drained {
+				it.closeSegment()
+				it.idx++
+				continue
+			}
+			if err := it.rewind(); err != nil {
+				return Record{}, err
+			}
+			if next {
+				it.drained = true
+				continue
+			}
+			return Record{}, io.EOF
+		default:
+			return Record{}, err
+		}
+	}
+}
+
+// openSegment opens the current segment. It returns io.EOF if the segment or its header isn't written yet
+func (it *Iterator) openSegment() error {
+	if it.idx >= len(it.segments) {
+		if err := it.refreshSegments(); err != nil {
+			return err
+		}
+		if it.idx >= len(it.segments) {
+			return io.EOF
+		}
+	}
+
+	file, err := os.Open(it.segments[it.idx].path)
+	if err != nil {
+		return err
+	}
+	br := bufio.NewReader(file)
+	format, err := readHeader(br)
+	if err != nil {
+		file.Close()
+		if err == io.ErrUnexpectedEOF {
+			return io.EOF
+		}
+		return err
+	}
+	it.file, it.br, it.format, it.offset = file, br, format, int64(headerSize)
+	return nil
+}
+
+func (it *Iterator) hasNextSegment() (bool, error) {
+	if it.idx+1 < len(it.segments) {
+		return true, nil
+	}
+	if err := it.refreshSegments(); err != nil {
+		return false, err
+	}
+	return it.idx+1 < len(it.segments), nil
+}
+
+// refreshSegments lists the segments again for the ones started after the last known segment
+func (it *Iterator) refreshSegments() error {
+	segments, err := listSegments(it.reader.dir, it.reader.prefix)
+	if err != nil {
+		return err
+	}
+	last := int64(-1)
+	if len(it.segments) > 0 {
+		last = it.segments[len(it.segments)-1].startHeight
+	}
+	for _, seg := range segments {
+		if seg.startHeight > last {
+			it.segments = append(it.segments, seg)
+		}
+	}
+	return nil
+}
+
+// rewind seeks to the end of the last complete record, the partial record after it is read again later
+func (it *Iterator) rewind() error {
+	if _, err := it.file.Seek(it.offset, io.SeekStart); err != nil {
+		return err
+	}
+	it.br.Reset(it.file)
+	return nil
+}
+
+func (it *Iterator) closeSegment() {
+	if it.file != nil {
+		it.file.Close()
+		it.file, it.br = nil, nil
+	}
+	it.drained = false
+}
+
+// Close closes the iterator
+func (it *Iterator) Close() {
+	it.closeSegment()
+}
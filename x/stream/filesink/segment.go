@@ -0,0 +1,204 @@
+package filesink
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/okex/okexchain/x/stream/types"
+)
+
+// A segment file starts with a header of the magic, the version and the format of the records, which is followed by
+// the records. Every record is framed as uvarint(len(payload)) | payload | crc32(payload), so a torn write at the
+// tail is detected when the segment is opened or read.
+
+const (
+	FormatJSON         byte = 0x01
+	FormatLengthPrefix byte = 0x02
+
+	segmentMagic   = "OKSS"
+	segmentVersion = byte(0x01)
+	segmentExt     = ".seg"
+	headerSize     = len(segmentMagic) + 2
+	crcSize        = 4
+	// maxPayloadSize guards the reader from allocating for a garbage length
+	maxPayloadSize = 1 << 30
+)
+
+var (
+	ErrCorruptedRecord = errors.New("corrupted record in segment")
+	ErrInvalidSegment  = errors.New("invalid segment header")
+
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+// StringToFormat returns the record format by the name, json or lpjson(length-prefixed json)
+func StringToFormat(name string) (byte, error) {
+	switch strings.ToLower(name) {
+	case "", "json":
+		return FormatJSON, nil
+	case "lpjson":
+		return FormatLengthPrefix, nil
+	default:
+		return 0, fmt.Errorf("unsupported record format %s", name)
+	}
+}
+
+// Record is a stream data written to the segments. Data is the json of the stream data
+type Record struct {
+	Height int64                `json:"height"`
+	Kind   types.StreamDataKind `json:"kind"`
+	Data   json.RawMessage      `json:"data"`
+}
+
+// encodeRecord encodes the payload of the record. The lpjson format saves the envelope of the json format, it's
+// uvarint(height) | uvarint(kind) | uvarint(len(data)) | data, where the data is the json of the stream data
+func encodeRecord(format byte, rec Record) ([]byte, error) {
+	if format == FormatJSON {
+		return json.Marshal(rec)
+	}
+
+	buf := make([]byte, 0, 3*binary.MaxVarintLen64+len(rec.Data))
+	buf = appendUvarint(buf, uint64(rec.Height))
+	buf = appendUvarint(buf, uint64(rec.Kind))
+	buf = appendUvarint(buf, uint64(len(rec.Data)))
+	return append(buf, rec.Data...), nil
+}
+
+func decodeRecord(format byte, payload []byte) (rec Record, err error) {
+	if format == FormatJSON {
+		err = json.Unmarshal(payload, &rec)
+		return rec, err
+	}
+
+	var fields [3]uint64
+	for i := range fields {
+		v, n := binary.Uvarint(payload)
+		if n <= 0 {
+			return rec, ErrCorruptedRecord
+		}
+		fields[i], payload = v, payload[n:]
+	}
+	if fields[2] != uint64(len(payload)) {
+		return rec, ErrCorruptedRecord
+	}
+	rec.Height, rec.Kind = int64(fields[0]), types.StreamDataKind(fields[1])
+	rec.Data = append(json.RawMessage{}, payload...)
+	return rec, nil
+}
+
+func appendUvarint(buf []byte, v uint64) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], v)
+	return append(buf, tmp[:n]...)
+}
+
+// frame frames the payload of a record
+func frame(payload []byte) []byte {
+	buf := appendUvarint(make([]byte, 0, len(payload)+binary.MaxVarintLen64+crcSize), uint64(len(payload)))
+	buf = append(buf, payload...)
+	var sum [crcSize]byte
+	binary.BigEndian.PutUint32(sum[:], crc32.Checksum(payload, crcTable))
+	return append(buf, sum[:]...)
+}
+
+// readFrame reads the payload of the next record and the size of its frame. It returns io.EOF at the end of the
+// segment and io.ErrUnexpectedEOF if the record is not fully written
+func readFrame(r *bufio.Reader) ([]byte, int64, error) {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, 0, io.EOF
+		}
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	if l > maxPayloadSize {
+		return nil, 0, ErrCorruptedRecord
+	}
+
+	buf := make([]byte, int(l)+crcSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, 0, io.ErrUnexpectedEOF
+	}
+	payload := buf[:l]
+	if binary.BigEndian.Uint32(buf[l:]) != crc32.Checksum(payload, crcTable) {
+		return nil, 0, ErrCorruptedRecord
+	}
+	return payload, int64(len(appendUvarint(nil, l))) + int64(len(buf)), nil
+}
+
+func writeHeader(w io.Writer, format byte) error {
+	_, err := w.Write(append([]byte(segmentMagic), segmentVersion, format))
+	return err
+}
+
+// readHeader returns the format of the segment, io.ErrUnexpectedEOF is returned if the header is not fully written
+func readHeader(r io.Reader) (byte, error) {
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if string(header[:len(segmentMagic)]) != segmentMagic || header[len(segmentMagic)] != segmentVersion {
+		return 0, ErrInvalidSegment
+	}
+	format := header[headerSize-1]
+	if format != FormatJSON && format != FormatLengthPrefix {
+		return 0, ErrInvalidSegment
+	}
+	return format, nil
+}
+
+// segment is a segment file, which is named by the prefix and the height of its first record
+type segment struct {
+	path        string
+	startHeight int64
+}
+
+func segmentName(prefix string, startHeight int64) string {
+	return fmt.Sprintf("%s-%020d%s", prefix, startHeight, segmentExt)
+}
+
+// listSegments returns the segments of the prefix in the dir in the order of the start heights
+func listSegments(dir, prefix string) ([]segment, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var segments []segment
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasPrefix(name, prefix+"-") || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		height, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimPrefix(name, prefix+"-"), segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		segments = append(segments, segment{path: filepath.Join(dir, name), startHeight: height})
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].startHeight < segments[j].startHeight
+	})
+	return segments, nil
+}
+
+// syncDir makes the creation of the segment files durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
@@ -0,0 +1,208 @@
+package filesink
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	DefaultMaxSegmentBytes   = 64 << 20
+	DefaultMaxSegmentHeights = 10000
+)
+
+// Options is the options of a writer
+type Options struct {
+	Format byte
+	// a new segment is started once the segment exceeds MaxSegmentBytes or MaxSegmentHeights heights
+	MaxSegmentBytes   int64
+	MaxSegmentHeights int64
+}
+
+// DefaultOptions returns the options of the json records in the default sized segments
+func DefaultOptions() Options {
+	return Options{
+		Format:            FormatJSON,
+		MaxSegmentBytes:   DefaultMaxSegmentBytes,
+		MaxSegmentHeights: DefaultMaxSegmentHeights,
+	}
+}
+
+// Writer appends the records of increasing heights to the segments of a prefix in a dir
+type Writer struct {
+	dir    string
+	prefix string
+	opts   Options
+
+	file        *os.File
+	format      byte
+	startHeight int64
+	size        int64
+	lastHeight  int64
+}
+
+// OpenWriter opens the writer, the records after a torn write at the tail of the last segment are truncated
+func OpenWriter(dir, prefix string, opts Options) (*Writer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	w := &Writer{dir: dir, prefix: prefix, opts: opts}
+
+	segments, err := listSegments(dir, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) == 0 {
+		return w, nil
+	}
+	if err := w.recover(segments[len(segments)-1]); err != nil {
+		w.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+// recover opens the last segment for appending after its last complete record
+func (w *Writer) recover(seg segment) error {
+	file, err := os.OpenFile(seg.path, os.O_RDWR, 0644)
+	if err != nil {
+		return err
+	}
+	w.file, w.startHeight = file, seg.startHeight
+
+	r := bufio.NewReader(file)
+	w.format, err = readHeader(r)
+	if err == io.ErrUnexpectedEOF {
+		// the segment was created but its header wasn't synced
+		w.format = w.opts.Format
+		if err = file.Truncate(0); err != nil {
+			return err
+		}
+		if err = writeHeader(file, w.format); err != nil {
+			return err
+		}
+		w.size = int64(headerSize)
+		w.lastHeight = seg.startHeight - 1
+		return file.Sync()
+	} else if err != nil {
+		return fmt.Errorf("%s: %s", seg.path, err.Error())
+	}
+
+	offset := int64(headerSize)
+	// the last height is known even if the segment has no complete record
+	w.lastHeight = seg.startHeight - 1
+	for {
+		payload, n, err := readFrame(r)
+		if err == io.EOF {
+			break
+		} else if err == io.ErrUnexpectedEOF || err == ErrCorruptedRecord {
+			if err = file.Truncate(offset); err != nil {
+				return err
+			}
+			if err = file.Sync(); err != nil {
+				return err
+			}
+			break
+		}
+		rec, err := decodeRecord(w.format, payload)
+		if err != nil {
+			return fmt.Errorf("%s: %s", seg.path, err.Error())
+		}
+		offset += n
+		w.lastHeight = rec.Height
+	}
+
+	w.size = offset
+	_, err = file.Seek(offset, io.SeekStart)
+	return err
+}
+
+// LastHeight returns the height of the last record written, a record of a height not greater than it is written
+// already
+func (w *Writer) LastHeight() int64 {
+	return w.lastHeight
+}
+
+// Append writes the record and syncs it to the disk. Nothing is left in the segment if it fails
+func (w *Writer) Append(rec Record) error {
+	if rec.Height <= w.lastHeight {
+		return fmt.Errorf("record of height %d is not after the last height %d", rec.Height, w.lastHeight)
+	}
+	if w.needRotate(rec.Height) {
+		if err := w.rotate(rec.Height); err != nil {
+			return err
+		}
+	}
+
+	payload, err := encodeRecord(w.format, rec)
+	if err != nil {
+		return err
+	}
+	bz := frame(payload)
+	if _, err = w.file.Write(bz); err == nil {
+		err = w.file.Sync()
+	}
+	if err != nil {
+		// drop the partial record, so that the record can be appended again
+		if tErr := w.file.Truncate(w.size); tErr == nil {
+			_, _ = w.file.Seek(w.size, io.SeekStart)
+		}
+		return err
+	}
+
+	w.size += int64(len(bz))
+	w.lastHeight = rec.Height
+	return nil
+}
+
+func (w *Writer) needRotate(height int64) bool {
+	if w.file == nil {
+		return true
+	}
+	if w.size <= int64(headerSize) {
+		return false
+	}
+	return (w.opts.MaxSegmentBytes > 0 && w.size >= w.opts.MaxSegmentBytes) ||
+		(w.opts.MaxSegmentHeights > 0 && height-w.startHeight >= w.opts.MaxSegmentHeights)
+}
+
+// rotate closes the current segment and starts the segment of the height
+func (w *Writer) rotate(height int64) error {
+	if w.file != nil {
+		if err := w.file.Close(); err != nil {
+			return err
+		}
+		w.file = nil
+	}
+
+	path := filepath.Join(w.dir, segmentName(w.prefix, height))
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if err = writeHeader(file, w.opts.Format); err == nil {
+		if err = file.Sync(); err == nil {
+			err = syncDir(w.dir)
+		}
+	}
+	if err != nil {
+		file.Close()
+		os.Remove(path)
+		return err
+	}
+
+	w.file, w.format, w.startHeight, w.size = file, w.opts.Format, height, int64(headerSize)
+	return nil
+}
+
+// Close closes the current segment
+func (w *Writer) Close() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	return err
+}
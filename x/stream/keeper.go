@@ -6,6 +6,7 @@ import (
 	"github.com/okex/okexchain/x/ammswap"
 	backend "github.com/okex/okexchain/x/backend/types"
 	"github.com/okex/okexchain/x/dex"
+	"github.com/okex/okexchain/x/stream/common"
 	"github.com/okex/okexchain/x/stream/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,15 +25,17 @@ type Keeper struct {
 
 // nolint
 func NewKeeper(orderKeeper types.OrderKeeper, tokenKeeper types.TokenKeeper, dexKeeper types.DexKeeper, accountKeeper types.AccountKeeper,
-	swapKeeper types.SwapKeeper, cdc *codec.Codec, logger log.Logger, cfg *config.Config, metrics *monitor.StreamMetrics) Keeper {
+	swapKeeper types.SwapKeeper, farmKeeper types.FarmKeeper, cdc *codec.Codec, logger log.Logger, cfg *config.Config,
+	metrics *monitor.StreamMetrics) Keeper {
 	logger = logger.With("module", "stream")
 	k := Keeper{
 		metric: metrics,
-		stream: NewStream(orderKeeper, tokenKeeper, dexKeeper, swapKeeper, cdc, logger, cfg),
+		stream: NewStream(orderKeeper, tokenKeeper, dexKeeper, swapKeeper, farmKeeper, cdc, logger, cfg),
 	}
 	dexKeeper.SetObserverKeeper(k)
 	accountKeeper.SetObserverKeeper(k)
 	swapKeeper.SetObserverKeeper(k)
+	farmKeeper.SetObserverKeeper(k)
 	return k
 }
 
@@ -78,19 +81,61 @@ func (k Keeper) OnAccountUpdated(acc auth.Account) {
 
 // OnSwapToken called by swap
 func (k Keeper) OnSwapToken(ctx sdk.Context, address sdk.AccAddress, swapTokenPair ammswap.SwapTokenPair, sellAmount sdk.SysCoin, buyAmount sdk.SysCoin) {
-	swapInfo := &backend.SwapInfo{
-		Address:          address.String(),
-		TokenPairName:    swapTokenPair.TokenPairName(),
-		BaseTokenAmount:  swapTokenPair.BasePooledCoin.String(),
-		QuoteTokenAmount: swapTokenPair.QuotePooledCoin.String(),
-		SellAmount:       sellAmount.String(),
-		BuysAmount:       buyAmount.String(),
-		Price:            swapTokenPair.BasePooledCoin.Amount.Quo(swapTokenPair.QuotePooledCoin.Amount).String(),
-		Timestamp:        ctx.BlockTime().Unix(),
-	}
+	swapInfo := backend.NewSwapInfo(address, swapTokenPair, sellAmount, buyAmount, ctx.BlockTime().Unix())
 	k.stream.Cache.AddSwapInfo(swapInfo)
+	k.stream.Cache.AddUpdatedSwapPair(swapTokenPair.TokenPairName())
 }
 
 func (k Keeper) OnSwapCreateExchange(ctx sdk.Context, swapTokenPair ammswap.SwapTokenPair) {
 	k.stream.Cache.AddNewSwapTokenPair(&swapTokenPair)
+	k.stream.Cache.AddUpdatedSwapPair(swapTokenPair.TokenPairName())
+}
+
+// OnAddLiquidity called by swap
+func (k Keeper) OnAddLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair ammswap.SwapTokenPair,
+	baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	k.addSwapLiquidityInfo(ctx, common.LiquidityTypeAdd, address, swapTokenPair, baseAmount, quoteAmount, liquidity)
+}
+
+// OnRemoveLiquidity called by swap
+func (k Keeper) OnRemoveLiquidity(ctx sdk.Context, address sdk.AccAddress, swapTokenPair ammswap.SwapTokenPair,
+	baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	k.addSwapLiquidityInfo(ctx, common.LiquidityTypeRemove, address, swapTokenPair, baseAmount, quoteAmount, liquidity)
+}
+
+func (k Keeper) addSwapLiquidityInfo(ctx sdk.Context, liquidityType string, address sdk.AccAddress,
+	swapTokenPair ammswap.SwapTokenPair, baseAmount, quoteAmount sdk.SysCoin, liquidity sdk.Dec) {
+	k.stream.Cache.AddSwapLiquidityInfo(&common.SwapLiquidityInfo{
+		Address:       address.String(),
+		TokenPairName: swapTokenPair.TokenPairName(),
+		Type:          liquidityType,
+		BaseAmount:    baseAmount.String(),
+		QuoteAmount:   quoteAmount.String(),
+		Liquidity:     liquidity.String(),
+		Timestamp:     ctx.BlockTime().Unix(),
+	})
+	k.stream.Cache.AddUpdatedSwapPair(swapTokenPair.TokenPairName())
+}
+
+// OnFarmPoolUpdated called by farm
+func (k Keeper) OnFarmPoolUpdated(ctx sdk.Context, poolName string) {
+	k.stream.Cache.AddUpdatedFarmPool(poolName)
+}
+
+// OnFarmLock called by farm
+func (k Keeper) OnFarmLock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin) {
+	k.stream.Cache.AddUpdatedFarmPool(poolName)
+	k.stream.Cache.AddUpdatedFarmLock(poolName, address)
+}
+
+// OnFarmUnlock called by farm
+func (k Keeper) OnFarmUnlock(ctx sdk.Context, address sdk.AccAddress, poolName string, amount sdk.SysCoin) {
+	k.stream.Cache.AddUpdatedFarmPool(poolName)
+	k.stream.Cache.AddUpdatedFarmLock(poolName, address)
+}
+
+// OnFarmClaim called by farm
+func (k Keeper) OnFarmClaim(ctx sdk.Context, address sdk.AccAddress, poolName string, rewards sdk.SysCoins) {
+	k.stream.Cache.AddUpdatedFarmPool(poolName)
+	k.stream.Cache.AddUpdatedFarmLock(poolName, address)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/okex/okexchain/x/ammswap"
 	ammswaptypes "github.com/okex/okexchain/x/ammswap/types"
 	"github.com/okex/okexchain/x/dex"
+	farmtypes "github.com/okex/okexchain/x/farm/types"
 	"github.com/okex/okexchain/x/order"
 	"github.com/okex/okexchain/x/token"
 	"github.com/willf/bitset"
@@ -22,6 +23,8 @@ type OrderKeeper interface {
 	GetDepthBookCopy(product string) *order.DepthBook
 	GetProductPriceOrderIDs(key string) []string
 	GetTxHandlerMsgResult() []bitset.BitSet
+	GetAccountFeeTier(ctx sdk.Context, addr sdk.AccAddress) order.AccountFeeTier
+	GetBlockProductHalts() []order.ProductHalt
 }
 
 type TokenKeeper interface {
@@ -42,4 +45,13 @@ type DexKeeper interface {
 type SwapKeeper interface {
 	SetObserverKeeper(k ammswaptypes.BackendKeeper)
 	GetSwapTokenPairs(ctx sdk.Context) []ammswap.SwapTokenPair
+	GetSwapTokenPair(ctx sdk.Context, tokenPairName string) (ammswap.SwapTokenPair, error)
+	GetPoolTokenAmount(ctx sdk.Context, poolTokenName string) sdk.Dec
+}
+
+// FarmKeeper expected farm keeper
+type FarmKeeper interface {
+	SetObserverKeeper(ok farmtypes.ObserverKeeper)
+	GetFarmPool(ctx sdk.Context, poolName string) (farmtypes.FarmPool, bool)
+	GetEarnings(ctx sdk.Context, poolName string, accAddr sdk.AccAddress) (farmtypes.Earnings, sdk.Error)
 }
@@ -0,0 +1,142 @@
+package webhook
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultMaxRetries     = 5
+	DefaultInitialBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff     = 8 * time.Second
+	// DefaultMaxElapsed keeps the retries of a write within the timeout of the atom task
+	DefaultMaxElapsed     = 20 * time.Second
+	DefaultRequestTimeout = 5 * time.Second
+	DefaultBatchSize      = 100
+
+	spoolDirName = "webhook_spool"
+)
+
+// Config is the config of a webhook engine
+type Config struct {
+	Targets []string
+	// Secret signs the payloads by HMAC-SHA256, the payloads aren't signed if it's empty
+	Secret string
+	// SpoolDir persists the batches undelivered
+	SpoolDir       string
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	MaxElapsed     time.Duration
+	RequestTimeout time.Duration
+	// BatchSize is the max number of the records posted at a time
+	BatchSize int
+}
+
+// ParseConfig parses the webhook engine url, which is a list separated by ";" of the target urls and the options in
+// the form of key=value, e.g. https://a.com/hook;https://b.com/hook;secret=xxx;spool_dir=/data/webhook;max_retries=3.
+// The spool dir is under the local lock dir by default
+func ParseConfig(engineURL, localLockDir string) (Config, error) {
+	cfg := Config{
+		MaxRetries:     DefaultMaxRetries,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+		MaxElapsed:     DefaultMaxElapsed,
+		RequestTimeout: DefaultRequestTimeout,
+		BatchSize:      DefaultBatchSize,
+	}
+
+	for _, item := range strings.Split(engineURL, ";") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if strings.Contains(item, "://") {
+			u, err := url.Parse(item)
+			if err != nil {
+				return cfg, err
+			}
+			if u.Scheme != "http" && u.Scheme != "https" {
+				return cfg, fmt.Errorf("unsupported scheme %s of webhook %s", u.Scheme, item)
+			}
+			cfg.Targets = append(cfg.Targets, item)
+			continue
+		}
+
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 {
+			return cfg, fmt.Errorf("expected option in a form of \"key=value\", given %s", item)
+		}
+		if err := cfg.setOption(kv[0], kv[1]); err != nil {
+			return cfg, err
+		}
+	}
+
+	if len(cfg.Targets) == 0 {
+		return cfg, fmt.Errorf("no webhook url in %s", RedactURL(engineURL))
+	}
+	if cfg.SpoolDir == "" {
+		if localLockDir == "" {
+			return cfg, fmt.Errorf("spool_dir of webhook is required if local_lock_dir isn't set")
+		}
+		cfg.SpoolDir = filepath.Join(localLockDir, spoolDirName)
+	}
+	return cfg, nil
+}
+
+func (cfg *Config) setOption(key, value string) (err error) {
+	switch key {
+	case "secret":
+		cfg.Secret = value
+	case "spool_dir":
+		cfg.SpoolDir = value
+	case "max_retries":
+		cfg.MaxRetries, err = strconv.Atoi(value)
+	case "batch_size":
+		cfg.BatchSize, err = strconv.Atoi(value)
+		if err == nil && cfg.BatchSize < 1 {
+			err = fmt.Errorf("batch_size must be positive")
+		}
+	case "initial_backoff":
+		cfg.InitialBackoff, err = time.ParseDuration(value)
+	case "max_backoff":
+		cfg.MaxBackoff, err = time.ParseDuration(value)
+	case "max_elapsed":
+		cfg.MaxElapsed, err = time.ParseDuration(value)
+	case "timeout":
+		cfg.RequestTimeout, err = time.ParseDuration(value)
+	default:
+		return fmt.Errorf("unknown webhook option %s", key)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid webhook option %s=%s: %s", key, value, err.Error())
+	}
+	return nil
+}
+
+// backoff returns the delay before the retry of the attempt, which doubles from the initial backoff
+func (cfg Config) backoff(attempt int) time.Duration {
+	delay := cfg.InitialBackoff
+	for i := 0; i < attempt && delay < cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > cfg.MaxBackoff {
+		delay = cfg.MaxBackoff
+	}
+	return delay
+}
+
+// RedactURL hides the secret of the webhook engine url
+func RedactURL(engineURL string) string {
+	items := strings.Split(engineURL, ";")
+	for i, item := range items {
+		if strings.HasPrefix(strings.TrimSpace(item), "secret=") {
+			items[i] = "secret=***"
+		}
+	}
+	return strings.Join(items, ";")
+}
@@ -0,0 +1,281 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	appCfg "github.com/cosmos/cosmos-sdk/server/config"
+	"github.com/okex/okexchain/x/stream/filesink"
+	"github.com/okex/okexchain/x/stream/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	HeaderSignature = "X-Okexchain-Signature"
+	HeaderTimestamp = "X-Okexchain-Timestamp"
+	HeaderBatchID   = "X-Okexchain-Batch-Id"
+)
+
+// Batch is the payload posted to the webhooks. ID identifies the batch for the receivers to drop the batch delivered
+// again after a restart
+type Batch struct {
+	ID      string            `json:"id"`
+	Records []filesink.Record `json:"records"`
+}
+
+// Sign returns the signature of the payload posted at the timestamp, which is the hex of
+// HMAC-SHA256(secret, timestamp + "." + payload) prefixed by "sha256="
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// target is a webhook with the records not delivered to it yet
+type target struct {
+	url       string
+	spool     *spool
+	pending   []pendingRecord
+	delivered int64
+}
+
+type pendingRecord struct {
+	record  filesink.Record
+	spooled bool
+}
+
+// lastHeight returns the height of the last record accepted by the target
+func (t *target) lastHeight() int64 {
+	if len(t.pending) > 0 {
+		return t.pending[len(t.pending)-1].record.Height
+	}
+	return t.delivered
+}
+
+// spoolPending persists the records pending, which are delivered after a restart
+func (t *target) spoolPending() error {
+	for i := range t.pending {
+		if t.pending[i].spooled {
+			continue
+		}
+		if err := t.spool.save(t.pending[i].record); err != nil {
+			return err
+		}
+		t.pending[i].spooled = true
+	}
+	return nil
+}
+
+// Engine posts the stream data of a kind to the webhooks
+type Engine struct {
+	url    string
+	cfg    Config
+	logger log.Logger
+	client *http.Client
+
+	mtx     sync.Mutex
+	targets []*target
+}
+
+// NewEngineCreator returns the creator of the webhook engines posting the stream data of the kind
+func NewEngineCreator(kind types.StreamDataKind) func(string, log.Logger,
+	*appCfg.StreamConfig) (types.IStreamEngine, error) {
+	return func(engineURL string, logger log.Logger, streamCfg *appCfg.StreamConfig) (types.IStreamEngine, error) {
+		return NewEngine(kind, engineURL, logger, streamCfg)
+	}
+}
+
+// NewEngine creates the webhook engine of the data kind, the records spooled before are delivered ahead of the new ones
+func NewEngine(kind types.StreamDataKind, engineURL string, logger log.Logger,
+	streamCfg *appCfg.StreamConfig) (types.IStreamEngine, error) {
+	localLockDir := ""
+	if streamCfg != nil {
+		localLockDir = streamCfg.LocalLockDir
+	}
+	cfg, err := ParseConfig(engineURL, localLockDir)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Engine{
+		url:    RedactURL(engineURL),
+		cfg:    cfg,
+		logger: logger,
+		client: &http.Client{},
+	}
+	for _, u := range cfg.Targets {
+		t, err := loadTarget(kind, u, cfg.SpoolDir)
+		if err != nil {
+			return nil, err
+		}
+		if len(t.pending) > 0 {
+			logger.Info(fmt.Sprintf("webhook %s has %d records undelivered", u, len(t.pending)))
+		}
+		e.targets = append(e.targets, t)
+	}
+	logger.Info(fmt.Sprintf("webhook engine posts to %v", cfg.Targets))
+	return e, nil
+}
+
+func loadTarget(kind types.StreamDataKind, u, spoolDir string) (*target, error) {
+	s, err := newSpool(spoolDir, kind, u)
+	if err != nil {
+		return nil, err
+	}
+	t := &target{url: u, spool: s}
+	if t.delivered, err = s.deliveredHeight(); err != nil {
+		return nil, err
+	}
+
+	records, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	var stale []filesink.Record
+	for _, rec := range records {
+		// the record was delivered right before the restart
+		if rec.Height <= t.delivered {
+			stale = append(stale, rec)
+			continue
+		}
+		t.pending = append(t.pending, pendingRecord{record: rec, spooled: true})
+	}
+	return t, s.remove(stale)
+}
+
+// URL returns the url of the engine with the secret hidden
+func (e *Engine) URL() string {
+	return e.url
+}
+
+// Write delivers the data with the records undelivered before to all the webhooks, success is set if all of them are
+// delivered. The records undelivered are spooled, and retried by the following writes
+func (e *Engine) Write(data types.IStreamData, success *bool) {
+	e.logger.Debug("Entering WebhookEngine Write")
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+
+	bz, err := json.Marshal(data)
+	if err != nil {
+		e.logger.Error(fmt.Sprintf("webhook engine marshal data failed: %s", err.Error()))
+		*success = false
+		return
+	}
+	rec := filesink.Record{Height: data.BlockHeight(), Kind: data.DataType(), Data: bz}
+
+	deadline := time.Now().Add(e.cfg.MaxElapsed)
+	*success = true
+	for _, t := range e.targets {
+		// the data of a height accepted already, e.g. by the task rerun, isn't delivered again
+		if rec.Height > t.lastHeight() {
+			t.pending = append(t.pending, pendingRecord{record: rec})
+		}
+		if err := e.flush(t, deadline); err != nil {
+			e.logger.Error(fmt.Sprintf("webhook engine post to %s failed: %s, %d records undelivered",
+				t.url, err.Error(), len(t.pending)))
+			*success = false
+			if err := t.spoolPending(); err != nil {
+				e.logger.Error(fmt.Sprintf("webhook engine spool records failed: %s", err.Error()))
+			}
+		}
+	}
+}
+
+// flush posts the records pending to the target in batches
+func (e *Engine) flush(t *target, deadline time.Time) error {
+	for len(t.pending) > 0 {
+		n := len(t.pending)
+		if n > e.cfg.BatchSize {
+			n = e.cfg.BatchSize
+		}
+		batch := Batch{Records: make([]filesink.Record, n)}
+		for i := 0; i < n; i++ {
+			batch.Records[i] = t.pending[i].record
+		}
+		first, last := batch.Records[0], batch.Records[n-1]
+		batch.ID = fmt.Sprintf("%s-%d-%d", filesink.DataKindPrefix(first.Kind), first.Height, last.Height)
+
+		if err := e.post(t.url, batch, deadline); err != nil {
+			return err
+		}
+
+		if err := t.spool.setDeliveredHeight(last.Height); err != nil {
+			e.logger.Error(fmt.Sprintf("webhook engine save delivered height failed: %s", err.Error()))
+		}
+		if err := t.spool.remove(batch.Records); err != nil {
+			e.logger.Error(fmt.Sprintf("webhook engine remove spooled records failed: %s", err.Error()))
+		}
+		t.pending, t.delivered = t.pending[n:], last.Height
+		e.logger.Debug(fmt.Sprintf("WebhookEngine write result: batch %s posted to %s", batch.ID, t.url))
+	}
+	return nil
+}
+
+// post posts the batch, which is retried with exponential backoff until the deadline
+func (e *Engine) post(u string, batch Batch, deadline time.Time) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return err
+	}
+
+	for attempt := 0; ; attempt++ {
+		retryable, err := e.send(u, batch.ID, body)
+		if err == nil {
+			return nil
+		}
+		if !retryable || attempt >= e.cfg.MaxRetries {
+			return err
+		}
+		delay := e.cfg.backoff(attempt)
+		if time.Now().Add(delay).After(deadline) {
+			return fmt.Errorf("%s, no time left to retry", err.Error())
+		}
+		e.logger.Debug(fmt.Sprintf("webhook engine retry batch %s in %s: %s", batch.ID, delay, err.Error()))
+		time.Sleep(delay)
+	}
+}
+
+// send posts the body once, it returns whether the failure is worth a retry
+func (e *Engine) send(u, batchID string, body []byte) (retryable bool, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.RequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderBatchID, batchID)
+	if e.cfg.Secret != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(HeaderTimestamp, timestamp)
+		req.Header.Set(HeaderSignature, Sign(e.cfg.Secret, timestamp, body))
+	}
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+	err = fmt.Errorf("unexpected status %s", resp.Status)
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500, err
+}
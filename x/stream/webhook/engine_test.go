@@ -0,0 +1,191 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/stream/types"
+)
+
+type testData struct {
+	Height int64                `json:"height"`
+	Kind   types.StreamDataKind `json:"-"`
+}
+
+func (d testData) BlockHeight() int64 {
+	return d.Height
+}
+
+func (d testData) DataType() types.StreamDataKind {
+	if d.Kind == types.StreamDataNilKind {
+		return types.StreamDataNotifyKind
+	}
+	return d.Kind
+}
+
+// testServer records the batches posted, it fails the requests while failing is set
+type testServer struct {
+	*httptest.Server
+	t       *testing.T
+	secret  string
+	mtx     sync.Mutex
+	failing bool
+	batches []Batch
+}
+
+func newTestServer(t *testing.T, secret string) *testServer {
+	s := &testServer{t: t, secret: secret}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mtx.Lock()
+		defer s.mtx.Unlock()
+		if s.failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, Sign(s.secret, r.Header.Get(HeaderTimestamp), body), r.Header.Get(HeaderSignature))
+		var batch Batch
+		require.NoError(t, json.Unmarshal(body, &batch))
+		require.Equal(t, batch.ID, r.Header.Get(HeaderBatchID))
+		s.batches = append(s.batches, batch)
+	}))
+	return s
+}
+
+func (s *testServer) setFailing(failing bool) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.failing = failing
+}
+
+func (s *testServer) posted() []Batch {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]Batch{}, s.batches...)
+}
+
+func batchHeights(batch Batch) []int64 {
+	var heights []int64
+	for _, rec := range batch.Records {
+		heights = append(heights, rec.Height)
+	}
+	return heights
+}
+
+func TestEngineRetryAndSpool(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	server := newTestServer(t, "s3cret")
+	defer server.Close()
+	engineURL := server.URL + ";secret=s3cret;spool_dir=" + dir + ";max_retries=2;initial_backoff=1ms;max_backoff=2ms"
+
+	engine, err := NewEngine(types.StreamDataNotifyKind, engineURL, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	require.NotContains(t, engine.URL(), "s3cret")
+
+	success := false
+	engine.Write(testData{Height: 1}, &success)
+	require.True(t, success)
+	require.Len(t, server.posted(), 1)
+	require.Equal(t, "notify-1-1", server.posted()[0].ID)
+
+	// the records undelivered survive the restart
+	server.setFailing(true)
+	engine.Write(testData{Height: 2}, &success)
+	require.False(t, success)
+	engine, err = NewEngine(types.StreamDataNotifyKind, engineURL, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	engine.Write(testData{Height: 3}, &success)
+	require.False(t, success)
+
+	server.setFailing(false)
+	engine.Write(testData{Height: 3}, &success)
+	require.True(t, success)
+	require.Len(t, server.posted(), 2)
+	require.Equal(t, []int64{2, 3}, batchHeights(server.posted()[1]))
+
+	// the height delivered isn't posted again after the restart
+	engine, err = NewEngine(types.StreamDataNotifyKind, engineURL, log.NewNopLogger(), nil)
+	require.NoError(t, err)
+	engine.Write(testData{Height: 3}, &success)
+	require.True(t, success)
+	require.Len(t, server.posted(), 2)
+}
+
+func TestEnginesOfKindsSharingTarget(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhook")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	server := newTestServer(t, "s3cret")
+	defer server.Close()
+	engineURL := server.URL + ";secret=s3cret;spool_dir=" + dir + ";max_retries=1;initial_backoff=1ms;max_backoff=2ms"
+
+	newEngines := func() (types.IStreamEngine, types.IStreamEngine) {
+		notifyEngine, err := NewEngine(types.StreamDataNotifyKind, engineURL, log.NewNopLogger(), nil)
+		require.NoError(t, err)
+		klineEngine, err := NewEngine(types.StreamDataKlineKind, engineURL, log.NewNopLogger(), nil)
+		require.NoError(t, err)
+		return notifyEngine, klineEngine
+	}
+
+	// the records of both kinds at the same height are spooled apart
+	server.setFailing(true)
+	notifyEngine, klineEngine := newEngines()
+	success := false
+	notifyEngine.Write(testData{Height: 1, Kind: types.StreamDataNotifyKind}, &success)
+	require.False(t, success)
+	klineEngine.Write(testData{Height: 1, Kind: types.StreamDataKlineKind}, &success)
+	require.False(t, success)
+
+	// the delivery of a kind doesn't skip the records of the other kind after the restart
+	server.setFailing(false)
+	notifyEngine, klineEngine = newEngines()
+	notifyEngine.Write(testData{Height: 2, Kind: types.StreamDataNotifyKind}, &success)
+	require.True(t, success)
+	_, klineEngine = newEngines()
+	klineEngine.Write(testData{Height: 2, Kind: types.StreamDataKlineKind}, &success)
+	require.True(t, success)
+
+	posted := server.posted()
+	require.Len(t, posted, 2)
+	require.Equal(t, "notify-1-2", posted[0].ID)
+	require.Equal(t, []int64{1, 2}, batchHeights(posted[0]))
+	require.Equal(t, "kline-1-2", posted[1].ID)
+	require.Equal(t, []int64{1, 2}, batchHeights(posted[1]))
+}
+
+func TestParseConfig(t *testing.T) {
+	cfg, err := ParseConfig("https://a.com/hook; https://b.com/hook;batch_size=10;max_elapsed=3s", "/data/lock")
+	require.NoError(t, err)
+	require.Equal(t, []string{"https://a.com/hook", "https://b.com/hook"}, cfg.Targets)
+	require.Equal(t, 10, cfg.BatchSize)
+	require.Equal(t, 3*time.Second, cfg.MaxElapsed)
+	require.Equal(t, "/data/lock/"+spoolDirName, cfg.SpoolDir)
+
+	_, err = ParseConfig("https://a.com/hook", "")
+	require.Error(t, err)
+	_, err = ParseConfig("secret=x;spool_dir=/tmp", "")
+	require.Error(t, err)
+	_, err = ParseConfig("ftp://a.com;spool_dir=/tmp", "")
+	require.Error(t, err)
+	_, err = ParseConfig("https://a.com;unknown=1;spool_dir=/tmp", "")
+	require.Error(t, err)
+
+	require.Equal(t, 8*DefaultInitialBackoff, cfg.backoff(3))
+	require.Equal(t, DefaultMaxBackoff, cfg.backoff(10))
+	require.Equal(t, "https://a.com;secret=***", RedactURL("https://a.com;secret=x"))
+}
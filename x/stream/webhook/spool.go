@@ -0,0 +1,128 @@
+package webhook
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/okex/okexchain/x/stream/filesink"
+	"github.com/okex/okexchain/x/stream/types"
+)
+
+const (
+	spoolExt      = ".json"
+	deliveredFile = "delivered"
+)
+
+// spool persists the records undelivered to a target and the height of the last record delivered, the records are
+// spooled in the files named by their heights
+type spool struct {
+	dir string
+}
+
+// newSpool returns the spool of the target posted by the engine of the data kind under the dir. The engines of the
+// different kinds posting to the same target have their own spools, since their records share the heights
+func newSpool(dir string, kind types.StreamDataKind, target string) (*spool, error) {
+	sum := sha256.Sum256([]byte(target))
+	s := &spool{dir: filepath.Join(dir, filesink.DataKindPrefix(kind)+"-"+hex.EncodeToString(sum[:8]))}
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *spool) recordPath(height int64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", height, spoolExt))
+}
+
+// load returns the records spooled in the order of the heights
+func (s *spool) load() ([]filesink.Record, error) {
+	files, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []filesink.Record
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), spoolExt) {
+			continue
+		}
+		if _, err := strconv.ParseInt(strings.TrimSuffix(f.Name(), spoolExt), 10, 64); err != nil {
+			continue
+		}
+		bz, err := ioutil.ReadFile(filepath.Join(s.dir, f.Name()))
+		if err != nil {
+			return nil, err
+		}
+		var rec filesink.Record
+		if err := json.Unmarshal(bz, &rec); err != nil {
+			return nil, fmt.Errorf("invalid spooled record %s: %s", f.Name(), err.Error())
+		}
+		records = append(records, rec)
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].Height < records[j].Height
+	})
+	return records, nil
+}
+
+// save persists the record
+func (s *spool) save(rec filesink.Record) error {
+	bz, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	return writeFileSync(s.recordPath(rec.Height), bz)
+}
+
+// remove removes the records delivered
+func (s *spool) remove(records []filesink.Record) error {
+	for _, rec := range records {
+		if err := os.Remove(s.recordPath(rec.Height)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deliveredHeight returns the height of the last record delivered, which is 0 if nothing is delivered
+func (s *spool) deliveredHeight() (int64, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(s.dir, deliveredFile))
+	if os.IsNotExist(err) {
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(bz)), 10, 64)
+}
+
+func (s *spool) setDeliveredHeight(height int64) error {
+	return writeFileSync(filepath.Join(s.dir, deliveredFile), []byte(strconv.FormatInt(height, 10)))
+}
+
+// writeFileSync replaces the file atomically with the data synced to the disk
+func writeFileSync(path string, bz []byte) error {
+	tmp := path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(bz); err == nil {
+		err = f.Sync()
+	}
+	if cErr := f.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -0,0 +1,114 @@
+package websocket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	backend "github.com/okex/okexchain/x/backend/types"
+	farmtypes "github.com/okex/okexchain/x/farm/types"
+	"github.com/okex/okexchain/x/stream/common"
+	"github.com/okex/okexchain/x/stream/types"
+)
+
+// SwapPool is the state of a swap token pair pushed on the swap pool channel
+type SwapPool struct {
+	TokenPairName   string      `json:"swap_pair"`
+	BasePooledCoin  sdk.SysCoin `json:"base_pooled_coin"`
+	QuotePooledCoin sdk.SysCoin `json:"quote_pooled_coin"`
+	PoolTokenName   string      `json:"pool_token_name"`
+	PoolTokenAmount sdk.Dec     `json:"pool_token_amount"`
+	// Price is the price of the base token in the quote token
+	Price  sdk.Dec `json:"price"`
+	Height int64   `json:"height"`
+}
+
+// FarmPoolState is the state of a farm pool pushed on the farm pool channel, the pool is nil if it's destroyed
+type FarmPoolState struct {
+	PoolName  string              `json:"pool_name"`
+	Destroyed bool                `json:"destroyed"`
+	Pool      *farmtypes.FarmPool `json:"pool,omitempty"`
+	Height    int64               `json:"height"`
+}
+
+// FarmEarnings is the claimable rewards of an address in a farm pool pushed on the private farm earnings channel
+type FarmEarnings struct {
+	PoolName string `json:"pool_name"`
+	Address  string `json:"address"`
+	// Locked is false if the address has unlocked all from the pool
+	Locked bool `json:"locked"`
+	farmtypes.Earnings
+}
+
+// setAmmData collects the swap and the farm updates of the block
+func (data *PushData) setAmmData(ctx sdk.Context, swapKeeper types.SwapKeeper, farmKeeper types.FarmKeeper,
+	cache *common.Cache) {
+	logger := ctx.Logger().With("module", "stream")
+
+	for _, tokenPairName := range cache.GetUpdatedSwapPairs() {
+		swapTokenPair, err := swapKeeper.GetSwapTokenPair(ctx, tokenPairName)
+		if err != nil {
+			logger.Error("setAmmData", "swap pair", tokenPairName, "error", err.Error())
+			continue
+		}
+
+		price := sdk.ZeroDec()
+		if swapTokenPair.BasePooledCoin.IsPositive() {
+			price = swapTokenPair.QuotePooledCoin.Amount.Quo(swapTokenPair.BasePooledCoin.Amount)
+		}
+		data.SwapPoolsMap[tokenPairName] = SwapPool{
+			TokenPairName:   tokenPairName,
+			BasePooledCoin:  swapTokenPair.BasePooledCoin,
+			QuotePooledCoin: swapTokenPair.QuotePooledCoin,
+			PoolTokenName:   swapTokenPair.PoolTokenName,
+			PoolTokenAmount: swapKeeper.GetPoolTokenAmount(ctx, swapTokenPair.PoolTokenName),
+			Price:           price,
+			Height:          ctx.BlockHeight(),
+		}
+	}
+
+	for _, swapInfo := range cache.GetSwapInfos() {
+		data.SwapsMap[swapInfo.TokenPairName] = append(data.SwapsMap[swapInfo.TokenPairName], *swapInfo)
+	}
+
+	for _, liquidityInfo := range cache.GetSwapLiquidityInfos() {
+		data.SwapLiquidityMap[liquidityInfo.TokenPairName] = append(data.SwapLiquidityMap[liquidityInfo.TokenPairName],
+			*liquidityInfo)
+	}
+
+	for _, poolName := range cache.GetUpdatedFarmPools() {
+		state := FarmPoolState{PoolName: poolName, Height: ctx.BlockHeight()}
+		if pool, found := farmKeeper.GetFarmPool(ctx, poolName); found {
+			state.Pool = &pool
+		} else {
+			state.Destroyed = true
+		}
+		data.FarmPoolsMap[poolName] = state
+	}
+
+	for _, lock := range cache.GetUpdatedFarmLocks() {
+		address, err := sdk.AccAddressFromBech32(lock.Address)
+		if err != nil {
+			continue
+		}
+
+		earnings := FarmEarnings{
+			PoolName: lock.PoolName,
+			Address:  lock.Address,
+			Locked:   true,
+		}
+		earnings.Earnings, err = farmKeeper.GetEarnings(ctx, lock.PoolName, address)
+		if err != nil {
+			earnings.Locked = false
+			earnings.Earnings = farmtypes.NewEarnings(ctx.BlockHeight(), sdk.SysCoin{Amount: sdk.ZeroDec()}, sdk.SysCoins{})
+		}
+		data.FarmEarningsMap[lock.PoolName+":"+lock.Address] = earnings
+	}
+}
+
+// newAmmDataMaps initializes the maps of the swap and the farm updates
+func (data *PushData) newAmmDataMaps() {
+	data.SwapPoolsMap = make(map[string]SwapPool)
+	data.SwapsMap = make(map[string][]backend.SwapInfo)
+	data.SwapLiquidityMap = make(map[string][]common.SwapLiquidityInfo)
+	data.FarmPoolsMap = make(map[string]FarmPoolState)
+	data.FarmEarningsMap = make(map[string]FarmEarnings)
+}
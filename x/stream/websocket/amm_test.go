@@ -0,0 +1,95 @@
+package websocket
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/ammswap"
+	ammswaptypes "github.com/okex/okexchain/x/ammswap/types"
+	backend "github.com/okex/okexchain/x/backend/types"
+	farmtypes "github.com/okex/okexchain/x/farm/types"
+	"github.com/okex/okexchain/x/stream/common"
+)
+
+type mockSwapKeeper struct {
+	pairs map[string]ammswap.SwapTokenPair
+}
+
+func (k mockSwapKeeper) SetObserverKeeper(ammswaptypes.BackendKeeper) {}
+
+func (k mockSwapKeeper) GetSwapTokenPairs(sdk.Context) []ammswap.SwapTokenPair { return nil }
+
+func (k mockSwapKeeper) GetSwapTokenPair(_ sdk.Context, tokenPairName string) (ammswap.SwapTokenPair, error) {
+	pair, ok := k.pairs[tokenPairName]
+	if !ok {
+		return pair, errors.New("not found")
+	}
+	return pair, nil
+}
+
+func (k mockSwapKeeper) GetPoolTokenAmount(sdk.Context, string) sdk.Dec { return sdk.NewDec(100) }
+
+type mockFarmKeeper struct {
+	pools map[string]farmtypes.FarmPool
+}
+
+func (k mockFarmKeeper) SetObserverKeeper(farmtypes.ObserverKeeper) {}
+
+func (k mockFarmKeeper) GetFarmPool(_ sdk.Context, poolName string) (farmtypes.FarmPool, bool) {
+	pool, ok := k.pools[poolName]
+	return pool, ok
+}
+
+func (k mockFarmKeeper) GetEarnings(ctx sdk.Context, poolName string, _ sdk.AccAddress) (farmtypes.Earnings, sdk.Error) {
+	if _, ok := k.pools[poolName]; !ok {
+		return farmtypes.Earnings{}, farmtypes.ErrNoFarmPoolFound(farmtypes.DefaultCodespace, poolName)
+	}
+	return farmtypes.NewEarnings(ctx.BlockHeight(), sdk.NewDecCoinFromDec("xxb", sdk.NewDec(10)),
+		sdk.NewDecCoinsFromDec("okt", sdk.NewDec(1))), nil
+}
+
+func TestSetAmmData(t *testing.T) {
+	ctx := sdk.NewContext(nil, abci.Header{Height: 10}, false, log.NewNopLogger())
+	swapKeeper := mockSwapKeeper{pairs: map[string]ammswap.SwapTokenPair{
+		"xxb_okt": {
+			BasePooledCoin:  sdk.NewDecCoinFromDec("xxb", sdk.NewDec(100)),
+			QuotePooledCoin: sdk.NewDecCoinFromDec("okt", sdk.NewDec(250)),
+			PoolTokenName:   "ammswap_xxb_okt",
+		},
+	}}
+	farmKeeper := mockFarmKeeper{pools: map[string]farmtypes.FarmPool{"pool": {Name: "pool"}}}
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	cache := common.NewCache()
+	cache.AddUpdatedSwapPair("xxb_okt")
+	cache.AddUpdatedSwapPair("unknown_okt")
+	cache.AddSwapInfo(&backend.SwapInfo{TokenPairName: "xxb_okt"})
+	cache.AddSwapLiquidityInfo(&common.SwapLiquidityInfo{TokenPairName: "xxb_okt", Type: common.LiquidityTypeAdd})
+	cache.AddUpdatedFarmPool("pool")
+	cache.AddUpdatedFarmPool("destroyed")
+	cache.AddUpdatedFarmLock("pool", addr)
+	cache.AddUpdatedFarmLock("destroyed", addr)
+
+	data := NewPushData()
+	data.setAmmData(ctx, swapKeeper, farmKeeper, cache)
+
+	require.Len(t, data.SwapPoolsMap, 1)
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), data.SwapPoolsMap["xxb_okt"].Price)
+	require.Equal(t, sdk.NewDec(100), data.SwapPoolsMap["xxb_okt"].PoolTokenAmount)
+	require.Len(t, data.SwapsMap["xxb_okt"], 1)
+	require.Len(t, data.SwapLiquidityMap["xxb_okt"], 1)
+
+	require.NotNil(t, data.FarmPoolsMap["pool"].Pool)
+	require.True(t, data.FarmPoolsMap["destroyed"].Destroyed)
+
+	earnings := data.FarmEarningsMap["pool:"+addr.String()]
+	require.True(t, earnings.Locked)
+	require.Equal(t, sdk.NewDec(10), earnings.AmountLocked.Amount)
+	require.False(t, data.FarmEarningsMap["destroyed:"+addr.String()].Locked)
+}
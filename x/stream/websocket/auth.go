@@ -0,0 +1,101 @@
+package websocket
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmcrypto "github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
+)
+
+const (
+	// key types supported by the login
+	keyTypeSecp256k1    = "secp256k1"
+	keyTypeEthSecp256k1 = "eth_secp256k1"
+
+	loginNonceLength = 32
+	loginSignPrefix  = "okexchain websocket login:"
+)
+
+// loginChallenge is the nonce issued to a connection, which must be signed by the client to log in
+type loginChallenge struct {
+	nonce    string
+	expireAt time.Time
+}
+
+func newLoginChallenge(now time.Time) (*loginChallenge, error) {
+	bz := make([]byte, loginNonceLength)
+	if _, err := rand.Read(bz); err != nil {
+		return nil, err
+	}
+
+	return &loginChallenge{
+		nonce:    hex.EncodeToString(bz),
+		expireAt: now.Add(loginChallengeTTL),
+	}, nil
+}
+
+// loginSession is the address logged in on a connection
+type loginSession struct {
+	address  string
+	expireAt time.Time
+}
+
+func (s *loginSession) valid(now time.Time) bool {
+	return s != nil && now.Before(s.expireAt)
+}
+
+// loginSignBytes returns the message signed by the client to log in with the nonce
+func loginSignBytes(nonce string) []byte {
+	return []byte(loginSignPrefix + nonce)
+}
+
+// verifyLogin verifies the signature of the nonce and returns the address of the public key
+func verifyLogin(keyType, pubKeyHex, sigHex, nonce string) (string, *ErrorResponse) {
+	pubKeyBytes, err := hex.DecodeString(strings.TrimPrefix(pubKeyHex, "0x"))
+	if err != nil || len(pubKeyBytes) != secp256k1.PubKeySecp256k1Size {
+		return "", newErrorResponse(errCodeInvalidPubKey, fmt.Sprintf("invalid compressed public key: %s", pubKeyHex))
+	}
+	sig, err := hex.DecodeString(strings.TrimPrefix(sigHex, "0x"))
+	if err != nil {
+		return "", newErrorResponse(errCodeInvalidSignature, fmt.Sprintf("invalid signature: %s", sigHex))
+	}
+
+	var pubKey tmcrypto.PubKey
+	switch keyType {
+	case keyTypeSecp256k1:
+		var key secp256k1.PubKeySecp256k1
+		copy(key[:], pubKeyBytes)
+		pubKey = key
+	case keyTypeEthSecp256k1:
+		pubKey = ethsecp256k1.PubKey(pubKeyBytes)
+	default:
+		return "", newErrorResponse(errCodeUnsupportedKeyType, fmt.Sprintf("unsupported key type: %s, expected %s or %s",
+			keyType, keyTypeSecp256k1, keyTypeEthSecp256k1))
+	}
+
+	if !pubKey.VerifyBytes(loginSignBytes(nonce), sig) {
+		return "", newErrorResponse(errCodeInvalidSignature, "signature verification failed")
+	}
+	return sdk.AccAddress(pubKey.Address()).String(), nil
+}
+
+// privateTopicAddress returns the address of the private topic, which is the last part of the filter
+func privateTopicAddress(topic *SubscriptionTopic) string {
+	idx := strings.LastIndex(topic.Filter, ":")
+	return topic.Filter[idx+1:]
+}
+
+func newErrorResponse(code int, message string) *ErrorResponse {
+	return &ErrorResponse{
+		Event:     "error",
+		Message:   message,
+		ErrorCode: code,
+	}
+}
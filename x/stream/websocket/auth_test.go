@@ -0,0 +1,84 @@
+package websocket
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+
+	"github.com/okex/okexchain/app/crypto/ethsecp256k1"
+)
+
+func TestVerifyLogin(t *testing.T) {
+	now := time.Now()
+	challenge, err := newLoginChallenge(now)
+	require.NoError(t, err)
+	require.Len(t, challenge.nonce, 2*loginNonceLength)
+	require.Equal(t, now.Add(loginChallengeTTL), challenge.expireAt)
+
+	ethPriv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	ethPub := ethPriv.PubKey().(ethsecp256k1.PubKey)
+	ethSig, err := ethPriv.Sign(loginSignBytes(challenge.nonce))
+	require.NoError(t, err)
+
+	priv := secp256k1.GenPrivKey()
+	pub := priv.PubKey().(secp256k1.PubKeySecp256k1)
+	sig, err := priv.Sign(loginSignBytes(challenge.nonce))
+	require.NoError(t, err)
+	otherSig, err := priv.Sign(loginSignBytes("other nonce"))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		keyType    string
+		pubKey     string
+		sig        string
+		expAddress string
+		expCode    int
+	}{
+		{"eth_secp256k1", keyTypeEthSecp256k1, hex.EncodeToString(ethPub), "0x" + hex.EncodeToString(ethSig),
+			sdk.AccAddress(ethPub.Address()).String(), 0},
+		{"secp256k1", keyTypeSecp256k1, hex.EncodeToString(pub[:]), hex.EncodeToString(sig),
+			sdk.AccAddress(pub.Address()).String(), 0},
+		{"signature of another nonce", keyTypeSecp256k1, hex.EncodeToString(pub[:]), hex.EncodeToString(otherSig),
+			"", errCodeInvalidSignature},
+		{"mismatched key type", keyTypeEthSecp256k1, hex.EncodeToString(pub[:]), hex.EncodeToString(sig),
+			"", errCodeInvalidSignature},
+		{"unsupported key type", "ed25519", hex.EncodeToString(pub[:]), hex.EncodeToString(sig),
+			"", errCodeUnsupportedKeyType},
+		{"invalid public key", keyTypeSecp256k1, "0x1234", hex.EncodeToString(sig), "", errCodeInvalidPubKey},
+		{"invalid signature", keyTypeSecp256k1, hex.EncodeToString(pub[:]), "xyz", "", errCodeInvalidSignature},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, errResp := verifyLogin(tc.keyType, tc.pubKey, tc.sig, challenge.nonce)
+			if tc.expCode == 0 {
+				require.Nil(t, errResp)
+				require.Equal(t, tc.expAddress, address)
+			} else {
+				require.NotNil(t, errResp)
+				require.Equal(t, tc.expCode, errResp.ErrorCode)
+				require.True(t, errResp.Valid())
+			}
+		})
+	}
+}
+
+func TestLoginSession(t *testing.T) {
+	now := time.Now()
+	var session *loginSession
+	require.False(t, session.valid(now))
+
+	session = &loginSession{address: "okexchain1abc", expireAt: now.Add(loginSessionTTL)}
+	require.True(t, session.valid(now))
+	require.False(t, session.valid(now.Add(loginSessionTTL)))
+
+	topic := FormSubscriptionTopic("dex_spot/account:tokt:okexchain1abc")
+	require.True(t, topic.NeedLogin())
+	require.Equal(t, "okexchain1abc", privateTopicAddress(topic))
+}
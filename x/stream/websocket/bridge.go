@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"runtime/debug"
+	"sync"
 	"time"
 
 	"github.com/spf13/viper"
@@ -36,11 +37,15 @@ func newContext() *Context {
 }
 
 type Conn struct {
-	cliConn      *websocket.Conn
-	rpcConn      *rpccli.HTTP
-	ctx          *Context
-	logger       log.Logger
-	loginAddress string
+	cliConn *websocket.Conn
+	rpcConn *rpccli.HTTP
+	ctx     *Context
+	logger  log.Logger
+
+	// authMtx guards the login state, which is read by the rpc event handler as well
+	authMtx   sync.RWMutex
+	challenge *loginChallenge
+	session   *loginSession
 
 	cliInChan    chan []byte
 	cliOutChan   chan interface{}
@@ -252,14 +257,27 @@ func (conn *Conn) handleRPCEventReceived() {
 	conn.logger.Debug("handleRPCEventReceived start")
 
 	convertors := map[string]func(event ctypes.ResultEvent, topic *SubscriptionTopic) (interface{}, error){
-		DexSpotAccount:     conn.convert2WSTableResponseFromMap,
-		DexSpotTicker:      conn.convert2WSTableResponseFromMap,
-		DexSpotOrder:       conn.convertWSTableResponseFromList,
-		DexSpotAllTicker3s: conn.convertWSTableResponseFromList,
+		DexSpotAccount:       conn.convert2WSTableResponseFromMap,
+		DexSpotTicker:        conn.convert2WSTableResponseFromMap,
+		DexSpotOrder:         conn.convertWSTableResponseFromList,
+		DexSpotAllTicker3s:   conn.convertWSTableResponseFromList,
+		DexSpotSwap:          conn.convertWSTableResponseFromList,
+		DexSpotSwapLiquidity: conn.convertWSTableResponseFromList,
 	}
 
+	// queries of the private channels which have been unsubscribed because of the expired login session
+	expiredQueries := make(map[string]bool)
+
 	for evt := range conn.rpcEventChan {
 		topic := query2SubscriptionTopic(evt.Query)
+		if topic != nil && topic.NeedLogin() && !conn.isLoggedIn(privateTopicAddress(topic), time.Now()) {
+			if !expiredQueries[evt.Query] {
+				expiredQueries[evt.Query] = true
+				conn.unsubscribeExpired(topic, evt.Query)
+			}
+			continue
+		}
+
 		if topic != nil {
 			convertFunc := convertors[topic.Channel]
 			if convertFunc == nil {
@@ -301,16 +319,12 @@ func (conn *Conn) cliSubscribe(op *BaseOp) (err error) {
 			}
 			// private channel
 			if topic.NeedLogin() {
-				if conn.loginAddress == "" {
-					errResp := ErrorResponse{
-						Event:     "error",
-						Message:   fmt.Sprintf("User not logged in / User must be logined in, before subscribe:%s", topic.Channel),
-						ErrorCode: 30041,
-					}
-					conn.cliOutChan <- errResp
+				address, errResp := conn.loginAddress(topic, true)
+				if errResp != nil {
+					conn.cliOutChan <- *errResp
 					continue
 				}
-				topic.Filter = fmt.Sprintf("%s:%s", topic.Filter, conn.loginAddress)
+				topic.Filter = fmt.Sprintf("%s:%s", topic.Filter, address)
 			}
 			topics = append(topics, topic)
 
@@ -358,7 +372,7 @@ func (conn *Conn) cliSubscribe(op *BaseOp) (err error) {
 				errResp := ErrorResponse{
 					Event:     "error",
 					Message:   fmt.Sprintf("fail to subscribe %s, error: %s", channel, rpcErr.Error()),
-					ErrorCode: 30043,
+					ErrorCode: errCodeRequestFailed,
 				}
 				conn.cliOutChan <- errResp
 			}
@@ -369,6 +383,7 @@ func (conn *Conn) cliSubscribe(op *BaseOp) (err error) {
 	// 4. push initial data
 	initialDataMap := map[string]func(topic *SubscriptionTopic){
 		DexSpotDepthBook: conn.initialDepthBook,
+		DexSpotDepthDiff: conn.initialDepthDiff,
 	}
 	for _, topic := range topics {
 		initialDataFunc, ok := initialDataMap[topic.Channel]
@@ -395,6 +410,21 @@ func (conn *Conn) initialDepthBook(topic *SubscriptionTopic) {
 	conn.cliOutChan <- resp
 }
 
+// initialDepthDiff pushes the whole depth book with its sequence, on which the following diffs are applied
+func (conn *Conn) initialDepthDiff(topic *SubscriptionTopic) {
+	partial, ok := GetDepthPartialFromCache(topic.Filter)
+	conn.logger.Debug("initialDepthDiff", "partial", partial, "ok", ok)
+	if !ok {
+		return
+	}
+	resp := TableResponse{
+		Table:  topic.Channel,
+		Action: "partial",
+		Data:   []interface{}{partial},
+	}
+	conn.cliOutChan <- resp
+}
+
 func (conn *Conn) receiveRPCResultEvents(eventCh <-chan ctypes.ResultEvent, subscriber, channel string) {
 	conn.logger.Debug("receiveRPCResultEvents start", subscriber, channel)
 
@@ -429,18 +459,14 @@ func (conn *Conn) cliUnSubscribe(op *BaseOp) (err error) {
 			if topic == nil {
 				continue
 			}
-			// private channel
+			// private channel, which can be unsubscribed after the login session expires
 			if topic.NeedLogin() {
-				if conn.loginAddress == "" {
-					errResp := ErrorResponse{
-						Event:     "error",
-						Message:   fmt.Sprintf("User not logged in / User must be logined in, before subscribe:%s", topic.Channel),
-						ErrorCode: 30041,
-					}
-					conn.cliOutChan <- errResp
+				address, errResp := conn.loginAddress(topic, false)
+				if errResp != nil {
+					conn.cliOutChan <- *errResp
 					continue
 				}
-				topic.Filter = fmt.Sprintf("%s:%s", topic.Filter, conn.loginAddress)
+				topic.Filter = fmt.Sprintf("%s:%s", topic.Filter, address)
 			}
 			topics = append(topics, topic)
 		}
@@ -471,7 +497,7 @@ func (conn *Conn) cliUnSubscribe(op *BaseOp) (err error) {
 				errResp := ErrorResponse{
 					Event:     "error",
 					Message:   fmt.Sprintf("fail to unsubscribe %s, error: %s", channel, rpcErr.Error()),
-					ErrorCode: 30043,
+					ErrorCode: errCodeRequestFailed,
 				}
 				conn.cliOutChan <- errResp
 			}
@@ -482,20 +508,107 @@ func (conn *Conn) cliUnSubscribe(op *BaseOp) (err error) {
 	return err
 }
 
+// loginAddress returns the address logged in on the connection. The expired session is rejected if requireValid is set
+func (conn *Conn) loginAddress(topic *SubscriptionTopic, requireValid bool) (string, *ErrorResponse) {
+	conn.authMtx.RLock()
+	defer conn.authMtx.RUnlock()
+
+	if conn.session == nil {
+		return "", newErrorResponse(errCodeNotLogin,
+			fmt.Sprintf("User not logged in / User must be logined in, before subscribe:%s", topic.Channel))
+	}
+	if requireValid && !conn.session.valid(time.Now()) {
+		return "", newErrorResponse(errCodeSessionExpired,
+			fmt.Sprintf("login session expired, login again before subscribe:%s", topic.Channel))
+	}
+	return conn.session.address, nil
+}
+
+// isLoggedIn returns whether the address is logged in on the connection with a valid session
+func (conn *Conn) isLoggedIn(address string, now time.Time) bool {
+	conn.authMtx.RLock()
+	defer conn.authMtx.RUnlock()
+
+	return conn.session.valid(now) && conn.session.address == address
+}
+
+// unsubscribeExpired unsubscribes the private channel whose login session has expired or been replaced
+func (conn *Conn) unsubscribeExpired(topic *SubscriptionTopic, query string) {
+	channel, _ := topic.ToString()
+	conn.cliOutChan <- *newErrorResponse(errCodeSessionExpired,
+		fmt.Sprintf("login session expired, %s is unsubscribed", channel))
+
+	if conn.rpcConn == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), maxRPCContextTimeout)
+	defer cancel()
+	if err := conn.rpcConn.Unsubscribe(ctx, conn.getSubsciber(), query); err != nil {
+		conn.logger.Error("unsubscribeExpired", "query", query, "error", err.Error())
+	}
+}
+
+// cliLoginChallenge issues a nonce to the client, which must be signed to log in
+func (conn *Conn) cliLoginChallenge(op *BaseOp) error {
+	now := time.Now()
+	challenge, err := newLoginChallenge(now)
+	if err != nil {
+		conn.cliOutChan <- *newErrorResponse(errCodeRequestFailed, fmt.Sprintf("fail to issue login challenge: %s", err))
+		return err
+	}
+
+	conn.authMtx.Lock()
+	conn.challenge = challenge
+	conn.authMtx.Unlock()
+
+	conn.cliOutChan <- LoginChallengeResponse{
+		Event:     eventLoginChallenge,
+		Nonce:     challenge.nonce,
+		Message:   string(loginSignBytes(challenge.nonce)),
+		ExpiresAt: challenge.expireAt.Unix(),
+	}
+	return nil
+}
+
+// cliLogin verifies the signature of the login challenge with the args [key type, public key hex, signature hex].
+// The nonce is used only once, a failed login requires a new challenge
 func (conn *Conn) cliLogin(op *BaseOp) error {
-	if op == nil || op.Op != eventLogin || len(op.Args) != 1 {
-		err := fmt.Errorf("invalid request, when doing: %s", eventLogin)
-		errResp := ErrorResponse{
-			Event:     "error",
-			Message:   err.Error(),
-			ErrorCode: 30043,
-		}
-		conn.cliOutChan <- errResp
+	if op == nil || op.Op != eventLogin || len(op.Args) != 3 {
+		err := fmt.Errorf("invalid request, when doing: %s, expected args: [key type, public key, signature]", eventLogin)
+		conn.cliOutChan <- *newErrorResponse(errCodeRequestFailed, err.Error())
 
 		conn.logger.Error(err.Error())
 		return err
 	}
-	conn.loginAddress = op.Args[0]
+
+	now := time.Now()
+	conn.authMtx.Lock()
+	challenge := conn.challenge
+	conn.challenge = nil
+	conn.authMtx.Unlock()
+
+	if challenge == nil || !now.Before(challenge.expireAt) {
+		conn.cliOutChan <- *newErrorResponse(errCodeChallengeRequired,
+			fmt.Sprintf("login challenge not requested or expired, request one by %s", eventLoginChallenge))
+		return nil
+	}
+
+	address, errResp := verifyLogin(op.Args[0], op.Args[1], op.Args[2], challenge.nonce)
+	if errResp != nil {
+		conn.logger.Debug("cliLogin failed", "error", errResp.Message)
+		conn.cliOutChan <- *errResp
+		return nil
+	}
+
+	conn.authMtx.Lock()
+	conn.session = &loginSession{address: address, expireAt: now.Add(loginSessionTTL)}
+	conn.authMtx.Unlock()
+
+	conn.cliOutChan <- EventResponse{
+		Event:   eventLoginSuccess,
+		Success: "true",
+		Channel: address,
+	}
 	return nil
 }
 
@@ -509,9 +622,10 @@ func (conn *Conn) handleConvert() {
 	conn.logger.Debug("handleConvert start")
 
 	cliEventMap := map[string]func(op *BaseOp) error{
-		eventSubscribe:   conn.cliSubscribe,
-		eventUnsubscribe: conn.cliUnSubscribe,
-		eventLogin:       conn.cliLogin,
+		eventSubscribe:      conn.cliSubscribe,
+		eventUnsubscribe:    conn.cliUnSubscribe,
+		eventLogin:          conn.cliLogin,
+		eventLoginChallenge: conn.cliLoginChallenge,
 	}
 
 	for cliInMsg := range conn.cliInChan {
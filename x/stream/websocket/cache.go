@@ -12,7 +12,9 @@ import (
 
 type cache struct {
 	depthBooksMap map[string]pushservice.BookRes
-	lock          sync.RWMutex
+	// sequence of the depth diffs per product
+	depthSeqMap map[string]uint64
+	lock        sync.RWMutex
 }
 
 var (
@@ -34,6 +36,7 @@ func InitialCache(ctx sdk.Context, orderKeeper types.OrderKeeper, dexKeeper type
 		logger.Debug("initial websocket cache", "depthbook", depthBooksMap)
 		singletonCache = &cache{
 			depthBooksMap: depthBooksMap,
+			depthSeqMap:   make(map[string]uint64, len(tokenPairs)),
 		}
 	})
 }
@@ -50,3 +53,29 @@ func UpdateDepthBookCache(product string, bookRes pushservice.BookRes) {
 	defer singletonCache.lock.Unlock()
 	singletonCache.depthBooksMap[product] = bookRes
 }
+
+// UpdateDepthBookCacheWithDiff updates the depth book of the product and returns the diff from the cached one. The
+// sequence of the product is increased only if the diff changes something
+func UpdateDepthBookCacheWithDiff(product string, bookRes pushservice.BookRes) (diff DepthDiff, changed bool) {
+	singletonCache.lock.Lock()
+	defer singletonCache.lock.Unlock()
+
+	diff = newDepthDiff(singletonCache.depthBooksMap[product], bookRes, singletonCache.depthSeqMap[product])
+	singletonCache.depthBooksMap[product] = bookRes
+	if diff.Empty() {
+		return diff, false
+	}
+	singletonCache.depthSeqMap[product] = diff.Seq
+	return diff, true
+}
+
+// GetDepthPartialFromCache returns the whole depth book of the product with its sequence
+func GetDepthPartialFromCache(product string) (partial DepthDiff, ok bool) {
+	singletonCache.lock.RLock()
+	defer singletonCache.lock.RUnlock()
+	depthBook, ok := singletonCache.depthBooksMap[product]
+	if !ok {
+		return partial, false
+	}
+	return newDepthPartial(depthBook, singletonCache.depthSeqMap[product]), true
+}
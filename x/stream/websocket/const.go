@@ -21,10 +21,34 @@ const (
 	DexSpotAllTicker3s = "dex_spot/all_ticker_3s"
 	DexSpotTicker      = "dex_spot/ticker"
 	DexSpotDepthBook   = "dex_spot/optimized_depth"
+	DexSpotDepthDiff   = "dex_spot/depth_diff"
+	DexSpotHalt        = "dex_spot/halt"
 
-	eventSubscribe   = "subscribe"
-	eventUnsubscribe = "unsubscribe"
-	eventLogin       = "dex_jwt"
+	DexSpotSwapPool      = "dex_spot/swap_pool"
+	DexSpotSwap          = "dex_spot/swap"
+	DexSpotSwapLiquidity = "dex_spot/swap_liquidity"
+	DexSpotFarmPool      = "dex_spot/farm_pool"
+	DexSpotFarmEarnings  = "dex_spot/farm_earnings"
+
+	eventSubscribe      = "subscribe"
+	eventUnsubscribe    = "unsubscribe"
+	eventLogin          = "dex_jwt"
+	eventLoginChallenge = "login_challenge"
+	eventLoginSuccess   = "login"
+
+	// the nonce of a login challenge must be signed within the ttl
+	loginChallengeTTL = time.Minute
+	// a login session expires after the ttl, the client must log in again to keep receiving the private channels
+	loginSessionTTL = 24 * time.Hour
+
+	// error codes responded to the client
+	errCodeNotLogin           = 30041
+	errCodeRequestFailed      = 30043
+	errCodeChallengeRequired  = 30044
+	errCodeInvalidPubKey      = 30045
+	errCodeInvalidSignature   = 30046
+	errCodeUnsupportedKeyType = 30047
+	errCodeSessionExpired     = 30048
 )
 
 var (
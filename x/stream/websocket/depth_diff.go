@@ -0,0 +1,104 @@
+package websocket
+
+import (
+	"hash/crc32"
+	"sort"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	pushservice "github.com/okex/okexchain/x/stream/pushservice/types"
+)
+
+// depthChecksumLevels is the number of the top levels on each side covered by the checksum
+const depthChecksumLevels = 25
+
+// DepthDiff is the change of the depth book of a product, which is pushed on the depth diff channel.
+// The levels are [price, quantity, order count], a level with the quantity "0" has been removed.
+// The partial pushed on subscription carries the whole book at Seq, a diff must be applied only if its PrevSeq is
+// equal to the Seq of the book applied last, otherwise the client must resubscribe to get a new partial
+type DepthDiff struct {
+	Product   string     `json:"instrument_id"`
+	Asks      [][]string `json:"asks"`
+	Bids      [][]string `json:"bids"`
+	PrevSeq   uint64     `json:"prev_seq"`
+	Seq       uint64     `json:"seq"`
+	Checksum  int32      `json:"checksum"`
+	Timestamp string     `json:"timestamp"`
+}
+
+// Empty returns whether the diff changes nothing
+func (diff DepthDiff) Empty() bool {
+	return len(diff.Asks) == 0 && len(diff.Bids) == 0
+}
+
+// newDepthDiff returns the diff from the previous book to the current one
+func newDepthDiff(prev, cur pushservice.BookRes, prevSeq uint64) DepthDiff {
+	return DepthDiff{
+		Product:   cur.Product,
+		Asks:      diffBookLevels(prev.Asks, cur.Asks, false),
+		Bids:      diffBookLevels(prev.Bids, cur.Bids, true),
+		PrevSeq:   prevSeq,
+		Seq:       prevSeq + 1,
+		Checksum:  depthChecksum(cur),
+		Timestamp: cur.Timestamp,
+	}
+}
+
+// newDepthPartial returns the whole book at the sequence
+func newDepthPartial(book pushservice.BookRes, seq uint64) DepthDiff {
+	return DepthDiff{
+		Product:   book.Product,
+		Asks:      book.Asks,
+		Bids:      book.Bids,
+		Seq:       seq,
+		Checksum:  depthChecksum(book),
+		Timestamp: time.Now().UTC().Format("2006-01-02T15:04:05.000Z"),
+	}
+}
+
+// diffBookLevels returns the changed and removed levels of one side of the book, ordered by the price in the same
+// way as the book
+func diffBookLevels(prev, cur [][]string, descending bool) [][]string {
+	prevLevels := make(map[string][]string, len(prev))
+	for _, level := range prev {
+		prevLevels[level[0]] = level
+	}
+
+	diff := [][]string{}
+	for _, level := range cur {
+		prevLevel, ok := prevLevels[level[0]]
+		delete(prevLevels, level[0])
+		if !ok || prevLevel[1] != level[1] || prevLevel[2] != level[2] {
+			diff = append(diff, level)
+		}
+	}
+	for price := range prevLevels {
+		diff = append(diff, []string{price, "0", "0"})
+	}
+
+	sort.SliceStable(diff, func(i, j int) bool {
+		pi, pj := sdk.MustNewDecFromStr(diff[i][0]), sdk.MustNewDecFromStr(diff[j][0])
+		if descending {
+			return pi.GT(pj)
+		}
+		return pi.LT(pj)
+	})
+	return diff
+}
+
+// depthChecksum returns the crc32 checksum of the top levels of the book. The string checked is built by joining the
+// price and the quantity of the bid and the ask levels alternately with ':', e.g. bid1:bidQty1:ask1:askQty1:bid2:...
+func depthChecksum(book pushservice.BookRes) int32 {
+	var fields []string
+	for i := 0; i < depthChecksumLevels; i++ {
+		if i < len(book.Bids) {
+			fields = append(fields, book.Bids[i][0], book.Bids[i][1])
+		}
+		if i < len(book.Asks) {
+			fields = append(fields, book.Asks[i][0], book.Asks[i][1])
+		}
+	}
+	return int32(crc32.ChecksumIEEE([]byte(strings.Join(fields, ":"))))
+}
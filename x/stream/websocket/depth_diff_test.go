@@ -0,0 +1,67 @@
+package websocket
+
+import (
+	"hash/crc32"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pushservice "github.com/okex/okexchain/x/stream/pushservice/types"
+)
+
+func TestDiffBookLevels(t *testing.T) {
+	prev := [][]string{{"1.0", "10", "1"}, {"2.0", "20", "2"}, {"3.0", "30", "3"}}
+	cur := [][]string{{"1.0", "10", "1"}, {"2.0", "25", "3"}, {"10.0", "5", "1"}}
+
+	require.Equal(t, [][]string{{"2.0", "25", "3"}, {"3.0", "0", "0"}, {"10.0", "5", "1"}},
+		diffBookLevels(prev, cur, false))
+	require.Equal(t, [][]string{{"10.0", "5", "1"}, {"3.0", "0", "0"}, {"2.0", "25", "3"}},
+		diffBookLevels(prev, cur, true))
+	require.Empty(t, diffBookLevels(cur, cur, false))
+}
+
+func TestDepthChecksum(t *testing.T) {
+	book := pushservice.BookRes{
+		Asks: [][]string{{"3.0", "1", "1"}, {"4.0", "2", "1"}},
+		Bids: [][]string{{"2.0", "3", "1"}},
+	}
+	require.Equal(t, int32(crc32.ChecksumIEEE([]byte("2.0:3:3.0:1:4.0:2"))), depthChecksum(book))
+}
+
+func TestDepthDiffCache(t *testing.T) {
+	singletonCache = &cache{
+		depthBooksMap: map[string]pushservice.BookRes{
+			"xxb_okt": {Asks: [][]string{{"3.0", "1", "1"}}, Bids: [][]string{{"2.0", "3", "1"}}, Product: "xxb_okt"},
+		},
+		depthSeqMap: map[string]uint64{},
+	}
+
+	partial, ok := GetDepthPartialFromCache("xxb_okt")
+	require.True(t, ok)
+	require.Equal(t, uint64(0), partial.Seq)
+	require.Len(t, partial.Asks, 1)
+
+	// an unchanged book doesn't increase the sequence
+	_, changed := UpdateDepthBookCacheWithDiff("xxb_okt", singletonCache.depthBooksMap["xxb_okt"])
+	require.False(t, changed)
+
+	book := pushservice.BookRes{Asks: [][]string{{"3.0", "2", "2"}}, Bids: [][]string{}, Product: "xxb_okt"}
+	diff, changed := UpdateDepthBookCacheWithDiff("xxb_okt", book)
+	require.True(t, changed)
+	require.Equal(t, uint64(0), diff.PrevSeq)
+	require.Equal(t, uint64(1), diff.Seq)
+	require.Equal(t, [][]string{{"3.0", "2", "2"}}, diff.Asks)
+	require.Equal(t, [][]string{{"2.0", "0", "0"}}, diff.Bids)
+	require.Equal(t, depthChecksum(book), diff.Checksum)
+
+	// a new product starts from the empty book
+	diff, changed = UpdateDepthBookCacheWithDiff("yyb_okt", book)
+	require.True(t, changed)
+	require.Equal(t, uint64(1), diff.Seq)
+	require.Equal(t, book.Asks, diff.Asks)
+
+	partial, ok = GetDepthPartialFromCache("xxb_okt")
+	require.True(t, ok)
+	require.Equal(t, uint64(1), partial.Seq)
+	require.Equal(t, diff.Checksum, partial.Checksum)
+}
@@ -37,6 +37,14 @@ func (engine *Engine) NewEvent(channel string, data interface{}) (sdk.Event, err
 	), nil
 }
 
+func (engine *Engine) mustNewEvent(channel, key string, data interface{}) sdk.Event {
+	event, err := engine.NewEvent(fmt.Sprintf("%s:%s", channel, key), data)
+	if err != nil {
+		panic(err)
+	}
+	return event
+}
+
 func (engine *Engine) Write(data types.IStreamData, success *bool) {
 	defer func() {
 		if e := recover(); e != nil {
@@ -89,6 +97,38 @@ func (engine *Engine) Write(data types.IStreamData, success *bool) {
 		events = append(events, event)
 	}
 
+	// 5. collect depth_diff events
+	for key, value := range wsData.DepthDiffsMap {
+		channel := fmt.Sprintf("%s:%s", DexSpotDepthDiff, key)
+		event, err := engine.NewEvent(channel, value)
+		if err != nil {
+			panic(err)
+		}
+		events = append(events, event)
+	}
+
+	// 6. collect swap and farm events
+	for key, value := range wsData.SwapPoolsMap {
+		events = append(events, engine.mustNewEvent(DexSpotSwapPool, key, value))
+	}
+	for key, value := range wsData.SwapsMap {
+		events = append(events, engine.mustNewEvent(DexSpotSwap, key, value))
+	}
+	for key, value := range wsData.SwapLiquidityMap {
+		events = append(events, engine.mustNewEvent(DexSpotSwapLiquidity, key, value))
+	}
+	for key, value := range wsData.FarmPoolsMap {
+		events = append(events, engine.mustNewEvent(DexSpotFarmPool, key, value))
+	}
+	for key, value := range wsData.FarmEarningsMap {
+		events = append(events, engine.mustNewEvent(DexSpotFarmEarnings, key, value))
+	}
+
+	// 7. collect circuit breaker halt events
+	for key, value := range wsData.HaltsMap {
+		events = append(events, engine.mustNewEvent(DexSpotHalt, key, value))
+	}
+
 	wsData.eventMgr.EmitEvents(events)
 	*success = true
 }
@@ -8,7 +8,7 @@ type SubscriptionTopic struct {
 }
 
 func (st *SubscriptionTopic) NeedLogin() bool {
-	return st.Channel == DexSpotAccount || st.Channel == DexSpotOrder
+	return st.Channel == DexSpotAccount || st.Channel == DexSpotOrder || st.Channel == DexSpotFarmEarnings
 }
 
 func (st *SubscriptionTopic) ToString() (topic string, err error) {
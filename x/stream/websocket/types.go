@@ -2,6 +2,8 @@ package websocket
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	backend "github.com/okex/okexchain/x/backend/types"
+	"github.com/okex/okexchain/x/order"
 	"github.com/okex/okexchain/x/stream/common"
 	pushservice "github.com/okex/okexchain/x/stream/pushservice/types"
 	"github.com/okex/okexchain/x/stream/types"
@@ -9,26 +11,44 @@ import (
 
 type PushData struct {
 	*pushservice.RedisBlock
+	DepthDiffsMap map[string]DepthDiff
+
+	SwapPoolsMap     map[string]SwapPool
+	SwapsMap         map[string][]backend.SwapInfo
+	SwapLiquidityMap map[string][]common.SwapLiquidityInfo
+	FarmPoolsMap     map[string]FarmPoolState
+	FarmEarningsMap  map[string]FarmEarnings
+	// HaltsMap is the matching of the products paused by the circuit breaker in the block
+	HaltsMap map[string]order.ProductHalt
+
 	eventMgr *sdk.EventManager
 }
 
 func NewPushData() *PushData {
 	baseData := pushservice.NewRedisBlock()
-	pd := PushData{RedisBlock: baseData, eventMgr: nil}
+	pd := PushData{RedisBlock: baseData, DepthDiffsMap: make(map[string]DepthDiff),
+		HaltsMap: make(map[string]order.ProductHalt), eventMgr: nil}
+	pd.newAmmDataMaps()
 	return &pd
 }
 
 func (data *PushData) SetData(ctx sdk.Context, orderKeeper types.OrderKeeper, tokenKeeper types.TokenKeeper,
-	dexKeeper types.DexKeeper, swapKeeper types.SwapKeeper, cache *common.Cache) {
+	dexKeeper types.DexKeeper, swapKeeper types.SwapKeeper, farmKeeper types.FarmKeeper, cache *common.Cache) {
 	data.eventMgr = ctx.EventManager()
 	data.RedisBlock.SetData(ctx, orderKeeper, tokenKeeper, dexKeeper, swapKeeper, cache)
+	data.setAmmData(ctx, swapKeeper, farmKeeper, cache)
+	for _, halt := range orderKeeper.GetBlockProductHalts() {
+		data.HaltsMap[halt.Product] = halt
+	}
 
 	// update depthBook cache
 	products := orderKeeper.GetUpdatedDepthbookKeys()
 	for _, product := range products {
 		depthBook := orderKeeper.GetDepthBookCopy(product)
 		bookRes := pushservice.ConvertBookRes(product, orderKeeper, depthBook, 200)
-		UpdateDepthBookCache(product, bookRes)
+		if diff, changed := UpdateDepthBookCacheWithDiff(product, bookRes); changed {
+			data.DepthDiffsMap[product] = diff
+		}
 	}
 
 }
@@ -67,6 +87,14 @@ func (r *ErrorResponse) Valid() bool {
 	return len(r.Event) > 0 && len(r.Message) > 0 && r.ErrorCode >= 30000
 }
 
+// LoginChallengeResponse carries the nonce to be signed by the client for the login
+type LoginChallengeResponse struct {
+	Event     string `json:"event"`
+	Nonce     string `json:"nonce"`
+	Message   string `json:"message"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
 type BaseOp struct {
 	Op   string   `json:"op"`
 	Args []string `json:"args"`
@@ -0,0 +1,62 @@
+package upgrade
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/upgrade/keeper"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// BeginBlocker applies the upgrade in progress at its height. The protocol switches to the new version if the
+// validators signalling the version hold at least the threshold of the power, otherwise the upgrade fails. A node
+// whose software hasn't registered the upgrade handler of the version halts here, unless it skips the height
+func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
+	config, found := k.GetUpgradeConfig(ctx)
+	blockHeight := ctx.BlockHeight()
+	if !found || uint64(blockHeight) < config.ProtocolDef.Height {
+		return
+	}
+
+	protocolDef := config.ProtocolDef
+	tally := k.TallySignals(ctx, protocolDef.Version)
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(config.ProposalID, 10)),
+		sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(protocolDef.Version, 10)),
+		sdk.NewAttribute(types.AttributeKeySoftware, protocolDef.Software),
+		sdk.NewAttribute(types.AttributeKeySignalledRatio, tally.SignalledRatio.String()),
+	}
+
+	if tally.SignalledRatio.LT(protocolDef.Threshold) {
+		k.AbortUpgrade(ctx, config)
+		k.Logger(ctx).Info(fmt.Sprintf("BlockHeight<%d> upgrade to version %d failed, signalled ratio %s is "+
+			"lower than the threshold %s", blockHeight, protocolDef.Version, tally.SignalledRatio,
+			protocolDef.Threshold))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpgradeFailed, attributes...))
+		return
+	}
+
+	if k.IsSkipHeight(blockHeight) {
+		k.CompleteUpgrade(ctx, config)
+		k.Logger(ctx).Info(fmt.Sprintf("BlockHeight<%d> skip the upgrade handler of version %d", blockHeight,
+			protocolDef.Version))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpgradeSkipped, attributes...))
+		return
+	}
+
+	handler, found := k.GetUpgradeHandler(protocolDef.Version)
+	if !found {
+		msg := fmt.Sprintf("UPGRADE NEEDED at height %d: switch to the software %s of version %d", blockHeight,
+			protocolDef.Software, protocolDef.Version)
+		k.Logger(ctx).Error(msg)
+		panic(msg)
+	}
+
+	handler(ctx, protocolDef)
+	k.CompleteUpgrade(ctx, config)
+	k.Logger(ctx).Info(fmt.Sprintf("BlockHeight<%d> upgraded to version %d (%s)", blockHeight,
+		protocolDef.Version, protocolDef.Software))
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpgradeSucceed, attributes...))
+}
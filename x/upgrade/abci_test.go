@@ -0,0 +1,84 @@
+package upgrade
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common/proto"
+	"github.com/okex/okexchain/x/upgrade/keeper"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+func scheduleUpgrade(t *testing.T, input keeper.TestInput, height uint64) sdk.Context {
+	ctx := input.Ctx.WithBlockHeight(10)
+	protocolDef := proto.NewProtocolDefinition(1, "v1", height, sdk.MustNewDecFromStr("0.75"))
+	proposal := &govtypes.Proposal{ProposalID: 1, Content: types.NewAppUpgradeProposal("title", "description",
+		protocolDef)}
+	require.Nil(t, NewProposalHandler(&input.Keeper)(ctx, proposal))
+	return ctx
+}
+
+func TestBeginBlockerUpgradeFailed(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	k := input.Keeper
+	ctx := scheduleUpgrade(t, input, 20)
+	for _, valAddr := range input.ValAddrs[:2] {
+		require.Nil(t, k.Signal(ctx, valAddr, 1))
+	}
+
+	BeginBlocker(ctx.WithBlockHeight(19), k)
+	_, found := k.GetUpgradeConfig(ctx)
+	require.True(t, found)
+
+	// only a half of the power is signalled
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	BeginBlocker(ctx, k)
+	_, found = k.GetUpgradeConfig(ctx)
+	require.False(t, found)
+	require.Equal(t, types.VersionInfo{CurrentVersion: 0, LastFailedVersion: 1}, k.GetVersionInfo(ctx))
+	require.Equal(t, types.EventTypeUpgradeFailed, ctx.EventManager().Events()[0].Type)
+}
+
+func TestBeginBlockerUpgradeSucceed(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	k := input.Keeper
+	ctx := scheduleUpgrade(t, input, 20)
+	for _, valAddr := range input.ValAddrs[:3] {
+		require.Nil(t, k.Signal(ctx, valAddr, 1))
+	}
+
+	// the node halts without the upgrade handler of the version
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	require.Panics(t, func() { BeginBlocker(ctx, k) })
+
+	var migrated bool
+	k.SetUpgradeHandler(1, func(_ sdk.Context, protocolDef proto.ProtocolDefinition) {
+		require.EqualValues(t, 1, protocolDef.Version)
+		migrated = true
+	})
+	BeginBlocker(ctx, k)
+	require.True(t, migrated)
+	_, found := k.GetUpgradeConfig(ctx)
+	require.False(t, found)
+	require.Empty(t, k.GetSignals(ctx, 1))
+	require.EqualValues(t, 1, k.GetVersionInfo(ctx).CurrentVersion)
+	require.Equal(t, types.EventTypeUpgradeSucceed, ctx.EventManager().Events()[0].Type)
+}
+
+func TestBeginBlockerUpgradeSkipped(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	k := input.Keeper
+	ctx := scheduleUpgrade(t, input, 100)
+	for _, valAddr := range input.ValAddrs {
+		require.Nil(t, k.Signal(ctx, valAddr, 1))
+	}
+
+	// the height 100 is skipped by the node, the version is switched without the upgrade handler
+	ctx = ctx.WithBlockHeight(100).WithEventManager(sdk.NewEventManager())
+	require.NotPanics(t, func() { BeginBlocker(ctx, k) })
+	require.EqualValues(t, 1, k.GetVersionInfo(ctx).CurrentVersion)
+	require.Equal(t, types.EventTypeUpgradeSkipped, ctx.EventManager().Events()[0].Type)
+}
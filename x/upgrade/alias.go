@@ -0,0 +1,22 @@
+package upgrade
+
+import (
+	"github.com/okex/okexchain/x/upgrade/keeper"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+const (
+	StoreKey         = types.StoreKey
+	DefaultCodespace = types.DefaultCodespace
+	ModuleName       = types.ModuleName
+	RouterKey        = types.RouterKey
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+)
+
+type (
+	Keeper         = keeper.Keeper
+	UpgradeHandler = types.UpgradeHandler
+)
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	client "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/okex/okexchain/x/common/proto"
+	"github.com/spf13/cobra"
+
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	// Group upgrade queries under a subcommand
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	queryCmd.AddCommand(
+		client.GetCommands(
+			GetCmdQueryUpgradeConfig(queryRoute, cdc),
+			GetCmdQueryVersion(queryRoute, cdc),
+			GetCmdQuerySignals(queryRoute, cdc),
+		)...,
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryUpgradeConfig gets the upgrade config query command.
+func GetCmdQueryUpgradeConfig(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "config",
+		Short: "query the plan of the upgrade in progress",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the proposal, the version, the software, the height and the signalling threshold of
+the upgrade waiting for its height.
+
+Example:
+$ %s query upgrade config
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryUpgradeConfig)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var config proto.AppUpgradeConfig
+			cdc.MustUnmarshalJSON(bz, &config)
+			return cliCtx.PrintOutput(config)
+		},
+	}
+}
+
+// GetCmdQueryVersion gets the protocol version query command.
+func GetCmdQueryVersion(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "query the current protocol version",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the version of the protocol that the chain runs, and the last version failed to be
+upgraded to.
+
+Example:
+$ %s query upgrade version
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QueryVersion)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var versionInfo types.VersionInfo
+			cdc.MustUnmarshalJSON(bz, &versionInfo)
+			return cliCtx.PrintOutput(versionInfo)
+		},
+	}
+}
+
+// GetCmdQuerySignals gets the signals query command.
+func GetCmdQuerySignals(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "signals",
+		Short: "query the validators signalling the upgrade in progress",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validators signalling the version of the upgrade in progress, and the share of
+the power they hold.
+
+Example:
+$ %s query upgrade signals
+`,
+				version.ClientName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			route := fmt.Sprintf("custom/%s/%s", storeName, types.QuerySignals)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var tally types.SignalTally
+			cdc.MustUnmarshalJSON(bz, &tally)
+			return cliCtx.PrintOutput(tally)
+		},
+	}
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	client "github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/okex/okexchain/x/gov"
+	"github.com/spf13/cobra"
+
+	upgradeutils "github.com/okex/okexchain/x/upgrade/client/utils"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		SuggestionsMinimumDistance: 2,
+	}
+
+	txCmd.AddCommand(client.PostCommands(
+		GetCmdSignal(cdc),
+	)...)
+	return txCmd
+}
+
+// GetCmdSignal implements the signal command
+func GetCmdSignal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "signal [version]",
+		Short: "signal that the validator runs the software of the upgrade version",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Signal that the node of the validator runs the software supporting the version of the
+upgrade in progress. The upgrade takes effect only if the validators signalling it hold enough power at the
+upgrade height. The transaction must be signed by the operator of the validator.
+
+Example:
+$ %s tx upgrade signal 1 --from mykey
+`, version.ClientName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			upgradeVersion, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid version %s: %s", args[0], err)
+			}
+
+			msg := types.NewMsgSignal(sdk.ValAddress(cliCtx.GetFromAddress()), upgradeVersion)
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdAppUpgradeProposal implements a command handler for submitting an app upgrade proposal transaction
+func GetCmdAppUpgradeProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "app-upgrade [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to upgrade the protocol to a new version at a block height",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit an app upgrade proposal along with an initial deposit.
+The proposal details must be supplied via a JSON file. Once the proposal passes, the protocol switches to the new
+version at the height if the validators signalling the version hold at least the threshold of the power, otherwise
+the upgrade fails. The nodes whose software doesn't support the new version halt at the height.
+
+Example:
+$ %s tx gov submit-proposal app-upgrade <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+ "title": "upgrade to version 1",
+ "description": "switch to the software v1.0.0 at height 1000000",
+ "protocol_definition": {
+   "version": "1",
+   "software": "v1.0.0",
+   "height": "1000000",
+   "threshold": "0.8"
+ },
+ "deposit": [
+   {
+     "denom": "%s",
+     "amount": "100"
+   }
+ ]
+}
+`, version.ClientName, sdk.DefaultBondDenom,
+			)),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := upgradeutils.ParseAppUpgradeProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			content := types.NewAppUpgradeProposal(proposal.Title, proposal.Description, proposal.ProtocolDefinition)
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, cliCtx.GetFromAddress())
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
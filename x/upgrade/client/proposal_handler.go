@@ -0,0 +1,12 @@
+package client
+
+import (
+	govcli "github.com/okex/okexchain/x/gov/client"
+
+	"github.com/okex/okexchain/x/upgrade/client/cli"
+	"github.com/okex/okexchain/x/upgrade/client/rest"
+)
+
+// AppUpgradeProposalHandler alias gov NewProposalHandler
+var AppUpgradeProposalHandler = govcli.NewProposalHandler(cli.GetCmdAppUpgradeProposal,
+	rest.AppUpgradeProposalRESTHandler)
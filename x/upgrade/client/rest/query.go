@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	// get the config of the upgrade in progress
+	r.HandleFunc(
+		"/upgrade/config",
+		queryWithoutParamsHandlerFn(cliCtx, types.QueryUpgradeConfig),
+	).Methods("GET")
+
+	// get the current protocol version and the last failed version
+	r.HandleFunc(
+		"/upgrade/version",
+		queryWithoutParamsHandlerFn(cliCtx, types.QueryVersion),
+	).Methods("GET")
+
+	// get the validators signalling the version of the upgrade in progress
+	r.HandleFunc(
+		"/upgrade/signals",
+		queryWithoutParamsHandlerFn(cliCtx, types.QuerySignals),
+	).Methods("GET")
+}
+
+func queryWithoutParamsHandlerFn(cliCtx context.CLIContext, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, endpoint)
+		res, height, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
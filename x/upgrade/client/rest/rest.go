@@ -0,0 +1,17 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	govRest "github.com/okex/okexchain/x/gov/client/rest"
+)
+
+// RegisterRoutes registers upgrade-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+}
+
+// AppUpgradeProposalRESTHandler defines upgrade app upgrade proposal handler
+func AppUpgradeProposalRESTHandler(context.CLIContext) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{}
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/common/proto"
+)
+
+// AppUpgradeProposalJSON defines an AppUpgradeProposal with a deposit used to parse app upgrade proposals from a
+// JSON file.
+type AppUpgradeProposalJSON struct {
+	Title              string                   `json:"title" yaml:"title"`
+	Description        string                   `json:"description" yaml:"description"`
+	ProtocolDefinition proto.ProtocolDefinition `json:"protocol_definition" yaml:"protocol_definition"`
+	Deposit            sdk.SysCoins             `json:"deposit" yaml:"deposit"`
+}
+
+// ParseAppUpgradeProposalJSON parses json from proposal file to AppUpgradeProposalJSON struct
+func ParseAppUpgradeProposalJSON(cdc *codec.Codec, proposalFilePath string) (
+	proposal AppUpgradeProposalJSON, err error) {
+	contents, err := ioutil.ReadFile(proposalFilePath)
+	if err != nil {
+		return
+	}
+
+	cdc.MustUnmarshalJSON(contents, &proposal)
+	return
+}
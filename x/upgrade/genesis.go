@@ -0,0 +1,30 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/upgrade/keeper"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// InitGenesis initializes the protocol versions, the upgrade in progress and its signals
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	k.SetVersionInfo(ctx, data.VersionInfo)
+	if data.UpgradeConfig == nil {
+		return
+	}
+
+	k.SetUpgradeConfig(ctx, *data.UpgradeConfig)
+	for _, valAddr := range data.Signals {
+		k.SetSignal(ctx, data.UpgradeConfig.ProtocolDef.Version, valAddr)
+	}
+}
+
+// ExportGenesis writes the current store values to a genesis file, which can be imported again with InitGenesis
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
+	config, found := k.GetUpgradeConfig(ctx)
+	if !found {
+		return types.NewGenesisState(k.GetVersionInfo(ctx), nil, []sdk.ValAddress{})
+	}
+	return types.NewGenesisState(k.GetVersionInfo(ctx), &config, k.GetSignals(ctx, config.ProtocolDef.Version))
+}
@@ -0,0 +1,27 @@
+package upgrade
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/upgrade/keeper"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+func TestGenesis(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+	ctx := scheduleUpgrade(t, input, 20)
+	require.Nil(t, input.Keeper.Signal(ctx, input.ValAddrs[0], 1))
+
+	exported := ExportGenesis(ctx, input.Keeper)
+	require.Nil(t, types.ValidateGenesis(exported))
+	require.NotNil(t, exported.UpgradeConfig)
+	require.Equal(t, 1, len(exported.Signals))
+
+	newInput := keeper.CreateTestInput(t)
+	InitGenesis(newInput.Ctx, newInput.Keeper, exported)
+	require.Equal(t, exported, ExportGenesis(newInput.Ctx, newInput.Keeper))
+
+	require.Nil(t, types.ValidateGenesis(types.DefaultGenesisState()))
+}
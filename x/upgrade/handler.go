@@ -0,0 +1,52 @@
+package upgrade
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common"
+	"github.com/okex/okexchain/x/common/perf"
+	"github.com/okex/okexchain/x/upgrade/keeper"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// NewHandler creates an sdk.Handler for all the upgrade type messages
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		var handlerFun func() (*sdk.Result, error)
+		var name string
+		switch msg := msg.(type) {
+		case types.MsgSignal:
+			name = "handleMsgSignal"
+			handlerFun = func() (*sdk.Result, error) {
+				return handleMsgSignal(ctx, k, msg)
+			}
+		default:
+			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+
+		seq := perf.GetPerf().OnDeliverTxEnter(ctx, types.ModuleName, name)
+		defer perf.GetPerf().OnDeliverTxExit(ctx, types.ModuleName, name, seq)
+
+		res, err := handlerFun()
+		common.SanityCheckHandler(res, err)
+		return res, err
+	}
+}
+
+func handleMsgSignal(ctx sdk.Context, k keeper.Keeper, msg types.MsgSignal) (*sdk.Result, error) {
+	if err := k.Signal(ctx, msg.ValidatorAddress, msg.Version); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSignal,
+		sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress.String()),
+		sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(msg.Version, 10)),
+	))
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/okex/okexchain/x/common/proto"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// Keeper of the upgrade store
+type Keeper struct {
+	storeKey           sdk.StoreKey
+	cdc                *codec.Codec
+	protocolKeeper     proto.ProtocolKeeper
+	stakingKeeper      types.StakingKeeper
+	govKeeper          types.GovKeeper
+	skipUpgradeHeights map[int64]bool
+	upgradeHandlers    map[uint64]types.UpgradeHandler
+}
+
+// NewKeeper creates an upgrade keeper, the upgrades at skipUpgradeHeights are applied without running their handlers
+func NewKeeper(protocolKeeper proto.ProtocolKeeper, stakingKeeper types.StakingKeeper,
+	skipUpgradeHeights map[int64]bool, key sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{
+		storeKey:           key,
+		cdc:                cdc,
+		protocolKeeper:     protocolKeeper,
+		stakingKeeper:      stakingKeeper,
+		skipUpgradeHeights: skipUpgradeHeights,
+		upgradeHandlers:    make(map[uint64]types.UpgradeHandler),
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetGovKeeper sets keeper of gov
+func (k *Keeper) SetGovKeeper(gk types.GovKeeper) {
+	k.govKeeper = gk
+}
+
+// SetUpgradeHandler registers the handler to migrate the state to a protocol version. The software supporting the
+// version must register its handler, otherwise the node halts at the upgrade height
+func (k Keeper) SetUpgradeHandler(version uint64, handler types.UpgradeHandler) {
+	k.upgradeHandlers[version] = handler
+}
+
+// GetUpgradeHandler returns the handler registered for a protocol version
+func (k Keeper) GetUpgradeHandler(version uint64) (handler types.UpgradeHandler, found bool) {
+	handler, found = k.upgradeHandlers[version]
+	return
+}
+
+// IsSkipHeight returns true if the upgrade at the height is skipped by the node
+func (k Keeper) IsSkipHeight(height int64) bool {
+	return k.skipUpgradeHeights[height]
+}
+
+// GetVersionInfo returns the current protocol version and the last version failed to be upgraded to
+func (k Keeper) GetVersionInfo(ctx sdk.Context) types.VersionInfo {
+	return types.VersionInfo{
+		CurrentVersion:    k.protocolKeeper.GetCurrentVersion(ctx),
+		LastFailedVersion: k.protocolKeeper.GetLastFailedVersion(ctx),
+	}
+}
+
+// GetUpgradeConfig returns the config of the upgrade in progress
+func (k Keeper) GetUpgradeConfig(ctx sdk.Context) (proto.AppUpgradeConfig, bool) {
+	return k.protocolKeeper.GetUpgradeConfig(ctx)
+}
+
+// SetVersionInfo sets the current protocol version and the last version failed to be upgraded to
+func (k Keeper) SetVersionInfo(ctx sdk.Context, versionInfo types.VersionInfo) {
+	k.protocolKeeper.SetCurrentVersion(ctx, versionInfo.CurrentVersion)
+	k.protocolKeeper.SetLastFailedVersion(ctx, versionInfo.LastFailedVersion)
+}
+
+// SetUpgradeConfig sets the config of the upgrade in progress
+func (k Keeper) SetUpgradeConfig(ctx sdk.Context, config proto.AppUpgradeConfig) {
+	k.protocolKeeper.SetUpgradeConfig(ctx, config)
+}
+
+// DeleteLegacyKeys deletes the keys left in the store by the upgrade module of the cosmos sdk, whose store key is taken
+// over by this module
+func (k Keeper) DeleteLegacyKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	for _, prefix := range [][]byte{types.LegacyPlanPrefix, types.LegacyDonePrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
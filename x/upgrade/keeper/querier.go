@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// NewQuerier creates a new querier for upgrade clients.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryUpgradeConfig:
+			return queryUpgradeConfig(ctx, k)
+		case types.QueryVersion:
+			return marshalJSONIndent(k.GetVersionInfo(ctx))
+		case types.QuerySignals:
+			return querySignals(ctx, k)
+		default:
+			return nil, sdk.ErrUnknownRequest("failed. unknown upgrade query endpoint")
+		}
+	}
+}
+
+func queryUpgradeConfig(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	config, found := k.GetUpgradeConfig(ctx)
+	if !found {
+		return nil, types.ErrNoUpgradeConfig(types.DefaultCodespace)
+	}
+	return marshalJSONIndent(config)
+}
+
+func querySignals(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	config, found := k.GetUpgradeConfig(ctx)
+	if !found {
+		return nil, types.ErrNoUpgradeConfig(types.DefaultCodespace)
+	}
+	tally := k.TallySignals(ctx, config.ProtocolDef.Version)
+	if tally.Validators == nil {
+		tally.Validators = []sdk.ValAddress{}
+	}
+	return marshalJSONIndent(tally)
+}
+
+func marshalJSONIndent(o interface{}) ([]byte, sdk.Error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, o)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to marshal result to JSON", err.Error()))
+	}
+	return res, nil
+}
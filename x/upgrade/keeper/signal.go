@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// Signal records that the node of a validator runs the software of the version of the upgrade in progress
+func (k Keeper) Signal(ctx sdk.Context, valAddr sdk.ValAddress, version uint64) sdk.Error {
+	config, found := k.protocolKeeper.GetUpgradeConfig(ctx)
+	if !found {
+		return types.ErrNoUpgradeConfig(types.DefaultCodespace)
+	}
+	if config.ProtocolDef.Version != version {
+		return types.ErrInvalidVersion(types.DefaultCodespace, version)
+	}
+	if k.stakingKeeper.GetLastValidatorPower(ctx, valAddr) <= 0 {
+		return types.ErrNotBondedValidator(types.DefaultCodespace, valAddr.String())
+	}
+
+	k.SetSignal(ctx, version, valAddr)
+	return nil
+}
+
+// SetSignal saves the signal of a validator for a protocol version
+func (k Keeper) SetSignal(ctx sdk.Context, version uint64, valAddr sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSignalKey(version, valAddr), valAddr.Bytes())
+}
+
+// GetSignals returns the validators signalling a protocol version
+func (k Keeper) GetSignals(ctx sdk.Context, version uint64) (validators []sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetVersionSignalsPrefix(version))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		validators = append(validators, types.SplitSignalKey(iter.Key()))
+	}
+	return validators
+}
+
+// DeleteSignals removes all the signals for a protocol version
+func (k Keeper) DeleteSignals(ctx sdk.Context, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetVersionSignalsPrefix(version))
+	defer iter.Close()
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// TallySignals returns the share of the last total power held by the validators signalling a protocol version. The
+// validators that are no longer bonded don't count
+func (k Keeper) TallySignals(ctx sdk.Context, version uint64) types.SignalTally {
+	tally := types.SignalTally{Version: version, SignalledRatio: sdk.ZeroDec()}
+	totalPower := k.stakingKeeper.GetLastTotalPower(ctx)
+	signalledPower := sdk.ZeroInt()
+	for _, valAddr := range k.GetSignals(ctx, version) {
+		tally.Validators = append(tally.Validators, valAddr)
+		signalledPower = signalledPower.AddRaw(k.stakingKeeper.GetLastValidatorPower(ctx, valAddr))
+	}
+	if totalPower.IsPositive() {
+		tally.SignalledRatio = signalledPower.ToDec().Quo(totalPower.ToDec())
+	}
+	return tally
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"os"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/okex/okexchain/x/common/proto"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// TestInput stores some variables for testing
+type TestInput struct {
+	Ctx           sdk.Context
+	Cdc           *codec.Codec
+	ValAddrs      []sdk.ValAddress
+	Keeper        Keeper
+	StakingKeeper *MockStakingKeeper
+	StoreKey      sdk.StoreKey
+}
+
+// MockStakingKeeper mocks the last powers of the bonded validators
+type MockStakingKeeper struct {
+	Powers map[string]int64
+}
+
+// GetLastValidatorPower returns the mocked power of a validator
+func (sk *MockStakingKeeper) GetLastValidatorPower(_ sdk.Context, operator sdk.ValAddress) int64 {
+	return sk.Powers[operator.String()]
+}
+
+// GetLastTotalPower returns the sum of the mocked powers
+func (sk *MockStakingKeeper) GetLastTotalPower(_ sdk.Context) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, power := range sk.Powers {
+		total = total.AddRaw(power)
+	}
+	return total
+}
+
+// CreateTestInput creates an upgrade keeper with 4 validators of power 10 and the skip height 100
+func CreateTestInput(t *testing.T) TestInput {
+	keyMain := sdk.NewKVStoreKey("main")
+	keyUpgrade := sdk.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyMain, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyUpgrade, sdk.StoreTypeIAVL, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewTMLogger(os.Stdout))
+	cdc := codec.New()
+	types.RegisterCodec(cdc)
+
+	stakingKeeper := &MockStakingKeeper{Powers: make(map[string]int64)}
+	valAddrs := make([]sdk.ValAddress, 4)
+	for i := range valAddrs {
+		valAddrs[i] = sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+		stakingKeeper.Powers[valAddrs[i].String()] = 10
+	}
+
+	keeper := NewKeeper(proto.NewProtocolKeeper(keyMain), stakingKeeper, map[int64]bool{100: true}, keyUpgrade,
+		cdc)
+	return TestInput{
+		Ctx:           ctx,
+		Cdc:           cdc,
+		ValAddrs:      valAddrs,
+		Keeper:        keeper,
+		StakingKeeper: stakingKeeper,
+		StoreKey:      keyUpgrade,
+	}
+}
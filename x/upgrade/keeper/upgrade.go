@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/okex/okexchain/x/common/proto"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// CheckProtocolDefinition checks that no upgrade is in progress, the version is the next one to be upgraded to and
+// the upgrade height is in the future
+func (k Keeper) CheckProtocolDefinition(ctx sdk.Context, protocolDef proto.ProtocolDefinition) sdk.Error {
+	if config, found := k.protocolKeeper.GetUpgradeConfig(ctx); found {
+		return types.ErrUpgradeInProgress(types.DefaultCodespace, config.ProposalID)
+	}
+	if !k.protocolKeeper.IsValidVersion(ctx, protocolDef.Version) {
+		return types.ErrInvalidVersion(types.DefaultCodespace, protocolDef.Version)
+	}
+	if protocolDef.Height <= uint64(ctx.BlockHeight()) {
+		return types.ErrInvalidHeight(types.DefaultCodespace, protocolDef.Height, ctx.BlockHeight())
+	}
+	return nil
+}
+
+// ScheduleUpgrade records the config of the upgrade of a passed proposal, it's applied at the upgrade height
+func (k Keeper) ScheduleUpgrade(ctx sdk.Context, proposalID uint64, protocolDef proto.ProtocolDefinition) sdk.Error {
+	if err := k.CheckProtocolDefinition(ctx, protocolDef); err != nil {
+		return err
+	}
+	k.protocolKeeper.SetUpgradeConfig(ctx, proto.NewAppUpgradeConfig(proposalID, protocolDef))
+	k.Logger(ctx).Info(fmt.Sprintf("upgrade to version %d (%s) is scheduled at height %d", protocolDef.Version,
+		protocolDef.Software, protocolDef.Height))
+	return nil
+}
+
+// CompleteUpgrade switches the protocol to the version of the upgrade config and clears the config
+func (k Keeper) CompleteUpgrade(ctx sdk.Context, config proto.AppUpgradeConfig) {
+	k.protocolKeeper.SetCurrentVersion(ctx, config.ProtocolDef.Version)
+	k.clearUpgrade(ctx, config)
+}
+
+// AbortUpgrade records the version of the upgrade config as failed and clears the config
+func (k Keeper) AbortUpgrade(ctx sdk.Context, config proto.AppUpgradeConfig) {
+	k.protocolKeeper.SetLastFailedVersion(ctx, config.ProtocolDef.Version)
+	k.clearUpgrade(ctx, config)
+}
+
+func (k Keeper) clearUpgrade(ctx sdk.Context, config proto.AppUpgradeConfig) {
+	k.protocolKeeper.ClearUpgradeConfig(ctx)
+	k.DeleteSignals(ctx, config.ProtocolDef.Version)
+}
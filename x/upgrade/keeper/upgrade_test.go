@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common/proto"
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+func TestScheduleUpgrade(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, k := input.Ctx.WithBlockHeight(10), input.Keeper
+	threshold := sdk.MustNewDecFromStr("0.75")
+
+	// the version must be the next one of the current version
+	require.NotNil(t, k.ScheduleUpgrade(ctx, 1, proto.NewProtocolDefinition(2, "v2", 20, threshold)))
+	// the height must be in the future
+	require.NotNil(t, k.ScheduleUpgrade(ctx, 1, proto.NewProtocolDefinition(1, "v1", 10, threshold)))
+
+	protocolDef := proto.NewProtocolDefinition(1, "v1", 20, threshold)
+	require.Nil(t, k.ScheduleUpgrade(ctx, 1, protocolDef))
+	config, found := k.GetUpgradeConfig(ctx)
+	require.True(t, found)
+	require.Equal(t, proto.NewAppUpgradeConfig(1, protocolDef), config)
+
+	// only one upgrade is in progress at a time
+	require.NotNil(t, k.ScheduleUpgrade(ctx, 2, protocolDef))
+
+	// the failed version can be proposed again
+	k.AbortUpgrade(ctx, config)
+	_, found = k.GetUpgradeConfig(ctx)
+	require.False(t, found)
+	require.EqualValues(t, 1, k.GetVersionInfo(ctx).LastFailedVersion)
+	require.Nil(t, k.CheckProtocolDefinition(ctx, protocolDef))
+
+	k.CompleteUpgrade(ctx, config)
+	require.EqualValues(t, 1, k.GetVersionInfo(ctx).CurrentVersion)
+	require.NotNil(t, k.CheckProtocolDefinition(ctx, protocolDef))
+}
+
+func TestSignal(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, k := input.Ctx.WithBlockHeight(10), input.Keeper
+	valAddrs := input.ValAddrs
+
+	// no upgrade in progress
+	require.NotNil(t, k.Signal(ctx, valAddrs[0], 1))
+
+	protocolDef := proto.NewProtocolDefinition(1, "v1", 20, sdk.MustNewDecFromStr("0.75"))
+	require.Nil(t, k.ScheduleUpgrade(ctx, 1, protocolDef))
+	require.NotNil(t, k.Signal(ctx, valAddrs[0], 2))
+	require.NotNil(t, k.Signal(ctx, sdk.ValAddress(valAddrs[0][:10]), 1))
+
+	require.Nil(t, k.Signal(ctx, valAddrs[0], 1))
+	require.Nil(t, k.Signal(ctx, valAddrs[1], 1))
+	require.Nil(t, k.Signal(ctx, valAddrs[1], 1))
+	tally := k.TallySignals(ctx, 1)
+	require.Equal(t, 2, len(tally.Validators))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), tally.SignalledRatio)
+
+	// the signals of the validators no longer bonded don't count
+	delete(input.StakingKeeper.Powers, valAddrs[1].String())
+	require.Equal(t, sdk.MustNewDecFromStr("0.333333333333333333"), k.TallySignals(ctx, 1).SignalledRatio)
+
+	config, _ := k.GetUpgradeConfig(ctx)
+	k.CompleteUpgrade(ctx, config)
+	require.Empty(t, k.GetSignals(ctx, 1))
+}
+
+func TestDeleteLegacyKeys(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, k := input.Ctx, input.Keeper
+	store := ctx.KVStore(input.StoreKey)
+
+	// the plan and the done upgrade left by the upgrade module of the cosmos sdk
+	planKey := types.LegacyPlanPrefix
+	doneKey := append(append([]byte{}, types.LegacyDonePrefix...), []byte("v0.16")...)
+	store.Set(planKey, []byte("plan"))
+	store.Set(doneKey, []byte("height"))
+	k.SetSignal(ctx, 1, input.ValAddrs[0])
+
+	k.DeleteLegacyKeys(ctx)
+	require.False(t, store.Has(planKey))
+	require.False(t, store.Has(doneKey))
+	require.Equal(t, []sdk.ValAddress{input.ValAddrs[0]}, k.GetSignals(ctx, 1))
+}
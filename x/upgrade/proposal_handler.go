@@ -0,0 +1,20 @@
+package upgrade
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/okex/okexchain/x/gov/types"
+
+	"github.com/okex/okexchain/x/upgrade/types"
+)
+
+// NewProposalHandler handles "gov" type message in "upgrade"
+func NewProposalHandler(k *Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, proposal *govTypes.Proposal) (err sdk.Error) {
+		switch content := proposal.Content.(type) {
+		case types.AppUpgradeProposal:
+			return k.ScheduleUpgrade(ctx, proposal.ProposalID, content.ProtocolDefinition)
+		default:
+			return types.ErrUnexpectedProposalType(DefaultCodespace, content.ProposalType())
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+type CodeType = uint32
+
+const (
+	DefaultCodespace string = ModuleName
+
+	CodeInvalidInput           CodeType = 101
+	CodeInvalidAddress         CodeType = 102
+	CodeInvalidVersion         CodeType = 103
+	CodeInvalidHeight          CodeType = 104
+	CodeUpgradeInProgress      CodeType = 105
+	CodeNoUpgradeConfig        CodeType = 106
+	CodeNotBondedValidator     CodeType = 107
+	CodeUnexpectedProposalType CodeType = 108
+)
+
+var (
+	errInvalidInput           = sdkerrors.Register(DefaultCodespace, CodeInvalidInput, "invalid input")
+	errInvalidAddress         = sdkerrors.Register(DefaultCodespace, CodeInvalidAddress, "invalid address")
+	errInvalidVersion         = sdkerrors.Register(DefaultCodespace, CodeInvalidVersion, "invalid version")
+	errInvalidHeight          = sdkerrors.Register(DefaultCodespace, CodeInvalidHeight, "invalid height")
+	errUpgradeInProgress      = sdkerrors.Register(DefaultCodespace, CodeUpgradeInProgress, "upgrade in progress")
+	errNoUpgradeConfig        = sdkerrors.Register(DefaultCodespace, CodeNoUpgradeConfig, "no upgrade config")
+	errNotBondedValidator     = sdkerrors.Register(DefaultCodespace, CodeNotBondedValidator, "not bonded validator")
+	errUnexpectedProposalType = sdkerrors.Register(DefaultCodespace, CodeUnexpectedProposalType, "unexpected proposal type")
+)
+
+// ErrInvalidInput returns an error when an input parameter is invalid
+func ErrInvalidInput(codespace string, msg string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInvalidInput, "failed. invalid input: %s", msg)}
+}
+
+// ErrNilAddress returns an error when an empty address appears
+func ErrNilAddress(codespace string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInvalidAddress, "failed. address is nil")}
+}
+
+// ErrInvalidVersion returns an error when the version isn't the next one to be upgraded to
+func ErrInvalidVersion(codespace string, version uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInvalidVersion,
+		"failed. version %d is not available to be upgraded to", version)}
+}
+
+// ErrInvalidHeight returns an error when the upgrade height has been reached
+func ErrInvalidHeight(codespace string, height uint64, blockHeight int64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errInvalidHeight,
+		"failed. upgrade height %d must be greater than the current block height %d", height, blockHeight)}
+}
+
+// ErrUpgradeInProgress returns an error when another upgrade is waiting for its height
+func ErrUpgradeInProgress(codespace string, proposalID uint64) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errUpgradeInProgress,
+		"failed. the upgrade of proposal %d is in progress", proposalID)}
+}
+
+// ErrNoUpgradeConfig returns an error when there is no upgrade waiting for its height
+func ErrNoUpgradeConfig(codespace string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errNoUpgradeConfig, "failed. there is no upgrade in progress")}
+}
+
+// ErrNotBondedValidator returns an error when the signaller isn't a bonded validator
+func ErrNotBondedValidator(codespace string, valAddr string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errNotBondedValidator,
+		"failed. %s is not a bonded validator", valAddr)}
+}
+
+// ErrUnexpectedProposalType returns an error when the proposal type is not supported in upgrade module
+func ErrUnexpectedProposalType(codespace string, proposalType string) sdk.EnvelopedErr {
+	return sdk.EnvelopedErr{Err: sdkerrors.Wrapf(errUnexpectedProposalType,
+		"failed. the proposal type %s is not supported in upgrade module", proposalType)}
+}
@@ -0,0 +1,17 @@
+package types
+
+// upgrade module event types
+const (
+	EventTypeSignal         = "signal"
+	EventTypeUpgradeSucceed = "upgrade_succeed"
+	EventTypeUpgradeFailed  = "upgrade_failed"
+	EventTypeUpgradeSkipped = "upgrade_skipped"
+
+	AttributeKeyValidator      = "validator"
+	AttributeKeyVersion        = "version"
+	AttributeKeySoftware       = "software"
+	AttributeKeyProposalID     = "proposal_id"
+	AttributeKeySignalledRatio = "signalled_ratio"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+// StakingKeeper defines the expected staking keeper to weigh the signals by the power of the validators
+type StakingKeeper interface {
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64
+	GetLastTotalPower(ctx sdk.Context) sdk.Int
+}
+
+// GovKeeper defines the expected gov Keeper
+type GovKeeper interface {
+	GetDepositParams(ctx sdk.Context) govtypes.DepositParams
+	GetVotingParams(ctx sdk.Context) govtypes.VotingParams
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/common/proto"
+)
+
+// GenesisState - all upgrade state that must be provided at genesis
+type GenesisState struct {
+	VersionInfo   VersionInfo             `json:"version_info" yaml:"version_info"`
+	UpgradeConfig *proto.AppUpgradeConfig `json:"upgrade_config,omitempty" yaml:"upgrade_config"`
+	Signals       []sdk.ValAddress        `json:"signals" yaml:"signals"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(versionInfo VersionInfo, upgradeConfig *proto.AppUpgradeConfig,
+	signals []sdk.ValAddress) GenesisState {
+	return GenesisState{
+		VersionInfo:   versionInfo,
+		UpgradeConfig: upgradeConfig,
+		Signals:       signals,
+	}
+}
+
+// DefaultGenesisState - default GenesisState used by Cosmos Hub
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Signals: []sdk.ValAddress{},
+	}
+}
+
+// ValidateGenesis validates the upgrade genesis parameters
+func ValidateGenesis(data GenesisState) error {
+	if data.UpgradeConfig == nil {
+		if len(data.Signals) != 0 {
+			return fmt.Errorf("signals without an upgrade in progress")
+		}
+		return nil
+	}
+
+	if err := ValidateProtocolDefinition(data.UpgradeConfig.ProtocolDef); err != nil {
+		return fmt.Errorf("invalid upgrade config: %s", err)
+	}
+	for _, valAddr := range data.Signals {
+		if valAddr.Empty() {
+			return fmt.Errorf("empty validator address in signals")
+		}
+	}
+	return nil
+}
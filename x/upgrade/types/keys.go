@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the upgrade module
+	ModuleName = "upgrade"
+
+	// StoreKey to be used when creating the KVStore. It's taken over from the upgrade module of the cosmos sdk, whose
+	// keys are deleted on the upgrade to version 1
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing msgs
+	RouterKey = ModuleName
+
+	// QuerierRoute to be used for querier msgs
+	QuerierRoute = ModuleName
+)
+
+var (
+	// prefixes of the scheduled plan and the done upgrades of the upgrade module of the cosmos sdk
+	LegacyPlanPrefix = []byte{0x00}
+	LegacyDonePrefix = []byte{0x01}
+
+	// the prefixes of this module don't overlap the legacy ones
+	SignalPrefix = []byte{0x11}
+)
+
+// GetVersionSignalsPrefix gets the prefix key of the signals for a protocol version
+func GetVersionSignalsPrefix(version uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, version)
+	return append(SignalPrefix, bz...)
+}
+
+// GetSignalKey gets the key for the signal of a validator for a protocol version
+func GetSignalKey(version uint64, valAddr sdk.ValAddress) []byte {
+	return append(GetVersionSignalsPrefix(version), valAddr.Bytes()...)
+}
+
+// SplitSignalKey splits the validator address out from a SignalKey
+func SplitSignalKey(key []byte) sdk.ValAddress {
+	return key[len(SignalPrefix)+8:]
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	signalMsgType = "signal"
+)
+
+// MsgSignal is the msg for a validator to signal that its node runs the software of a protocol version, which is
+// required for the upgrade to the version to take effect
+type MsgSignal struct {
+	ValidatorAddress sdk.ValAddress `json:"validator_address" yaml:"validator_address"`
+	Version          uint64         `json:"version" yaml:"version"`
+}
+
+var _ sdk.Msg = MsgSignal{}
+
+// NewMsgSignal creates a new instance of MsgSignal
+func NewMsgSignal(valAddr sdk.ValAddress, version uint64) MsgSignal {
+	return MsgSignal{
+		ValidatorAddress: valAddr,
+		Version:          version,
+	}
+}
+
+func (m MsgSignal) Route() string {
+	return RouterKey
+}
+
+func (m MsgSignal) Type() string {
+	return signalMsgType
+}
+
+func (m MsgSignal) ValidateBasic() sdk.Error {
+	if m.ValidatorAddress.Empty() {
+		return ErrNilAddress(DefaultCodespace)
+	}
+	if m.Version == 0 {
+		return ErrInvalidInput(DefaultCodespace, "version must be positive")
+	}
+	return nil
+}
+
+func (m MsgSignal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgSignal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(m.ValidatorAddress)}
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/common/proto"
+	govtypes "github.com/okex/okexchain/x/gov/types"
+)
+
+const (
+	// proposalTypeAppUpgrade defines the type for an AppUpgradeProposal
+	proposalTypeAppUpgrade = "AppUpgrade"
+)
+
+func init() {
+	govtypes.RegisterProposalType(proposalTypeAppUpgrade)
+	govtypes.RegisterProposalTypeCodec(AppUpgradeProposal{}, "okexchain/upgrade/AppUpgradeProposal")
+}
+
+var _ govtypes.Content = (*AppUpgradeProposal)(nil)
+
+// AppUpgradeProposal - structure for the proposal to upgrade the protocol to a new version at a block height, the
+// upgrade takes effect only if the validators signalling the new version hold enough power at the height
+type AppUpgradeProposal struct {
+	Title              string                   `json:"title" yaml:"title"`
+	Description        string                   `json:"description" yaml:"description"`
+	ProtocolDefinition proto.ProtocolDefinition `json:"protocol_definition" yaml:"protocol_definition"`
+}
+
+// NewAppUpgradeProposal creates a new instance of AppUpgradeProposal
+func NewAppUpgradeProposal(title, description string, protocolDef proto.ProtocolDefinition) AppUpgradeProposal {
+	return AppUpgradeProposal{
+		Title:              title,
+		Description:        description,
+		ProtocolDefinition: protocolDef,
+	}
+}
+
+// GetTitle returns title of an app upgrade proposal object
+func (up AppUpgradeProposal) GetTitle() string {
+	return up.Title
+}
+
+// GetDescription returns description of an app upgrade proposal object
+func (up AppUpgradeProposal) GetDescription() string {
+	return up.Description
+}
+
+// ProposalRoute returns route key of an app upgrade proposal object
+func (up AppUpgradeProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+// ProposalType returns type of an app upgrade proposal object
+func (up AppUpgradeProposal) ProposalType() string {
+	return proposalTypeAppUpgrade
+}
+
+// ValidateBasic validates an app upgrade proposal
+func (up AppUpgradeProposal) ValidateBasic() sdk.Error {
+	if len(strings.TrimSpace(up.Title)) == 0 {
+		return govtypes.ErrInvalidProposalContent(DefaultCodespace,
+			"failed to submit the app upgrade proposal because the title is blank")
+	}
+	if len(up.Title) > govtypes.MaxTitleLength {
+		return govtypes.ErrInvalidProposalContent(DefaultCodespace,
+			fmt.Sprintf("failed to submit the app upgrade proposal because the title is longer than max length of %d",
+				govtypes.MaxTitleLength))
+	}
+
+	if len(up.Description) == 0 {
+		return govtypes.ErrInvalidProposalContent(DefaultCodespace,
+			"failed to submit the app upgrade proposal because the description is blank")
+	}
+	if len(up.Description) > govtypes.MaxDescriptionLength {
+		return govtypes.ErrInvalidProposalContent(DefaultCodespace,
+			fmt.Sprintf("failed to submit the app upgrade proposal because the description is longer than max "+
+				"length of %d", govtypes.MaxDescriptionLength))
+	}
+
+	if up.ProposalType() != proposalTypeAppUpgrade {
+		return govtypes.ErrInvalidProposalType(DefaultCodespace, up.ProposalType())
+	}
+
+	if err := ValidateProtocolDefinition(up.ProtocolDefinition); err != nil {
+		return govtypes.ErrInvalidProposalContent(DefaultCodespace,
+			fmt.Sprintf("failed to submit the app upgrade proposal because of the invalid protocol definition: %s",
+				err))
+	}
+
+	return nil
+}
+
+// String returns a human readable string representation of an AppUpgradeProposal
+func (up AppUpgradeProposal) String() string {
+	return fmt.Sprintf(`AppUpgradeProposal:
+ Title:					%s
+ Description:        	%s
+ Type:                	%s
+ Version:				%d
+ Software:				%s
+ Height:				%d
+ Threshold:				%s`,
+		up.Title, up.Description, up.ProposalType(), up.ProtocolDefinition.Version, up.ProtocolDefinition.Software,
+		up.ProtocolDefinition.Height, up.ProtocolDefinition.Threshold)
+}
+
+// ValidateProtocolDefinition checks the fields of a protocol definition regardless of the state
+func ValidateProtocolDefinition(protocolDef proto.ProtocolDefinition) error {
+	if protocolDef.Version == 0 {
+		return fmt.Errorf("version must be positive")
+	}
+	if len(strings.TrimSpace(protocolDef.Software)) == 0 {
+		return fmt.Errorf("software is blank")
+	}
+	if protocolDef.Height == 0 {
+		return fmt.Errorf("height must be positive")
+	}
+	if protocolDef.Threshold.IsNil() || !protocolDef.Threshold.IsPositive() || protocolDef.Threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("threshold must be in (0, 1]")
+	}
+	return nil
+}
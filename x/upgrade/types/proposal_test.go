@@ -0,0 +1,56 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/okex/okexchain/x/common/proto"
+)
+
+func TestAppUpgradeProposal_ValidateBasic(t *testing.T) {
+	threshold := sdk.MustNewDecFromStr("0.8")
+	tests := []struct {
+		name     string
+		proposal AppUpgradeProposal
+		valid    bool
+	}{
+		{"valid", NewAppUpgradeProposal("title", "description",
+			proto.NewProtocolDefinition(1, "v1", 100, threshold)), true},
+		{"blank title", NewAppUpgradeProposal(" ", "description",
+			proto.NewProtocolDefinition(1, "v1", 100, threshold)), false},
+		{"long description", NewAppUpgradeProposal("title", strings.Repeat("d", 5001),
+			proto.NewProtocolDefinition(1, "v1", 100, threshold)), false},
+		{"zero version", NewAppUpgradeProposal("title", "description",
+			proto.NewProtocolDefinition(0, "v1", 100, threshold)), false},
+		{"blank software", NewAppUpgradeProposal("title", "description",
+			proto.NewProtocolDefinition(1, "", 100, threshold)), false},
+		{"zero height", NewAppUpgradeProposal("title", "description",
+			proto.NewProtocolDefinition(1, "v1", 0, threshold)), false},
+		{"zero threshold", NewAppUpgradeProposal("title", "description",
+			proto.NewProtocolDefinition(1, "v1", 100, sdk.ZeroDec())), false},
+		{"threshold above 1", NewAppUpgradeProposal("title", "description",
+			proto.NewProtocolDefinition(1, "v1", 100, sdk.MustNewDecFromStr("1.1"))), false},
+		{"nil threshold", NewAppUpgradeProposal("title", "description",
+			proto.ProtocolDefinition{Version: 1, Software: "v1", Height: 100}), false},
+	}
+
+	for _, tc := range tests {
+		err := tc.proposal.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, tc.name)
+		} else {
+			require.NotNil(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSignal_ValidateBasic(t *testing.T) {
+	valAddr := sdk.ValAddress("validator")
+	require.Nil(t, NewMsgSignal(valAddr, 1).ValidateBasic())
+	require.NotNil(t, NewMsgSignal(nil, 1).ValidateBasic())
+	require.NotNil(t, NewMsgSignal(valAddr, 0).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress(valAddr)}, NewMsgSignal(valAddr, 1).GetSigners())
+}
@@ -0,0 +1,7 @@
+package types
+
+const (
+	QueryUpgradeConfig = "config"
+	QueryVersion       = "version"
+	QuerySignals       = "signals"
+)
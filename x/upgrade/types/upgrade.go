@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/okex/okexchain/x/common/proto"
+)
+
+// UpgradeHandler migrates the state to a new protocol version, it's registered in the app of the software that
+// supports the version and called at the upgrade height
+type UpgradeHandler func(ctx sdk.Context, protocolDef proto.ProtocolDefinition)
+
+// VersionInfo is the version of the protocol that the chain runs, and the last version failed to be upgraded to
+type VersionInfo struct {
+	CurrentVersion    uint64 `json:"current_version" yaml:"current_version"`
+	LastFailedVersion uint64 `json:"last_failed_version" yaml:"last_failed_version"`
+}
+
+// String implements the stringer interface
+func (vi VersionInfo) String() string {
+	return fmt.Sprintf(`VersionInfo:
+  Current Version:     %d
+  Last Failed Version: %d`, vi.CurrentVersion, vi.LastFailedVersion)
+}
+
+// SignalTally is the validators signalling the version of the upgrade in progress and the share of the power they hold
+type SignalTally struct {
+	Version        uint64           `json:"version" yaml:"version"`
+	Validators     []sdk.ValAddress `json:"validators" yaml:"validators"`
+	SignalledRatio sdk.Dec          `json:"signalled_ratio" yaml:"signalled_ratio"`
+}
+
+// String implements the stringer interface
+func (st SignalTally) String() string {
+	return fmt.Sprintf(`SignalTally:
+  Version:         %d
+  Validators:      %v
+  Signalled Ratio: %s`, st.Version, st.Validators, st.SignalledRatio)
+}